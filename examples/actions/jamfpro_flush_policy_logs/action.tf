@@ -0,0 +1,22 @@
+# Flushes the logs of the install policy for the pilot computers whenever its
+# script changes, so the "Once per computer" policy runs on them again.
+action "jamfpro_flush_policy_logs" "install_agent" {
+  config {
+    policy_id         = jamfpro_policy.install_agent.id
+    computer_group_id = jamfpro_static_computer_group.pilot.id
+    interval          = "Zero Days"
+  }
+}
+
+resource "jamfpro_script" "install_agent" {
+  name            = "Install Agent"
+  script_contents = file("${path.module}/scripts/install_agent.sh")
+  priority        = "AFTER"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.jamfpro_flush_policy_logs.install_agent]
+    }
+  }
+}
@@ -0,0 +1,31 @@
+action "jamfpro_redeploy_management_framework" "lab" {
+  config {
+    computer_ids = [12, 15]
+  }
+}
+
+# Repairs the Jamf binary on the lab computers after the policy relying on it
+# is changed.
+resource "jamfpro_policy" "lab_maintenance" {
+  name            = "Lab Maintenance"
+  enabled         = true
+  trigger_checkin = true
+  frequency       = "Once every day"
+
+  scope {
+    computer_ids = [12, 15]
+  }
+
+  payloads {
+    maintenance {
+      recon = true
+    }
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.jamfpro_redeploy_management_framework.lab]
+    }
+  }
+}
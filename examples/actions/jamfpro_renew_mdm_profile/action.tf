@@ -0,0 +1,9 @@
+# Renews the MDM profile of every managed device, e.g. after the push
+# certificate was renewed. Invoke it on its own with:
+# terraform apply -invoke=action.jamfpro_renew_mdm_profile.all_managed
+action "jamfpro_renew_mdm_profile" "all_managed" {
+  config {
+    computer_group_id      = 1
+    mobile_device_group_id = 1
+  }
+}
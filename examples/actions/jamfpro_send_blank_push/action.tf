@@ -0,0 +1,30 @@
+# Prompts the pilot computers and iPads to check in as soon as the profiles
+# scoped to them change, rather than on their next scheduled check-in.
+action "jamfpro_send_blank_push" "pilot" {
+  config {
+    computer_group_id      = jamfpro_static_computer_group.pilot.id
+    mobile_device_group_id = jamfpro_static_mobile_device_group.pilot.id
+  }
+}
+
+resource "jamfpro_macos_configuration_profile_plist" "wifi" {
+  name                = "Corporate Wi-Fi"
+  distribution_method = "Install Automatically"
+  level               = "System"
+  redeploy_on_update  = "Newly Assigned"
+  payloads            = file("${path.module}/profiles/wifi.mobileconfig")
+
+  scope {
+    computer_group_ids = [jamfpro_static_computer_group.pilot.id]
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.jamfpro_send_blank_push.pilot]
+    }
+  }
+}
+
+# Actions can also be invoked on their own:
+# terraform apply -invoke=action.jamfpro_send_blank_push.pilot
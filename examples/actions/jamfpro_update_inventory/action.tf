@@ -0,0 +1,26 @@
+# Refreshes the inventory of the shared iPads once the profile scoped to them
+# changes, so that smart groups pick up the new state.
+action "jamfpro_update_inventory" "shared_ipads" {
+  config {
+    mobile_device_group_id = jamfpro_static_mobile_device_group.shared_ipads.id
+  }
+}
+
+resource "jamfpro_mobile_device_configuration_profile_plist" "restrictions" {
+  name               = "Shared iPad Restrictions"
+  level              = "Device Level"
+  deployment_method  = "Install Automatically"
+  redeploy_on_update = "Newly Assigned"
+  payloads           = file("${path.module}/profiles/restrictions.mobileconfig")
+
+  scope {
+    mobile_device_group_ids = [jamfpro_static_mobile_device_group.shared_ipads.id]
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.jamfpro_update_inventory.shared_ipads]
+    }
+  }
+}
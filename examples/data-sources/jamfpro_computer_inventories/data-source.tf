@@ -0,0 +1,21 @@
+# All Macs in building 3 still on macOS 14.
+data "jamfpro_computer_inventories" "sonoma_hq" {
+  filter   = "userAndLocation.buildingId==\"3\" and operatingSystem.version=lt=\"15\""
+  sections = ["GENERAL", "HARDWARE", "OPERATING_SYSTEM", "USER_AND_LOCATION"]
+  sort     = ["general.name:asc"]
+}
+
+# Drive a static group from the result.
+resource "jamfpro_static_computer_group" "sonoma_hq" {
+  name                  = "HQ - macOS 14"
+  assigned_computer_ids = [for c in data.jamfpro_computer_inventories.sonoma_hq.computers : tonumber(c.id)]
+}
+
+# Write a report of the same computers.
+resource "local_file" "sonoma_hq_report" {
+  filename = "${path.module}/sonoma_hq.csv"
+  content = join("\n", concat(
+    ["name,serial_number,os_version,username"],
+    [for c in data.jamfpro_computer_inventories.sonoma_hq.computers : "${c.name},${c.serial_number},${c.os_version},${c.username}"],
+  ))
+}
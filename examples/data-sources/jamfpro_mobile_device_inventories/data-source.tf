@@ -0,0 +1,14 @@
+# All lab iPads.
+data "jamfpro_mobile_device_inventories" "lab_ipads" {
+  name_regex             = "^LAB-"
+  model_identifier_regex = "^iPad"
+}
+
+# Drive a static group from the result, skipping unsupervised devices.
+resource "jamfpro_static_mobile_device_group" "lab_ipads" {
+  name = "Lab iPads"
+  assigned_mobile_device_ids = [
+    for d in data.jamfpro_mobile_device_inventories.lab_ipads.mobile_devices : tonumber(d.id)
+    if d.general[0].supervised
+  ]
+}
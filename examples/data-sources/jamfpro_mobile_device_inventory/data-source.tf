@@ -0,0 +1,13 @@
+# Look up a device by serial number.
+data "jamfpro_mobile_device_inventory" "by_serial" {
+  serial_number = "DMPXJ0ABCD12"
+}
+
+# Look up a device by UDID.
+data "jamfpro_mobile_device_inventory" "by_udid" {
+  udid = "00008103-000A1B2C3D4E5F60"
+}
+
+output "ipad_os_version" {
+  value = data.jamfpro_mobile_device_inventory.by_serial.general[0].os_version
+}
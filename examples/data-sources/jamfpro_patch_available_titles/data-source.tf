@@ -0,0 +1,8 @@
+data "jamfpro_patch_available_titles" "jamf" {
+  source_id = 1
+}
+
+# The titles of the source published by Google.
+output "google_titles" {
+  value = [for t in data.jamfpro_patch_available_titles.jamf.titles : "${t.name_id} (${t.current_version})" if t.publisher == "Google"]
+}
@@ -0,0 +1,6 @@
+data "jamfpro_patch_sources" "all" {}
+
+# The Jamf patch management catalog.
+locals {
+  jamf_patch_source_id = one([for s in data.jamfpro_patch_sources.all.sources : s.id if s.type == "internal" && s.name == "Jamf"])
+}
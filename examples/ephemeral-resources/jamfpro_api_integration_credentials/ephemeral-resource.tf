@@ -0,0 +1,23 @@
+resource "jamfpro_api_integration" "vault_sync" {
+  display_name                  = "vault-sync"
+  enabled                       = true
+  access_token_lifetime_seconds = 300
+  authorization_scopes          = [jamfpro_api_role.read_only.display_name]
+}
+
+# Generates a new client secret for the integration on every run. The
+# credentials are never written to plan or state.
+ephemeral "jamfpro_api_integration_credentials" "vault_sync" {
+  api_integration_id = jamfpro_api_integration.vault_sync.id
+}
+
+resource "vault_kv_secret_v2" "jamfpro_api_client" {
+  mount = "secret"
+  name  = "jamfpro/vault-sync"
+
+  data_json_wo = jsonencode({
+    client_id     = ephemeral.jamfpro_api_integration_credentials.vault_sync.client_id
+    client_secret = ephemeral.jamfpro_api_integration_credentials.vault_sync.client_secret
+  })
+  data_json_wo_version = 1
+}
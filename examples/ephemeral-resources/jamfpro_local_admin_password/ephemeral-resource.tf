@@ -0,0 +1,17 @@
+# Retrieves the current LAPS password for the managed admin account. The
+# password is never written to plan or state.
+ephemeral "jamfpro_local_admin_password" "break_glass" {
+  serial_number = "C02XXXXXXXXX"
+  username      = "jamfadmin"
+}
+
+resource "vault_kv_secret_v2" "break_glass" {
+  mount = "secret"
+  name  = "laps/C02XXXXXXXXX"
+
+  data_json_wo = jsonencode({
+    username = "jamfadmin"
+    password = ephemeral.jamfpro_local_admin_password.break_glass.password
+  })
+  data_json_wo_version = 1
+}
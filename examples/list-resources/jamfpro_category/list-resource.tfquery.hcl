@@ -0,0 +1,14 @@
+# Lists categories in Jamf Pro for import. Run with
+# `terraform query -generate-config-out=generated.tf` to write an import block
+# and configuration for each result.
+list "jamfpro_category" "all" {
+  provider = jamfpro
+}
+
+list "jamfpro_category" "filtered" {
+  provider = jamfpro
+
+  config {
+    name_contains = "Applications"
+  }
+}
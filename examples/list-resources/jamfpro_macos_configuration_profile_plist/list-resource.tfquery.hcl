@@ -0,0 +1,14 @@
+# Lists macOS configuration profiles in Jamf Pro for import. Run with
+# `terraform query -generate-config-out=generated.tf` to write an import block
+# and configuration for each result.
+list "jamfpro_macos_configuration_profile_plist" "all" {
+  provider = jamfpro
+}
+
+list "jamfpro_macos_configuration_profile_plist" "filtered" {
+  provider = jamfpro
+
+  config {
+    name_contains = "FileVault"
+  }
+}
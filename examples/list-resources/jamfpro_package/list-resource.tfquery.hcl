@@ -0,0 +1,14 @@
+# Lists packages in Jamf Pro for import. Run with
+# `terraform query -generate-config-out=generated.tf` to write an import block
+# and configuration for each result.
+list "jamfpro_package" "all" {
+  provider = jamfpro
+}
+
+list "jamfpro_package" "filtered" {
+  provider = jamfpro
+
+  config {
+    name_contains = "Office"
+  }
+}
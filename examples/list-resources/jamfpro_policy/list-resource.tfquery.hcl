@@ -0,0 +1,14 @@
+# Lists policies in Jamf Pro for import. Run with
+# `terraform query -generate-config-out=generated.tf` to write an import block
+# and configuration for each result.
+list "jamfpro_policy" "all" {
+  provider = jamfpro
+}
+
+list "jamfpro_policy" "filtered" {
+  provider = jamfpro
+
+  config {
+    name_contains = "Install"
+  }
+}
@@ -0,0 +1,14 @@
+# Lists scripts in Jamf Pro for import. Run with
+# `terraform query -generate-config-out=generated.tf` to write an import block
+# and configuration for each result.
+list "jamfpro_script" "all" {
+  provider = jamfpro
+}
+
+list "jamfpro_script" "filtered" {
+  provider = jamfpro
+
+  config {
+    name_contains = "Rosetta"
+  }
+}
@@ -0,0 +1,14 @@
+# Lists smart computer groups in Jamf Pro for import. Run with
+# `terraform query -generate-config-out=generated.tf` to write an import block
+# and configuration for each result.
+list "jamfpro_smart_computer_group" "all" {
+  provider = jamfpro
+}
+
+list "jamfpro_smart_computer_group" "filtered" {
+  provider = jamfpro
+
+  config {
+    name_contains = "macOS"
+  }
+}
@@ -0,0 +1,14 @@
+# Lists static mobile device groups in Jamf Pro for import. Run with
+# `terraform query -generate-config-out=generated.tf` to write an import block
+# and configuration for each result.
+list "jamfpro_static_mobile_device_group" "all" {
+  provider = jamfpro
+}
+
+list "jamfpro_static_mobile_device_group" "filtered" {
+  provider = jamfpro
+
+  config {
+    name_contains = "Pilot"
+  }
+}
@@ -23,6 +23,10 @@ provider "jamfpro" {
   jamfpro_load_balancer_lock           = var.jamfpro_jamf_load_balancer_lock
   token_refresh_buffer_period_seconds  = var.jamfpro_token_refresh_buffer_period_seconds
   mandatory_request_delay_milliseconds = var.jamfpro_mandatory_request_delay_milliseconds
+  max_concurrent_requests              = var.jamfpro_max_concurrent_requests
+  requests_per_second                  = var.jamfpro_requests_per_second
+  honor_retry_after                    = var.jamfpro_honor_retry_after
+  max_throttle_retries                 = var.jamfpro_max_throttle_retries
 }
 
 variable "jamfpro_instance_fqdn" {
@@ -117,3 +121,23 @@ variable "jamfpro_mandatory_request_delay_milliseconds" {
   description = "A mandatory delay after each request before returning to reduce high volume of requests in a short time."
   default     = 1000
 }
+
+variable "jamfpro_max_concurrent_requests" {
+  description = "The maximum number of requests in flight to the Jamf Pro instance at any time. 0 disables the limit."
+  default     = 5
+}
+
+variable "jamfpro_requests_per_second" {
+  description = "The sustained request rate allowed against the Jamf Pro instance. 0 disables the limit."
+  default     = 10
+}
+
+variable "jamfpro_honor_retry_after" {
+  description = "Replay throttled requests after the delay requested by Jamf Pro."
+  default     = true
+}
+
+variable "jamfpro_max_throttle_retries" {
+  description = "The number of times a throttled request is replayed."
+  default     = 5
+}
@@ -0,0 +1,5 @@
+# Cloud identity provider configurations can be imported by their Jamf Pro ID.
+# keystore_password and keystore_file_bytes are not returned by the API, so they stay
+# empty in state after import and the keystore Jamf Pro holds is kept. To upload a new
+# keystore, change keystore_file_name along with the keystore settings.
+terraform import jamfpro_cloud_ldap.example 1001
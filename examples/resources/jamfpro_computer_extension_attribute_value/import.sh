@@ -0,0 +1,5 @@
+# Import using the ID of the computer and the ID of the extension attribute
+terraform import jamfpro_computer_extension_attribute_value.lab_01_tier 12:5
+
+# Or using the serial number of the computer
+terraform import jamfpro_computer_extension_attribute_value.ceo_laptop_tier C02ABC123XYZ:5
@@ -0,0 +1,22 @@
+resource "jamfpro_computer_extension_attribute" "ownership_tier" {
+  name                   = "Ownership Tier"
+  enabled                = true
+  data_type              = "STRING"
+  inventory_display_type = "GENERAL"
+  input_type             = "POPUP"
+  popup_menu_choices     = ["Corporate", "Shared", "BYOD"]
+}
+
+# By computer ID
+resource "jamfpro_computer_extension_attribute_value" "lab_01_tier" {
+  computer_id            = "12"
+  extension_attribute_id = jamfpro_computer_extension_attribute.ownership_tier.id
+  value                  = "Shared"
+}
+
+# By serial number
+resource "jamfpro_computer_extension_attribute_value" "ceo_laptop_tier" {
+  serial_number          = "C02ABC123XYZ"
+  extension_attribute_id = jamfpro_computer_extension_attribute.ownership_tier.id
+  value                  = "Corporate"
+}
@@ -0,0 +1,17 @@
+# A CSV in the format of Jamf Pro's inventory preload template, kept in the repository, e.g.
+#
+# Serial Number,Device Type,Username,Department,PO Number,Cost Center
+# C02ABC123XYZ,Computer,jappleseed,Research,PO-2024-0042,R&D
+# DMPXYZ123ABC,Mobile Device,japplebaum,Sales,PO-2024-0042,Sales
+resource "jamfpro_inventory_preload_csv" "procurement" {
+  csv_file = "${path.module}/inventory_preload.csv"
+}
+
+# The CSV can also be given inline.
+resource "jamfpro_inventory_preload_csv" "loaners" {
+  csv_content = <<-EOT
+    Serial Number,Device Type,Department
+    C02LOAN0001,Computer,IT Loaners
+    C02LOAN0002,Computer,IT Loaners
+  EOT
+}
@@ -0,0 +1,5 @@
+# Import using the ID of the inventory preload record
+terraform import jamfpro_inventory_preload_record.lab_01 12
+
+# Or using the serial number of its device
+terraform import jamfpro_inventory_preload_record.lab_01 C02ABC123XYZ
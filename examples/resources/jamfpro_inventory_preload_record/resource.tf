@@ -0,0 +1,21 @@
+resource "jamfpro_inventory_preload_record" "lab_01" {
+  serial_number = "C02ABC123XYZ"
+  device_type   = "Computer"
+
+  username      = "jappleseed"
+  full_name     = "Jane Appleseed"
+  email_address = "jane.appleseed@example.com"
+  department    = "Research"
+  building      = "Headquarters"
+  room          = "4.01"
+
+  po_number           = "PO-2024-0042"
+  po_date             = "2024-03-01"
+  warranty_expiration = "2027-03-01"
+  vendor              = "Apple"
+  asset_tag           = "IT-00451"
+
+  extension_attributes = {
+    "Cost Center" = "R&D"
+  }
+}
@@ -0,0 +1,3 @@
+# The Jamf Protect integration is a singleton and is imported with a fixed ID.
+# The password is not returned by the API and is left empty in state.
+terraform import jamfpro_jamf_protect.example jamfpro_settings
@@ -0,0 +1,2 @@
+# macOS onboarding settings are a singleton and are imported with a fixed ID.
+terraform import jamfpro_macos_onboarding_settings.example jamfpro_settings
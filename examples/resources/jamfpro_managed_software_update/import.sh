@@ -0,0 +1,6 @@
+# Plans are imported by their UUID, which populates the device block from the plan.
+terraform import jamfpro_managed_software_update.example 5a47f2a4-3e1c-4f1e-9d6b-8a2f7c0e1b3d
+
+# Plans created for a group are imported by appending the group object type and ID,
+# as Jamf Pro records only the member device on each plan.
+terraform import jamfpro_managed_software_update.example 5a47f2a4-3e1c-4f1e-9d6b-8a2f7c0e1b3d:COMPUTER_GROUP:12
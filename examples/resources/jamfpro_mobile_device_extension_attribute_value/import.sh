@@ -0,0 +1,5 @@
+# Import using the ID of the mobile device and the ID of the extension attribute
+terraform import jamfpro_mobile_device_extension_attribute_value.cart_ipad_tier 31:5
+
+# Or using the serial number of the mobile device
+terraform import jamfpro_mobile_device_extension_attribute_value.sales_iphone_tier DMPXYZ123ABC:5
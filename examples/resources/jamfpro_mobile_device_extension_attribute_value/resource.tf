@@ -0,0 +1,21 @@
+resource "jamfpro_mobile_device_extension_attribute" "ownership_tier" {
+  name                   = "Ownership Tier"
+  data_type              = "STRING"
+  inventory_display_type = "GENERAL"
+  input_type             = "POPUP"
+  popup_menu_choices     = ["Corporate", "Shared", "BYOD"]
+}
+
+# By mobile device ID
+resource "jamfpro_mobile_device_extension_attribute_value" "cart_ipad_tier" {
+  mobile_device_id       = "31"
+  extension_attribute_id = jamfpro_mobile_device_extension_attribute.ownership_tier.id
+  value                  = "Shared"
+}
+
+# By serial number
+resource "jamfpro_mobile_device_extension_attribute_value" "sales_iphone_tier" {
+  serial_number          = "DMPXYZ123ABC"
+  extension_attribute_id = jamfpro_mobile_device_extension_attribute.ownership_tier.id
+  value                  = "Corporate"
+}
@@ -26,6 +26,34 @@ resource "jamfpro_package" "jamfpro_package_002" {
   }
 }
 
+// Example pinning a large package downloaded from a URL. The apply fails before upload if the
+// download does not match expected_sha256 or is not validly signed, and with the provider's
+// package_cache_dir set the cached download is reused on later runs.
+resource "jamfpro_package" "xcode" {
+  package_name          = "Xcode 16.4"
+  package_file_source   = "https://downloads.example.com/Xcode_16.4.pkg"
+  expected_sha256       = "0d5e0f3a7a6c9b1e0c6e4b6a3c1f8f2c9e6a4b1d7c3e5f9a2b8d6c4e1f3a5b7c"
+  require_signed        = true
+  priority              = 10
+  reboot_required       = false
+  fill_user_template    = false
+  fill_existing_users   = false
+  os_install            = false
+  suppress_updates      = false
+  suppress_from_dock    = false
+  suppress_eula         = false
+  suppress_registration = false
+  timeouts {
+    create = "90m"
+    update = "90m"
+  }
+}
+
+// Metadata read from the flat package, e.g. to check the team that signed it
+output "xcode_package_signer" {
+  value = jamfpro_package.xcode.pkg_metadata[0].signing_certificates[0].subject
+}
+
 // Example without package_file_source (when package exists on File Share Distribution Point only)
 // Package metadata only is created
 resource "jamfpro_package" "jamfpro_package_003" {
@@ -0,0 +1,2 @@
+# Import using the ID of the patch policy
+terraform import jamfpro_patch_policy.chrome 4
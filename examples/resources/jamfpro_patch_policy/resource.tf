@@ -0,0 +1,35 @@
+resource "jamfpro_patch_policy" "chrome" {
+  name                            = "Google Chrome - 126"
+  software_title_configuration_id = jamfpro_patch_software_title_configuration.chrome.id
+  target_version                  = "126.0.6478.127"
+  distribution_method             = "selfservice"
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [jamfpro_smart_computer_group.chrome_installed.id]
+
+    exclusions {
+      computer_group_ids = [jamfpro_static_computer_group.kiosks.id]
+    }
+  }
+
+  self_service {
+    install_button_text = "Update"
+    description         = "Updates Google Chrome to the latest version."
+  }
+
+  notifications {
+    type                    = "Self Service"
+    subject                 = "Google Chrome update available"
+    message                 = "Install the update from Self Service."
+    reminder_frequency_days = 1
+  }
+
+  deadline {
+    period_days = 7
+  }
+
+  grace_period {
+    duration_minutes = 30
+  }
+}
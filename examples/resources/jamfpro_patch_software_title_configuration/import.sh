@@ -0,0 +1,2 @@
+# Import using the ID of the software title configuration
+terraform import jamfpro_patch_software_title_configuration.chrome 12
@@ -0,0 +1,14 @@
+resource "jamfpro_patch_software_title_configuration" "chrome" {
+  source_id    = 1
+  name_id      = "GoogleChrome"
+  display_name = "Google Chrome"
+
+  ui_notifications                 = true
+  accepted_extension_attribute_ids = ["google-chrome-ea"]
+
+  # The package installing this version, e.g. a jamfpro_package.
+  package {
+    package_id = jamfpro_package.chrome_126.id
+    version    = "126.0.6478.127"
+  }
+}
@@ -0,0 +1,3 @@
+# Scope targets are imported by the policy ID, whether the entry is a target or an
+# exclusion, the target type and the target ID.
+terraform import jamfpro_policy_scope_target.example 12:exclusion:building:7
@@ -0,0 +1,35 @@
+resource "jamfpro_policy" "inventory" {
+  name            = "Inventory Update"
+  enabled         = true
+  trigger_checkin = true
+  frequency       = "Once every day"
+
+  # Keep the scope entries added by jamfpro_policy_scope_target resources.
+  ignore_external_scope = true
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [1]
+  }
+
+  payloads {
+    maintenance {
+      recon = true
+    }
+  }
+}
+
+# Scope the policy to a group owned by another team.
+resource "jamfpro_policy_scope_target" "finance" {
+  policy_id   = jamfpro_policy.inventory.id
+  target_type = "computer_group"
+  target_id   = 42
+}
+
+# Exclude a building from the policy.
+resource "jamfpro_policy_scope_target" "lab" {
+  policy_id   = jamfpro_policy.inventory.id
+  target_type = "building"
+  target_id   = 7
+  exclusion   = true
+}
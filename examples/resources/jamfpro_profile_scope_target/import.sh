@@ -0,0 +1,3 @@
+# Scope targets are imported by the profile type and ID, whether the entry is a
+# target or an exclusion, the target type and the target ID.
+terraform import jamfpro_profile_scope_target.example macos:12:target:computer_group:42
@@ -0,0 +1,16 @@
+# Scope a macOS configuration profile to a group owned by another team.
+resource "jamfpro_profile_scope_target" "finance" {
+  profile_type = "macos"
+  profile_id   = jamfpro_macos_configuration_profile_plist.wifi.id
+  target_type  = "computer_group"
+  target_id    = 42
+}
+
+# Exclude a department from a mobile device configuration profile.
+resource "jamfpro_profile_scope_target" "contractors" {
+  profile_type = "mobile_device"
+  profile_id   = jamfpro_mobile_device_configuration_profile_plist.wifi.id
+  target_type  = "department"
+  target_id    = 3
+  exclusion    = true
+}
@@ -0,0 +1,2 @@
+# The SSO certificate is a singleton and is imported with a fixed ID.
+terraform import jamfpro_sso_certificate.example jamfpro_settings
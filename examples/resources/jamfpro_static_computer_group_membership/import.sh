@@ -0,0 +1,3 @@
+# Memberships are imported by the group ID followed by the Jamf Pro IDs of the
+# computers the resource owns. Other members of the group are left unmanaged.
+terraform import jamfpro_static_computer_group_membership.example 12:1,2,3
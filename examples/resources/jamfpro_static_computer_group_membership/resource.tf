@@ -0,0 +1,22 @@
+resource "jamfpro_static_computer_group" "shared" {
+  name = "Shared Group"
+
+  # Members are managed by jamfpro_static_computer_group_membership resources, which may live in
+  # other configurations. Ignore them here so the group does not remove them.
+  lifecycle {
+    ignore_changes = [assigned_computer_ids]
+  }
+}
+
+# Members owned by the helpdesk team, given by serial number.
+resource "jamfpro_static_computer_group_membership" "helpdesk" {
+  group_id       = jamfpro_static_computer_group.shared.id
+  serial_numbers = ["C02XL0GJJGH5"]
+}
+
+# Members owned by the platform team, given by Jamf Pro ID and UDID.
+resource "jamfpro_static_computer_group_membership" "platform" {
+  group_id     = jamfpro_static_computer_group.shared.id
+  computer_ids = [1, 2]
+  udids        = ["8D1D4AE1-4C4B-5A1C-9D2E-0F3A6B7C8D9E"]
+}
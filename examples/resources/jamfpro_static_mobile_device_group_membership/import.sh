@@ -0,0 +1,3 @@
+# Memberships are imported by the group ID followed by the Jamf Pro IDs of the
+# mobile devices the resource owns. Other members of the group are left unmanaged.
+terraform import jamfpro_static_mobile_device_group_membership.example 12:1,2,3
@@ -0,0 +1,22 @@
+resource "jamfpro_static_mobile_device_group" "shared" {
+  name = "Shared Group"
+
+  # Members are managed by jamfpro_static_mobile_device_group_membership resources, which may live in
+  # other configurations. Ignore them here so the group does not remove them.
+  lifecycle {
+    ignore_changes = [assigned_mobile_device_ids]
+  }
+}
+
+# Members owned by the helpdesk team, given by serial number.
+resource "jamfpro_static_mobile_device_group_membership" "helpdesk" {
+  group_id       = jamfpro_static_mobile_device_group.shared.id
+  serial_numbers = ["F9FXK1ABCD12"]
+}
+
+# Members owned by the platform team, given by Jamf Pro ID and UDID.
+resource "jamfpro_static_mobile_device_group_membership" "platform" {
+  group_id          = jamfpro_static_mobile_device_group.shared.id
+  mobile_device_ids = [1, 2]
+  udids             = ["00008110-000A1C2E3F4B801E"]
+}
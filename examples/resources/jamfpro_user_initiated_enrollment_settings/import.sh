@@ -0,0 +1,4 @@
+# User-initiated enrollment settings are a singleton and are imported with a fixed ID.
+# The third-party signing certificate and QuickAdd package keystore are not returned by
+# the API, so they differ from configuration on the first plan after import.
+terraform import jamfpro_user_initiated_enrollment_settings.example jamfpro_settings
@@ -0,0 +1,100 @@
+// Package acctest provides the shared setup for acceptance tests.
+//
+// Acceptance tests run when TF_ACC is set. They target the Jamf Pro instance configured through
+// the provider's environment variables (JAMFPRO_INSTANCE_FQDN and its credentials) and, when no
+// instance is configured, an in-process fake Jamf Pro server so that they run hermetically.
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest/fakejamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// ProviderName is the local name of the provider in test configurations.
+const ProviderName = "jamfpro"
+
+// ProtoV6ProviderFactories serves the muxed provider in process for resource.TestCase.
+var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	ProviderName: func() (tfprotov6.ProviderServer, error) {
+		server, err := provider.MuxServer(context.Background(), "test")
+		if err != nil {
+			return nil, err
+		}
+		return server(), nil
+	},
+}
+
+// PreCheck configures the provider environment for an acceptance test. When
+// JAMFPRO_INSTANCE_FQDN is unset a fake Jamf Pro server is started for the duration of the test
+// and the provider is pointed at it using OAuth client credentials.
+func PreCheck(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("JAMFPRO_INSTANCE_FQDN") != "" {
+		return
+	}
+
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+
+	t.Setenv("JAMFPRO_INSTANCE_FQDN", server.URL)
+	t.Setenv("JAMFPRO_AUTH_METHOD", "oauth2")
+	t.Setenv("JAMFPRO_AUTH_PROVIDER", "direct")
+	t.Setenv("JAMFPRO_CLIENT_ID", server.ClientID)
+	t.Setenv("JAMFPRO_CLIENT_SECRET", server.ClientSecret)
+}
+
+// Client returns a Jamf Pro client for the instance configured by PreCheck, built by the SDKv2
+// provider from the same environment as the provider under test.
+func Client() (*jamfpro.Client, error) {
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return nil, fmt.Errorf("failed to configure provider: %v", diags)
+	}
+
+	return p.Meta().(*jamfpro.Client), nil
+}
+
+// CheckDestroy returns a CheckDestroy function which verifies that every resource of
+// resourceType in state has been deleted, using get to look each one up by ID.
+func CheckDestroy(resourceType string, get func(client *jamfpro.Client, id string) error) func(*tfresource.State) error {
+	return func(state *tfresource.State) error {
+		client, err := Client()
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			err := get(client, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+			if !strings.Contains(err.Error(), "404") {
+				return fmt.Errorf("failed to check whether %s %s was destroyed: %v", resourceType, rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// RandomName returns a unique object name carrying the tf-testing prefix used by the live test
+// cleanup jobs.
+func RandomName(suffix string) string {
+	return fmt.Sprintf("tf-testing-acc-%s-%s", suffix, sdkacctest.RandString(8))
+}
@@ -0,0 +1,152 @@
+package fakejamfpro
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// classicResource serves a Classic API resource under /JSSResource/{path}.
+type classicResource[T any] struct {
+	// path is the URL segment below /JSSResource, e.g. "policies".
+	path string
+	// element is the root element of a single object, e.g. "policy".
+	element string
+	// listElement is the root element of the list response, e.g. "policies".
+	listElement string
+	// list builds the SDK list response from the stored objects.
+	list func([]T) any
+	// normalize, when set, rewrites a submitted object into the shape Jamf Pro stores and returns,
+	// filling the defaults it always includes.
+	normalize func(*T)
+
+	items *collection[T]
+}
+
+// registerClassic adds the list, by ID and by name routes of a Classic API resource.
+func registerClassic[T any](s *Server, r *classicResource[T]) {
+	base := "/JSSResource/" + r.path
+
+	s.mux.HandleFunc("GET "+base, func(w http.ResponseWriter, req *http.Request) {
+		writeXML(w, http.StatusOK, r.listElement, r.list(r.items.list()))
+	})
+
+	s.mux.HandleFunc("GET "+base+"/id/{id}", r.byID(r.get))
+	s.mux.HandleFunc("PUT "+base+"/id/{id}", r.byID(r.update))
+	s.mux.HandleFunc("DELETE "+base+"/id/{id}", r.byID(r.delete))
+	s.mux.HandleFunc("GET "+base+"/name/{name}", r.byName(r.get))
+	s.mux.HandleFunc("PUT "+base+"/name/{name}", r.byName(r.update))
+	s.mux.HandleFunc("DELETE "+base+"/name/{name}", r.byName(r.delete))
+
+	// Jamf Pro expects creates to be posted to ID 0 and ignores any ID in the body.
+	s.mux.HandleFunc("POST "+base+"/id/{id}", func(w http.ResponseWriter, req *http.Request) {
+		item, ok := r.decode(w, req)
+		if !ok {
+			return
+		}
+
+		created, err := r.items.create(item)
+		if err != nil {
+			r.writeError(w, err)
+			return
+		}
+
+		r.writeID(w, http.StatusCreated, r.items.id(&created))
+	})
+}
+
+// byID resolves the {id} path value and calls handle with it.
+func (r *classicResource[T]) byID(handle func(http.ResponseWriter, *http.Request, int)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id, err := strconv.Atoi(req.PathValue("id"))
+		if err != nil {
+			writeClassicError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		handle(w, req, id)
+	}
+}
+
+// byName resolves the {name} path value to an ID and calls handle with it.
+func (r *classicResource[T]) byName(handle func(http.ResponseWriter, *http.Request, int)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id, err := r.items.idByName(req.PathValue("name"))
+		if err != nil {
+			r.writeError(w, err)
+			return
+		}
+		handle(w, req, id)
+	}
+}
+
+func (r *classicResource[T]) get(w http.ResponseWriter, _ *http.Request, id int) {
+	item, err := r.items.get(id)
+	if err != nil {
+		r.writeError(w, err)
+		return
+	}
+	writeXML(w, http.StatusOK, r.element, item)
+}
+
+func (r *classicResource[T]) update(w http.ResponseWriter, req *http.Request, id int) {
+	item, ok := r.decode(w, req)
+	if !ok {
+		return
+	}
+
+	if _, err := r.items.update(id, item); err != nil {
+		r.writeError(w, err)
+		return
+	}
+
+	r.writeID(w, http.StatusCreated, id)
+}
+
+func (r *classicResource[T]) delete(w http.ResponseWriter, _ *http.Request, id int) {
+	if err := r.items.delete(id); err != nil {
+		r.writeError(w, err)
+		return
+	}
+
+	r.writeID(w, http.StatusOK, id)
+}
+
+// decode reads an object from the request body, writing a 400 response when it is invalid.
+func (r *classicResource[T]) decode(w http.ResponseWriter, req *http.Request) (T, bool) {
+	var item T
+
+	body, err := io.ReadAll(req.Body)
+	if err == nil {
+		err = xml.Unmarshal(body, &item)
+	}
+	if err != nil {
+		writeClassicError(w, http.StatusBadRequest, "Unable to parse XML: "+err.Error())
+		return item, false
+	}
+
+	if r.normalize != nil {
+		r.normalize(&item)
+	}
+
+	return item, true
+}
+
+// writeID writes the create, update and delete response, which only carries the object's ID.
+func (r *classicResource[T]) writeID(w http.ResponseWriter, status, id int) {
+	writeXML(w, status, r.element, struct {
+		ID int `xml:"id"`
+	}{ID: id})
+}
+
+func (r *classicResource[T]) writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errNotFound):
+		writeClassicError(w, http.StatusNotFound, "Not Found")
+	case errors.Is(err, errDuplicateName):
+		writeClassicError(w, http.StatusConflict, "Duplicate name")
+	default:
+		writeClassicError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,126 @@
+package fakejamfpro
+
+import (
+	"errors"
+	"slices"
+	"sync"
+)
+
+var (
+	errNotFound      = errors.New("not found")
+	errDuplicateName = errors.New("duplicate name")
+)
+
+// collection stores the objects of a single resource type. IDs are allocated per type starting at
+// 1 and are never reused, matching Jamf Pro. Names must be unique within a type.
+type collection[T any] struct {
+	mu     sync.Mutex
+	lastID int
+	items  map[int]T
+
+	id    func(*T) int
+	setID func(*T, int)
+	name  func(*T) string
+}
+
+func newCollection[T any](id func(*T) int, setID func(*T, int), name func(*T) string) *collection[T] {
+	return &collection[T]{items: map[int]T{}, id: id, setID: setID, name: name}
+}
+
+// create stores item under a newly allocated ID and returns the stored object.
+func (c *collection[T]) create(item T) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.nameTaken(c.name(&item), 0) {
+		var zero T
+		return zero, errDuplicateName
+	}
+
+	c.lastID++
+	c.setID(&item, c.lastID)
+	c.items[c.lastID] = item
+	return item, nil
+}
+
+// update replaces the object stored under id. Jamf Pro merges partial Classic API updates, but
+// the provider always sends complete objects so replacing is equivalent.
+func (c *collection[T]) update(id int, item T) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+	if _, ok := c.items[id]; !ok {
+		return zero, errNotFound
+	}
+	if c.nameTaken(c.name(&item), id) {
+		return zero, errDuplicateName
+	}
+
+	c.setID(&item, id)
+	c.items[id] = item
+	return item, nil
+}
+
+func (c *collection[T]) get(id int) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[id]
+	if !ok {
+		return item, errNotFound
+	}
+	return item, nil
+}
+
+// idByName returns the ID of the object with the given name.
+func (c *collection[T]) idByName(name string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, item := range c.items {
+		if c.name(&item) == name {
+			return id, nil
+		}
+	}
+	return 0, errNotFound
+}
+
+func (c *collection[T]) delete(id int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.items[id]; !ok {
+		return errNotFound
+	}
+	delete(c.items, id)
+	return nil
+}
+
+// list returns every stored object ordered by ID.
+func (c *collection[T]) list() []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make([]int, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	items := make([]T, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, c.items[id])
+	}
+	return items
+}
+
+// nameTaken reports whether an object other than exceptID already uses name. Callers hold c.mu.
+func (c *collection[T]) nameTaken(name string, exceptID int) bool {
+	for id, item := range c.items {
+		if id != exceptID && c.name(&item) == name {
+			return true
+		}
+	}
+	return false
+}
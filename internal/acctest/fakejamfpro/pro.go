@@ -0,0 +1,136 @@
+package fakejamfpro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// proResource serves a Pro API resource under /api/{path}, e.g. "v1/categories".
+type proResource[T any] struct {
+	path  string
+	items *collection[T]
+}
+
+// registerPro adds the paginated list and by ID routes of a Pro API resource.
+func registerPro[T any](s *Server, r *proResource[T]) {
+	base := "/api/" + r.path
+
+	s.mux.HandleFunc("GET "+base, r.handleList)
+
+	s.mux.HandleFunc("POST "+base, func(w http.ResponseWriter, req *http.Request) {
+		item, ok := r.decode(w, req)
+		if !ok {
+			return
+		}
+
+		created, err := r.items.create(item)
+		if err != nil {
+			r.writeError(w, err)
+			return
+		}
+
+		id := r.items.id(&created)
+		writeJSON(w, http.StatusCreated, map[string]string{
+			"id":   strconv.Itoa(id),
+			"href": fmt.Sprintf("%s/%d", base, id),
+		})
+	})
+
+	s.mux.HandleFunc("GET "+base+"/{id}", r.byID(func(w http.ResponseWriter, req *http.Request, id int) {
+		item, err := r.items.get(id)
+		if err != nil {
+			r.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, item)
+	}))
+
+	s.mux.HandleFunc("PUT "+base+"/{id}", r.byID(func(w http.ResponseWriter, req *http.Request, id int) {
+		item, ok := r.decode(w, req)
+		if !ok {
+			return
+		}
+
+		updated, err := r.items.update(id, item)
+		if err != nil {
+			r.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, updated)
+	}))
+
+	s.mux.HandleFunc("DELETE "+base+"/{id}", r.byID(func(w http.ResponseWriter, req *http.Request, id int) {
+		if err := r.items.delete(id); err != nil {
+			r.writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+// handleList serves a page of results using the page and page-size query parameters.
+func (r *proResource[T]) handleList(w http.ResponseWriter, req *http.Request) {
+	page, err := queryInt(req, "page", 0)
+	if err != nil || page < 0 {
+		writeJSON(w, http.StatusBadRequest, proError(http.StatusBadRequest, "INVALID_FIELD", "page"))
+		return
+	}
+	pageSize, err := queryInt(req, "page-size", 100)
+	if err != nil || pageSize < 1 {
+		writeJSON(w, http.StatusBadRequest, proError(http.StatusBadRequest, "INVALID_FIELD", "page-size"))
+		return
+	}
+
+	items := r.items.list()
+	start := min(page*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"totalCount": len(items),
+		"results":    items[start:end],
+	})
+}
+
+// byID resolves the {id} path value and calls handle with it.
+func (r *proResource[T]) byID(handle func(http.ResponseWriter, *http.Request, int)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		id, err := strconv.Atoi(req.PathValue("id"))
+		if err != nil {
+			r.writeError(w, errNotFound)
+			return
+		}
+		handle(w, req, id)
+	}
+}
+
+// decode reads an object from the request body, writing a 400 response when it is invalid.
+func (r *proResource[T]) decode(w http.ResponseWriter, req *http.Request) (T, bool) {
+	var item T
+	if err := json.NewDecoder(req.Body).Decode(&item); err != nil {
+		writeJSON(w, http.StatusBadRequest, proError(http.StatusBadRequest, "INVALID_JSON", err.Error()))
+		return item, false
+	}
+	return item, true
+}
+
+func (r *proResource[T]) writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errNotFound):
+		writeJSON(w, http.StatusNotFound, proError(http.StatusNotFound, "INVALID_ID", "Resource not found"))
+	case errors.Is(err, errDuplicateName):
+		writeJSON(w, http.StatusBadRequest, proError(http.StatusBadRequest, "DUPLICATE_FIELD", "name must be unique"))
+	default:
+		writeJSON(w, http.StatusInternalServerError, proError(http.StatusInternalServerError, "INTERNAL_ERROR", err.Error()))
+	}
+}
+
+func queryInt(req *http.Request, name string, fallback int) (int, error) {
+	value := req.URL.Query().Get(name)
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(value)
+}
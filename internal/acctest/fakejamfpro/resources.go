@@ -0,0 +1,143 @@
+package fakejamfpro
+
+import (
+	"html"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// registerResources adds every resource type served by the fake.
+func registerResources(s *Server) {
+	registerClassic(s, &classicResource[jamfpro.ResourcePolicy]{
+		path:        "policies",
+		element:     "policy",
+		listElement: "policies",
+		list: func(items []jamfpro.ResourcePolicy) any {
+			list := jamfpro.ResponsePoliciesList{Size: len(items)}
+			for _, item := range items {
+				list.Policy = append(list.Policy, jamfpro.ResponsePolicyListItem{ID: item.General.ID, Name: item.General.Name})
+			}
+			return list
+		},
+		normalize: normalizePolicy,
+		items: newCollection(
+			func(p *jamfpro.ResourcePolicy) int { return p.General.ID },
+			func(p *jamfpro.ResourcePolicy, id int) { p.General.ID = id },
+			func(p *jamfpro.ResourcePolicy) string { return p.General.Name },
+		),
+	})
+
+	registerClassic(s, &classicResource[jamfpro.ResourceComputerGroup]{
+		path:        "computergroups",
+		element:     "computer_group",
+		listElement: "computer_groups",
+		list: func(items []jamfpro.ResourceComputerGroup) any {
+			list := jamfpro.ResponseComputerGroupsList{Size: len(items)}
+			for _, item := range items {
+				list.Results = append(list.Results, jamfpro.ComputerGroupListItem{ID: item.ID, Name: item.Name, IsSmart: item.IsSmart})
+			}
+			return list
+		},
+		items: newCollection(
+			func(g *jamfpro.ResourceComputerGroup) int { return g.ID },
+			func(g *jamfpro.ResourceComputerGroup, id int) { g.ID = id },
+			func(g *jamfpro.ResourceComputerGroup) string { return g.Name },
+		),
+	})
+
+	registerClassic(s, &classicResource[jamfpro.ResourceMacOSConfigurationProfile]{
+		path:        "osxconfigurationprofiles",
+		element:     "os_x_configuration_profile",
+		listElement: "os_x_configuration_profiles",
+		list: func(items []jamfpro.ResourceMacOSConfigurationProfile) any {
+			var list jamfpro.ResponseMacOSConfigurationProfileList
+			for _, item := range items {
+				list.Results = append(list.Results, jamfpro.MacOSConfigurationProfileListItem{ID: item.General.ID, Name: item.General.Name})
+			}
+			return list
+		},
+		normalize: normalizeMacOSConfigurationProfile,
+		items: newCollection(
+			func(p *jamfpro.ResourceMacOSConfigurationProfile) int { return p.General.ID },
+			func(p *jamfpro.ResourceMacOSConfigurationProfile, id int) { p.General.ID = id },
+			func(p *jamfpro.ResourceMacOSConfigurationProfile) string { return p.General.Name },
+		),
+	})
+
+	registerPro(s, &proResource[jamfpro.ResourceCategory]{
+		path: "v1/categories",
+		items: newCollection(
+			func(c *jamfpro.ResourceCategory) int { return atoi(c.Id) },
+			func(c *jamfpro.ResourceCategory, id int) { c.Id = strconv.Itoa(id) },
+			func(c *jamfpro.ResourceCategory) string { return c.Name },
+		),
+	})
+
+	registerPro(s, &proResource[jamfpro.ResourceScript]{
+		path: "v1/scripts",
+		items: newCollection(
+			func(sc *jamfpro.ResourceScript) int { return atoi(sc.ID) },
+			func(sc *jamfpro.ResourceScript, id int) { sc.ID = strconv.Itoa(id) },
+			func(sc *jamfpro.ResourceScript) string { return sc.Name },
+		),
+	})
+}
+
+// normalizePolicy moves the submitted target drive into the override settings and fills the
+// defaults Jamf Pro always returns for the general, payload and scope sections.
+func normalizePolicy(p *jamfpro.ResourcePolicy) {
+	if p.General.OverrideDefaultSettings == nil {
+		p.General.OverrideDefaultSettings = &jamfpro.PolicySubsetGeneralOverrideSettings{
+			TargetDrive:       "default",
+			DistributionPoint: "default",
+			SUS:               "default",
+		}
+	}
+	if p.General.TargetDrive != "" {
+		p.General.OverrideDefaultSettings.TargetDrive = p.General.TargetDrive
+		p.General.TargetDrive = ""
+	}
+	if p.General.Category == nil {
+		p.General.Category = &jamfpro.SharedResourceCategory{ID: -1, Name: "No category assigned"}
+	}
+	if p.General.Site == nil {
+		p.General.Site = &jamfpro.SharedResourceSite{ID: -1, Name: "None"}
+	}
+	if p.DiskEncryption.Action == "" {
+		p.DiskEncryption.Action = "none"
+	}
+	if p.DiskEncryption.Action == "none" {
+		p.DiskEncryption.RemediateKeyType = ""
+	}
+	if p.Reboot.NoUserLoggedIn == "" {
+		p.Reboot.NoUserLoggedIn = "Do not restart"
+	}
+	if p.Reboot.UserLoggedIn == "" {
+		p.Reboot.UserLoggedIn = "Do not restart"
+	}
+	if p.Scope.Limitations == nil {
+		p.Scope.Limitations = &jamfpro.PolicySubsetScopeLimitations{}
+	}
+	if p.Scope.Exclusions == nil {
+		p.Scope.Exclusions = &jamfpro.PolicySubsetScopeExclusions{}
+	}
+}
+
+// normalizeMacOSConfigurationProfile stores the payload unescaped, as Jamf Pro returns it, and
+// fills the category and site it always includes.
+func normalizeMacOSConfigurationProfile(p *jamfpro.ResourceMacOSConfigurationProfile) {
+	p.General.Payloads = html.UnescapeString(p.General.Payloads)
+	if p.General.Category == nil {
+		p.General.Category = &jamfpro.SharedResourceCategory{ID: -1, Name: "No category assigned"}
+	}
+	if p.General.Site == nil {
+		p.General.Site = &jamfpro.SharedResourceSite{ID: -1, Name: "None"}
+	}
+}
+
+// atoi converts a Pro API ID, treating anything unparsable as unset.
+func atoi(id string) int {
+	n, _ := strconv.Atoi(id)
+	return n
+}
@@ -0,0 +1,218 @@
+// Package fakejamfpro provides an in-process fake of the Jamf Pro API for hermetic acceptance
+// tests.
+//
+// The server implements the subset of the Classic (XML) and Pro (JSON) APIs used by the
+// resources covered by acceptance tests, together with OAuth client credentials and basic auth
+// bearer token issuance. Objects are held in memory using the go-api-sdk-jamfpro types, so
+// whatever the provider sends is returned on the next read. IDs are allocated per type and names
+// must be unique, as on a real instance. Requests for endpoints which are not implemented fail
+// with 501 rather than 404, so that missing coverage is not mistaken for a deleted object.
+package fakejamfpro
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Version is reported by the jamf-pro-version endpoint. It must satisfy the provider's
+	// minimum supported version.
+	Version = "11.22.1-t1762179835791"
+
+	// tokenLifetime exceeds the provider's default token refresh buffer of 300 seconds.
+	tokenLifetime = 30 * time.Minute
+)
+
+// Server is a fake Jamf Pro instance listening on a local address.
+type Server struct {
+	*httptest.Server
+
+	// Credentials accepted by the token endpoints.
+	ClientID     string
+	ClientSecret string
+	Username     string
+	Password     string
+
+	mux *http.ServeMux
+
+	tokensMu sync.Mutex
+	tokens   map[string]time.Time
+}
+
+// New starts a fake Jamf Pro server. Callers must Close it when done.
+func New() *Server {
+	s := &Server{
+		ClientID:     "fake-client-id",
+		ClientSecret: "fake-client-secret",
+		Username:     "fake-user",
+		Password:     "fake-password",
+		mux:          http.NewServeMux(),
+		tokens:       map[string]time.Time{},
+	}
+
+	s.mux.HandleFunc("POST /api/v1/oauth/token", s.handleOAuthToken)
+	s.mux.HandleFunc("POST /api/v1/auth/token", s.handleBasicAuthToken)
+	s.mux.HandleFunc("POST /api/v1/auth/keep-alive", s.handleKeepAlive)
+	s.mux.HandleFunc("POST /api/v1/auth/invalidate-token", s.handleInvalidateToken)
+	s.mux.HandleFunc("GET /api/v1/jamf-pro-version", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"version": Version})
+	})
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, fmt.Sprintf("fake Jamf Pro server does not implement %s %s", r.Method, r.URL.Path), http.StatusNotImplemented)
+	})
+
+	registerResources(s)
+
+	s.Server = httptest.NewServer(s.authenticate(s.mux))
+	return s
+}
+
+// HandleFunc registers a handler for requests matching the pattern, for tests which script the
+// responses of an endpoint the fake does not otherwise serve. Requests must still be authenticated.
+func (s *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// authenticate rejects requests without a bearer token issued by the server, other than those
+// to the token endpoints.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/oauth/token" || r.URL.Path == "/api/v1/auth/token" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || !s.validToken(token) {
+			writeJSON(w, http.StatusUnauthorized, proError(http.StatusUnauthorized, "INVALID_TOKEN", ""))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleOAuthToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "client_credentials" ||
+		r.PostForm.Get("client_id") != s.ClientID ||
+		r.PostForm.Get("client_secret") != s.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": s.issueToken(),
+		"token_type":   "Bearer",
+		"expires_in":   int(tokenLifetime.Seconds()),
+		"scope":        "api-role:1",
+	})
+}
+
+func (s *Server) handleBasicAuthToken(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok || username != s.Username || password != s.Password {
+		writeJSON(w, http.StatusUnauthorized, proError(http.StatusUnauthorized, "INVALID_CREDENTIALS", ""))
+		return
+	}
+
+	token := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"token":   token,
+		"expires": s.tokenExpiry(token).UTC().Format(time.RFC3339),
+	})
+}
+
+func (s *Server) handleKeepAlive(w http.ResponseWriter, r *http.Request) {
+	s.revokeToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+
+	token := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"token":   token,
+		"expires": s.tokenExpiry(token).UTC().Format(time.RFC3339),
+	})
+}
+
+func (s *Server) handleInvalidateToken(w http.ResponseWriter, r *http.Request) {
+	s.revokeToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) issueToken() string {
+	buf := make([]byte, 32)
+	_, _ = rand.Read(buf)
+	token := hex.EncodeToString(buf)
+
+	s.tokensMu.Lock()
+	defer s.tokensMu.Unlock()
+
+	s.tokens[token] = time.Now().Add(tokenLifetime)
+	return token
+}
+
+func (s *Server) tokenExpiry(token string) time.Time {
+	s.tokensMu.Lock()
+	defer s.tokensMu.Unlock()
+
+	return s.tokens[token]
+}
+
+func (s *Server) validToken(token string) bool {
+	s.tokensMu.Lock()
+	defer s.tokensMu.Unlock()
+
+	expiry, ok := s.tokens[token]
+	return ok && time.Now().Before(expiry)
+}
+
+func (s *Server) revokeToken(token string) {
+	s.tokensMu.Lock()
+	defer s.tokensMu.Unlock()
+
+	delete(s.tokens, token)
+}
+
+// writeJSON writes a Pro API response.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeXML writes a Classic API response with the given root element.
+func writeXML(w http.ResponseWriter, status int, element string, body any) {
+	w.Header().Set("Content-Type", "application/xml;charset=UTF-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).EncodeElement(body, xml.StartElement{Name: xml.Name{Local: element}})
+}
+
+// writeClassicError writes a Classic API error, which Jamf Pro returns as an HTML page.
+func writeClassicError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<html><head><title>Status page</title></head><body><p>%s</p><p>Error: %s</p></body></html>", http.StatusText(status), message)
+}
+
+// proError returns a Pro API error body.
+func proError(status int, code, description string) map[string]any {
+	return map[string]any{
+		"httpStatus": status,
+		"errors": []map[string]any{{
+			"code":        code,
+			"description": description,
+		}},
+	}
+}
@@ -0,0 +1,188 @@
+package fakejamfpro
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// do sends a request with the given bearer token and returns the response status and body.
+func do(t *testing.T, s *Server, token, method, path, body string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(data)
+}
+
+// oauthToken obtains a token using the server's client credentials.
+func oauthToken(t *testing.T, s *Server) string {
+	t.Helper()
+
+	resp, err := http.PostForm(s.URL+"/api/v1/oauth/token", url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {s.ClientID},
+		"client_secret": {s.ClientSecret},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&token))
+	assert.Greater(t, token.ExpiresIn, 300)
+	return token.AccessToken
+}
+
+func TestAuthentication(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, _ := do(t, s, "", http.MethodGet, "/api/v1/jamf-pro-version", "")
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	resp, err := http.PostForm(s.URL+"/api/v1/oauth/token", url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {s.ClientID},
+		"client_secret": {"wrong"},
+	})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	status, body := do(t, s, oauthToken(t, s), http.MethodGet, "/api/v1/jamf-pro-version", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, Version)
+
+	req, err := http.NewRequest(http.MethodPost, s.URL+"/api/v1/auth/token", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth(s.Username, s.Password)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var basic struct {
+		Token string `json:"token"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&basic))
+
+	status, _ = do(t, s, basic.Token, http.MethodPost, "/api/v1/auth/invalidate-token", "")
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = do(t, s, basic.Token, http.MethodGet, "/api/v1/jamf-pro-version", "")
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestClassicResourceLifecycle(t *testing.T) {
+	s := New()
+	defer s.Close()
+	token := oauthToken(t, s)
+
+	status, body := do(t, s, token, http.MethodPost, "/JSSResource/policies/id/0", "<policy><general><name>first</name></general></policy>")
+	require.Equal(t, http.StatusCreated, status)
+	assert.Contains(t, body, "<policy><id>1</id></policy>")
+
+	status, _ = do(t, s, token, http.MethodPost, "/JSSResource/policies/id/0", "<policy><general><name>first</name></general></policy>")
+	assert.Equal(t, http.StatusConflict, status)
+
+	status, body = do(t, s, token, http.MethodPost, "/JSSResource/policies/id/0", "<policy><general><name>second</name></general></policy>")
+	require.Equal(t, http.StatusCreated, status)
+	assert.Contains(t, body, "<id>2</id>")
+
+	status, body = do(t, s, token, http.MethodGet, "/JSSResource/policies/name/second", "")
+	require.Equal(t, http.StatusOK, status)
+	var policy jamfpro.ResourcePolicy
+	require.NoError(t, xml.Unmarshal([]byte(body), &policy))
+	assert.Equal(t, 2, policy.General.ID)
+
+	status, _ = do(t, s, token, http.MethodPut, "/JSSResource/policies/id/2", "<policy><general><name>renamed</name></general></policy>")
+	assert.Equal(t, http.StatusCreated, status)
+
+	status, body = do(t, s, token, http.MethodGet, "/JSSResource/policies", "")
+	require.Equal(t, http.StatusOK, status)
+	var list jamfpro.ResponsePoliciesList
+	require.NoError(t, xml.Unmarshal([]byte(body), &list))
+	assert.Equal(t, 2, list.Size)
+	assert.Equal(t, "renamed", list.Policy[1].Name)
+
+	status, _ = do(t, s, token, http.MethodDelete, "/JSSResource/policies/id/1", "")
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = do(t, s, token, http.MethodGet, "/JSSResource/policies/id/1", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// IDs are never reused after deletion.
+	_, body = do(t, s, token, http.MethodPost, "/JSSResource/policies/id/0", "<policy><general><name>third</name></general></policy>")
+	assert.Contains(t, body, "<id>3</id>")
+}
+
+func TestClassicResourceNormalization(t *testing.T) {
+	s := New()
+	defer s.Close()
+	token := oauthToken(t, s)
+
+	status, _ := do(t, s, token, http.MethodPost, "/JSSResource/policies/id/0", "<policy><general><name>first</name><target_drive>/</target_drive></general></policy>")
+	require.Equal(t, http.StatusCreated, status)
+
+	_, body := do(t, s, token, http.MethodGet, "/JSSResource/policies/id/1", "")
+	var policy jamfpro.ResourcePolicy
+	require.NoError(t, xml.Unmarshal([]byte(body), &policy))
+	require.NotNil(t, policy.General.OverrideDefaultSettings)
+	assert.Equal(t, "/", policy.General.OverrideDefaultSettings.TargetDrive)
+	assert.Equal(t, "default", policy.General.OverrideDefaultSettings.DistributionPoint)
+	assert.Equal(t, -1, policy.General.Category.ID)
+	assert.Equal(t, "none", policy.DiskEncryption.Action)
+}
+
+func TestProResourcePagination(t *testing.T) {
+	s := New()
+	defer s.Close()
+	token := oauthToken(t, s)
+
+	for _, name := range []string{"a", "b", "c"} {
+		status, _ := do(t, s, token, http.MethodPost, "/api/v1/categories", `{"name":"`+name+`","priority":9}`)
+		require.Equal(t, http.StatusCreated, status)
+	}
+
+	status, _ := do(t, s, token, http.MethodPost, "/api/v1/categories", `{"name":"a","priority":9}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, body := do(t, s, token, http.MethodGet, "/api/v1/categories?page=1&page-size=2", "")
+	require.Equal(t, http.StatusOK, status)
+	var page jamfpro.ResponseCategoriesList
+	require.NoError(t, json.Unmarshal([]byte(body), &page))
+	assert.Equal(t, 3, page.TotalCount)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "3", page.Results[0].Id)
+
+	status, _ = do(t, s, token, http.MethodGet, "/api/v1/categories/9", "")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestUnimplementedEndpoint(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, _ := do(t, s, oauthToken(t, s), http.MethodGet, "/JSSResource/printers", "")
+	assert.Equal(t, http.StatusNotImplemented, status)
+}
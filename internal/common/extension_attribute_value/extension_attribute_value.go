@@ -0,0 +1,120 @@
+// Package extension_attribute_value validates the values set on the extension attributes of
+// computers and mobile devices against the definitions of the extension attributes, and formats
+// the IDs of the resources setting them.
+package extension_attribute_value
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Input types of an extension attribute whose values can be set.
+const (
+	InputTypeText  = "TEXT"
+	InputTypePopup = "POPUP"
+)
+
+// Data types of an extension attribute.
+const (
+	DataTypeString  = "STRING"
+	DataTypeInteger = "INTEGER"
+	DataTypeDate    = "DATE"
+)
+
+// dateLayouts are the layouts Jamf Pro accepts for the value of a date extension attribute.
+var dateLayouts = []string{"2006-01-02 15:04:05", "2006-01-02"}
+
+// Definition is the part of the definition of an extension attribute its values are checked
+// against.
+type Definition struct {
+	Name             string
+	InputType        string
+	DataType         string
+	PopupMenuChoices []string
+}
+
+// Validate returns an error when the value cannot be set on the extension attribute: its values are
+// reported by inventory rather than set, the value is not one of the choices of its pop-up menu, or
+// the value does not have its data type. An empty value, which clears the extension attribute, is
+// always valid for an extension attribute whose values can be set.
+func Validate(definition Definition, value string) error {
+	switch definition.InputType {
+	case InputTypeText, InputTypePopup:
+	default:
+		return fmt.Errorf("the values of extension attribute %q are reported by inventory, as its input type is %s; only %s and %s extension attributes can be set",
+			definition.Name, definition.InputType, InputTypeText, InputTypePopup)
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	if definition.InputType == InputTypePopup && !slices.Contains(definition.PopupMenuChoices, value) {
+		message := fmt.Sprintf("%q is not a choice of the pop-up menu of extension attribute %q, which are: %s",
+			value, definition.Name, quoteAll(definition.PopupMenuChoices))
+		for _, choice := range definition.PopupMenuChoices {
+			if strings.EqualFold(choice, value) {
+				message += fmt.Sprintf(". Did you mean %q?", choice)
+				break
+			}
+		}
+		return fmt.Errorf("%s", message)
+	}
+
+	switch definition.DataType {
+	case DataTypeInteger:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%q is not an integer, the data type of extension attribute %q", value, definition.Name)
+		}
+	case DataTypeDate:
+		for _, layout := range dateLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not a date of the form YYYY-MM-DD or YYYY-MM-DD hh:mm:ss, the data type of extension attribute %q", value, definition.Name)
+	}
+
+	return nil
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// FormatID returns the ID of the value of the extension attribute on the device, in the form
+// <device_id>:<extension_attribute_id>.
+func FormatID(deviceID, extensionAttributeID string) string {
+	return deviceID + ":" + extensionAttributeID
+}
+
+// ParseID returns the device ID and extension attribute ID of an ID returned by FormatID. When
+// serialNumbers is set, the device may also be given by its serial number, which is returned in
+// place of the device ID with isSerialNumber set, as in import IDs.
+func ParseID(id string, serialNumbers bool) (device, extensionAttributeID string, isSerialNumber bool, err error) {
+	device, extensionAttributeID, ok := strings.Cut(id, ":")
+	if !ok || device == "" || !numericID.MatchString(extensionAttributeID) {
+		if serialNumbers {
+			return "", "", false, fmt.Errorf("unexpected ID %q, expected <device_id>:<extension_attribute_id> or <serial_number>:<extension_attribute_id>", id)
+		}
+		return "", "", false, fmt.Errorf("unexpected ID %q, expected <device_id>:<extension_attribute_id>", id)
+	}
+
+	if numericID.MatchString(device) {
+		return device, extensionAttributeID, false, nil
+	}
+	if !serialNumbers {
+		return "", "", false, fmt.Errorf("unexpected device ID %q in ID %q", device, id)
+	}
+	return device, extensionAttributeID, true, nil
+}
@@ -0,0 +1,59 @@
+package extension_attribute_value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tier := Definition{Name: "Ownership Tier", InputType: InputTypePopup, DataType: DataTypeString, PopupMenuChoices: []string{"Corporate", "BYOD"}}
+
+	tests := map[string]struct {
+		definition Definition
+		value      string
+		err        string
+	}{
+		"pop-up choice":        {tier, "BYOD", ""},
+		"pop-up cleared":       {tier, "", ""},
+		"pop-up not a choice":  {tier, "Loaner", `"Loaner" is not a choice of the pop-up menu of extension attribute "Ownership Tier", which are: "Corporate", "BYOD"`},
+		"pop-up wrong case":    {tier, "byod", `Did you mean "BYOD"?`},
+		"text":                 {Definition{Name: "Owner", InputType: InputTypeText, DataType: DataTypeString}, "jane", ""},
+		"integer":              {Definition{Name: "Seat", InputType: InputTypeText, DataType: DataTypeInteger}, "12", ""},
+		"not an integer":       {Definition{Name: "Seat", InputType: InputTypeText, DataType: DataTypeInteger}, "12a", "is not an integer"},
+		"date":                 {Definition{Name: "Issued", InputType: InputTypeText, DataType: DataTypeDate}, "2024-03-01", ""},
+		"date and time":        {Definition{Name: "Issued", InputType: InputTypeText, DataType: DataTypeDate}, "2024-03-01 09:30:00", ""},
+		"not a date":           {Definition{Name: "Issued", InputType: InputTypeText, DataType: DataTypeDate}, "01/03/2024", "is not a date"},
+		"script":               {Definition{Name: "Battery", InputType: "SCRIPT"}, "", "are reported by inventory"},
+		"directory attributes": {Definition{Name: "Office", InputType: "DIRECTORY_SERVICE_ATTRIBUTE_MAPPING"}, "HQ", "are reported by inventory"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(tc.definition, tc.value)
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestParseID(t *testing.T) {
+	device, attribute, isSerialNumber, err := ParseID("12:5", false)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"12", "5", false}, []any{device, attribute, isSerialNumber})
+
+	device, attribute, isSerialNumber, err = ParseID("C02ABC123:5", true)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"C02ABC123", "5", true}, []any{device, attribute, isSerialNumber})
+
+	_, _, _, err = ParseID("C02ABC123:5", false)
+	assert.ErrorContains(t, err, "unexpected device ID")
+
+	_, _, _, err = ParseID("12", true)
+	assert.ErrorContains(t, err, "expected <device_id>:<extension_attribute_id> or <serial_number>")
+}
@@ -42,16 +42,7 @@ func DownloadFile(url string) (string, error) {
 	}
 	defer tmpFile.Close()
 
-	client := &http.Client{
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			if len(via) >= 10 {
-				return fmt.Errorf("too many redirects when attempting to download file from %s", url)
-			}
-			return nil
-		},
-	}
-
-	resp, err := client.Get(url)
+	resp, err := NewDownloadClient(url).Get(url)
 	if err != nil {
 		return "", fmt.Errorf("failed to download file from %s: %v", url, err)
 	}
@@ -62,6 +53,40 @@ func DownloadFile(url string) (string, error) {
 		return "", fmt.Errorf("failed to write to temporary file: %v", err)
 	}
 
+	finalFileName := ResponseFileName(resp)
+
+	finalPath := filepath.Join(os.TempDir(), finalFileName)
+
+	if !strings.HasPrefix(filepath.Clean(finalPath), os.TempDir()) {
+		return "", fmt.Errorf("security error: final path '%s' would be outside temporary directory", finalPath)
+	}
+
+	err = os.Rename(tmpFile.Name(), finalPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to rename temporary file to final destination: %v", err)
+	}
+
+	log.Printf("[INFO] File downloaded to: %s", finalPath)
+	return finalPath, nil
+}
+
+// NewDownloadClient returns an HTTP client for downloading from url, which follows up to 10
+// redirects.
+func NewDownloadClient(url string) *http.Client {
+	return &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("too many redirects when attempting to download file from %s", url)
+			}
+			return nil
+		},
+	}
+}
+
+// ResponseFileName returns the sanitized name of the file served by a download response, taken
+// from its Content-Disposition header, else from the final URL after redirects, else a
+// timestamp-based name.
+func ResponseFileName(resp *http.Response) string {
 	var finalFileName string
 
 	_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition"))
@@ -85,19 +110,7 @@ func DownloadFile(url string) (string, error) {
 		}
 	}
 
-	finalPath := filepath.Join(os.TempDir(), finalFileName)
-
-	if !strings.HasPrefix(filepath.Clean(finalPath), os.TempDir()) {
-		return "", fmt.Errorf("security error: final path '%s' would be outside temporary directory", finalPath)
-	}
-
-	err = os.Rename(tmpFile.Name(), finalPath)
-	if err != nil {
-		return "", fmt.Errorf("failed to rename temporary file to final destination: %v", err)
-	}
-
-	log.Printf("[INFO] File downloaded to: %s", finalPath)
-	return finalPath, nil
+	return finalFileName
 }
 
 // sanitizeFileName cleans and validates a filename for secure file operations
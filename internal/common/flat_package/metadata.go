@@ -0,0 +1,182 @@
+// Package flat_package reads the metadata of macOS flat installer packages: the identifiers,
+// versions and requirements declared in their Distribution and PackageInfo files, and the state
+// of their signature. Flat packages are xar archives, which are read in pure Go without
+// extracting the payload.
+package flat_package
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Metadata describes a flat package.
+type Metadata struct {
+	// Identifier and Version identify the product, or the component package of a package
+	// built with pkgbuild.
+	Identifier string
+	Version    string
+	// BundleIDs are the identifiers of the bundles the package installs.
+	BundleIDs []string
+	// MinimumOSVersion is the lowest macOS version the Distribution allows.
+	MinimumOSVersion string
+	// InstallLocation is where the first component package installs its payload.
+	InstallLocation string
+	// Signed reports whether the package has a signature, and SignatureValid whether it verifies
+	// with a Developer ID Installer certificate chaining to the Apple Root CA. SignatureError
+	// explains an invalid signature.
+	Signed         bool
+	SignatureValid bool
+	SignatureError string
+	// Notarized reports whether the package ends with a stapled notarization ticket trailer. The
+	// ticket is not verified, so it does not establish that Apple notarized the package.
+	Notarized bool
+	// Certificates is the signing certificate chain, leaf first.
+	Certificates []Certificate
+}
+
+// distribution is the part of a product archive's Distribution file describing the product.
+type distribution struct {
+	Product *struct {
+		ID      string `xml:"id,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"product"`
+	PkgRefs []struct {
+		ID      string `xml:"id,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"pkg-ref"`
+	OSVersions []osVersion `xml:"allowed-os-versions>os-version"`
+	Volume     []osVersion `xml:"volume-check>allowed-os-versions>os-version"`
+}
+
+type osVersion struct {
+	Min string `xml:"min,attr"`
+}
+
+// packageInfo is the part of a component package's PackageInfo file describing the package.
+type packageInfo struct {
+	Identifier      string   `xml:"identifier,attr"`
+	Version         string   `xml:"version,attr"`
+	InstallLocation string   `xml:"install-location,attr"`
+	Bundles         []bundle `xml:"bundle"`
+}
+
+type bundle struct {
+	ID      string   `xml:"id,attr"`
+	Bundles []bundle `xml:"bundle"`
+}
+
+// Inspect returns the metadata of the flat package at path.
+func Inspect(path string) (*Metadata, error) {
+	return inspect(path, []string{appleRootCAFingerprint})
+}
+
+// inspect returns the metadata of the flat package at path, accepting signatures which chain to a
+// root with one of the trusted fingerprints.
+func inspect(path string, trustedRoots []string) (*Metadata, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open package %q: %v", path, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat package %q: %v", path, err)
+	}
+
+	archive, err := openXar(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package %q: %v", path, err)
+	}
+
+	metadata := &Metadata{}
+
+	for _, f := range archive.findFiles("Distribution") {
+		data, err := archive.readFile(f)
+		if err != nil {
+			return nil, err
+		}
+		var dist distribution
+		if err := xml.Unmarshal(data, &dist); err != nil {
+			return nil, fmt.Errorf("failed to parse Distribution of %q: %v", path, err)
+		}
+		applyDistribution(metadata, dist)
+		break
+	}
+
+	for _, f := range archive.findFiles("PackageInfo") {
+		data, err := archive.readFile(f)
+		if err != nil {
+			return nil, err
+		}
+		var pkg packageInfo
+		if err := xml.Unmarshal(data, &pkg); err != nil {
+			return nil, fmt.Errorf("failed to parse PackageInfo of %q: %v", path, err)
+		}
+		applyPackageInfo(metadata, pkg)
+	}
+	slices.Sort(metadata.BundleIDs)
+	metadata.BundleIDs = slices.Compact(metadata.BundleIDs)
+
+	signature := checkSignature(archive, trustedRoots)
+	metadata.Signed = signature.signed
+	metadata.SignatureValid = signature.valid
+	metadata.Certificates = signature.certificates
+	if signature.err != nil {
+		metadata.SignatureError = signature.err.Error()
+	}
+	metadata.Notarized = hasStapledTicket(file, info.Size())
+
+	return metadata, nil
+}
+
+// applyDistribution sets the product identity and OS requirement declared by a Distribution.
+func applyDistribution(metadata *Metadata, dist distribution) {
+	if dist.Product != nil {
+		metadata.Identifier = dist.Product.ID
+		metadata.Version = dist.Product.Version
+	}
+	if metadata.Identifier == "" {
+		for _, ref := range dist.PkgRefs {
+			if ref.Version != "" {
+				metadata.Identifier, metadata.Version = ref.ID, ref.Version
+				break
+			}
+		}
+	}
+
+	for _, v := range append(dist.OSVersions, dist.Volume...) {
+		if v.Min != "" {
+			metadata.MinimumOSVersion = v.Min
+			break
+		}
+	}
+}
+
+// applyPackageInfo adds the bundles of a component package, and takes its identity and install
+// location when no earlier file set them.
+func applyPackageInfo(metadata *Metadata, pkg packageInfo) {
+	if metadata.Identifier == "" {
+		metadata.Identifier = pkg.Identifier
+		metadata.Version = pkg.Version
+	}
+	if metadata.InstallLocation == "" {
+		metadata.InstallLocation = pkg.InstallLocation
+	}
+	metadata.BundleIDs = append(metadata.BundleIDs, bundleIDs(pkg.Bundles)...)
+}
+
+// bundleIDs returns the identifiers of the bundles and of the bundles nested within them.
+func bundleIDs(bundles []bundle) []string {
+	var out []string
+	for _, b := range bundles {
+		if id := strings.TrimSpace(b.ID); id != "" {
+			out = append(out, id)
+		}
+		out = append(out, bundleIDs(b.Bundles)...)
+	}
+	return out
+}
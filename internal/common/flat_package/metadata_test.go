@@ -0,0 +1,283 @@
+package flat_package
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDistribution = `<?xml version="1.0" encoding="utf-8"?>
+<installer-gui-script minSpecVersion="2">
+  <title>Agent</title>
+  <product id="com.example.agent.product" version="3.1.0"/>
+  <volume-check>
+    <allowed-os-versions>
+      <os-version min="13.0"/>
+    </allowed-os-versions>
+  </volume-check>
+  <pkg-ref id="com.example.agent" version="3.1.0">#agent.pkg</pkg-ref>
+</installer-gui-script>`
+
+const testPackageInfo = `<?xml version="1.0" encoding="utf-8"?>
+<pkg-info format-version="2" identifier="com.example.agent" version="3.1.0" install-location="/Applications" auth="root">
+  <bundle id="com.example.agent.app" path="./Agent.app">
+    <bundle id="com.example.agent.helper" path="./Agent.app/Contents/Library/Helper.app"/>
+  </bundle>
+  <bundle id="com.example.agent.app" path="./Agent.app"/>
+</pkg-info>`
+
+// testMember is a file stored in a test archive, within dir when set.
+type testMember struct {
+	dir, name, content string
+}
+
+// testSigner holds a leaf certificate issued by a self-signed root.
+type testSigner struct {
+	key   *rsa.PrivateKey
+	chain []*x509.Certificate
+}
+
+// newTestSigner returns a signer whose leaf is marked as a Developer ID Installer certificate.
+func newTestSigner(t *testing.T) *testSigner {
+	t.Helper()
+	return newTestSignerWithLeafExtensions(t, []pkix.Extension{
+		{Id: developerIDInstallerOID, Critical: true, Value: []byte{0x05, 0x00}},
+	})
+}
+
+// newTestSignerWithLeafExtensions returns a signer whose leaf carries the extensions.
+func newTestSignerWithLeafExtensions(t *testing.T, extensions []pkix.Extension) *testSigner {
+	t.Helper()
+
+	rootKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root CA"},
+		NotBefore:             time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+
+	leafKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		Subject:         pkix.Name{CommonName: "Developer ID Installer: Example (TEAM123456)"},
+		NotBefore:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:        time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtraExtensions: extensions,
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, root, &leafKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(leafDER)
+	require.NoError(t, err)
+
+	return &testSigner{key: leafKey, chain: []*x509.Certificate{leaf, root}}
+}
+
+// buildTestPackage returns a xar archive holding the members, signed by signer when set.
+func buildTestPackage(t *testing.T, members []testMember, signer *testSigner) []byte {
+	t.Helper()
+
+	var heap bytes.Buffer
+	heap.Write(make([]byte, sha1.Size))
+	signatureSize := 0
+	if signer != nil {
+		signatureSize = signer.key.Size()
+		heap.Write(make([]byte, signatureSize))
+	}
+
+	var toc strings.Builder
+	toc.WriteString(`<?xml version="1.0" encoding="UTF-8"?><xar><toc><creation-time>2024-05-01T10:00:00</creation-time>`)
+	fmt.Fprintf(&toc, `<checksum style="sha1"><offset>0</offset><size>%d</size></checksum>`, sha1.Size)
+	if signer != nil {
+		fmt.Fprintf(&toc, `<signature style="RSA"><offset>%d</offset><size>%d</size>`, sha1.Size, signatureSize)
+		toc.WriteString(`<KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#"><X509Data>`)
+		for _, cert := range signer.chain {
+			fmt.Fprintf(&toc, `<X509Certificate>%s</X509Certificate>`, base64.StdEncoding.EncodeToString(cert.Raw))
+		}
+		toc.WriteString(`</X509Data></KeyInfo></signature>`)
+	}
+
+	writeMember := func(m testMember) {
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write([]byte(m.content))
+		zw.Close()
+		fmt.Fprintf(&toc, `<file><name>%s</name><type>file</type><data><length>%d</length><offset>%d</offset><size>%d</size>`+
+			`<encoding style="application/x-gzip"/></data></file>`, m.name, compressed.Len(), heap.Len(), len(m.content))
+		heap.Write(compressed.Bytes())
+	}
+	dirs := map[string][]testMember{}
+	var dirOrder []string
+	for _, m := range members {
+		if m.dir == "" {
+			writeMember(m)
+			continue
+		}
+		if _, ok := dirs[m.dir]; !ok {
+			dirOrder = append(dirOrder, m.dir)
+		}
+		dirs[m.dir] = append(dirs[m.dir], m)
+	}
+	for _, dir := range dirOrder {
+		fmt.Fprintf(&toc, `<file><name>%s</name><type>directory</type>`, dir)
+		for _, m := range dirs[dir] {
+			writeMember(m)
+		}
+		toc.WriteString(`</file>`)
+	}
+	toc.WriteString(`</toc></xar>`)
+
+	var tocCompressed bytes.Buffer
+	zw := zlib.NewWriter(&tocCompressed)
+	zw.Write([]byte(toc.String()))
+	zw.Close()
+
+	digest := sha1.Sum(tocCompressed.Bytes())
+	heapBytes := heap.Bytes()
+	copy(heapBytes, digest[:])
+	if signer != nil {
+		signature, err := rsa.SignPKCS1v15(rand.Reader, signer.key, crypto.SHA1, digest[:])
+		require.NoError(t, err)
+		copy(heapBytes[sha1.Size:], signature)
+	}
+
+	var out bytes.Buffer
+	binary.Write(&out, binary.BigEndian, xarHeader{
+		Magic:                 xarMagic,
+		Size:                  28,
+		Version:               1,
+		TOCLengthCompressed:   uint64(tocCompressed.Len()),
+		TOCLengthUncompressed: uint64(toc.Len()),
+		ChecksumAlgorithm:     1,
+	})
+	out.Write(tocCompressed.Bytes())
+	out.Write(heapBytes)
+	return out.Bytes()
+}
+
+func writeTestPackage(t *testing.T, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.pkg")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func productMembers() []testMember {
+	return []testMember{
+		{name: "Distribution", content: testDistribution},
+		{dir: "agent.pkg", name: "PackageInfo", content: testPackageInfo},
+	}
+}
+
+func TestInspectSignedProductArchive(t *testing.T) {
+	signer := newTestSigner(t)
+	path := writeTestPackage(t, buildTestPackage(t, productMembers(), signer))
+
+	metadata, err := inspect(path, []string{fingerprintSHA256(signer.chain[1])})
+	require.NoError(t, err)
+
+	assert.Equal(t, "com.example.agent.product", metadata.Identifier)
+	assert.Equal(t, "3.1.0", metadata.Version)
+	assert.Equal(t, "13.0", metadata.MinimumOSVersion)
+	assert.Equal(t, "/Applications", metadata.InstallLocation)
+	assert.Equal(t, []string{"com.example.agent.app", "com.example.agent.helper"}, metadata.BundleIDs)
+	assert.True(t, metadata.Signed)
+	assert.True(t, metadata.SignatureValid, metadata.SignatureError)
+	assert.False(t, metadata.Notarized)
+	require.Len(t, metadata.Certificates, 2)
+	assert.Equal(t, "CN=Developer ID Installer: Example (TEAM123456)", metadata.Certificates[0].Subject)
+	assert.Equal(t, "CN=Test Root CA", metadata.Certificates[0].Issuer)
+	assert.Equal(t, "2026-01-01T00:00:00Z", metadata.Certificates[0].NotAfter)
+}
+
+func TestInspectUntrustedRoot(t *testing.T) {
+	path := writeTestPackage(t, buildTestPackage(t, productMembers(), newTestSigner(t)))
+
+	metadata, err := Inspect(path)
+	require.NoError(t, err)
+
+	assert.True(t, metadata.Signed)
+	assert.False(t, metadata.SignatureValid)
+	assert.Contains(t, metadata.SignatureError, "not trusted")
+}
+
+func TestInspectRequiresDeveloperIDInstallerLeaf(t *testing.T) {
+	signer := newTestSignerWithLeafExtensions(t, nil)
+	path := writeTestPackage(t, buildTestPackage(t, productMembers(), signer))
+
+	metadata, err := inspect(path, []string{fingerprintSHA256(signer.chain[1])})
+	require.NoError(t, err)
+
+	assert.True(t, metadata.Signed)
+	assert.False(t, metadata.SignatureValid)
+	assert.Contains(t, metadata.SignatureError, "not a Developer ID Installer certificate")
+}
+
+func TestInspectTamperedSignature(t *testing.T) {
+	signer := newTestSigner(t)
+	data := buildTestPackage(t, productMembers(), signer)
+
+	// Flip a byte of the checksum stored at the start of the heap.
+	var header xarHeader
+	require.NoError(t, binary.Read(bytes.NewReader(data), binary.BigEndian, &header))
+	data[int(header.Size)+int(header.TOCLengthCompressed)] ^= 0xff
+
+	metadata, err := inspect(writeTestPackage(t, data), []string{fingerprintSHA256(signer.chain[1])})
+	require.NoError(t, err)
+
+	assert.True(t, metadata.Signed)
+	assert.False(t, metadata.SignatureValid)
+	assert.Contains(t, metadata.SignatureError, "checksum")
+}
+
+func TestInspectUnsignedComponentPackage(t *testing.T) {
+	data := buildTestPackage(t, []testMember{{name: "PackageInfo", content: testPackageInfo}}, nil)
+	data = append(data, []byte("ticket")...)
+	data = append(data, []byte(ticketTrailerMagic)...)
+	data = binary.BigEndian.AppendUint16(data, 1)
+	data = binary.BigEndian.AppendUint16(data, 1)
+	data = binary.BigEndian.AppendUint32(data, 6)
+
+	metadata, err := Inspect(writeTestPackage(t, data))
+	require.NoError(t, err)
+
+	assert.Equal(t, "com.example.agent", metadata.Identifier)
+	assert.Equal(t, "3.1.0", metadata.Version)
+	assert.Empty(t, metadata.MinimumOSVersion)
+	assert.False(t, metadata.Signed)
+	assert.False(t, metadata.SignatureValid)
+	assert.Empty(t, metadata.SignatureError)
+	assert.True(t, metadata.Notarized)
+}
+
+func TestInspectNotFlatPackage(t *testing.T) {
+	_, err := Inspect(writeTestPackage(t, []byte("this is a disk image")))
+	assert.ErrorContains(t, err, "not a flat package")
+}
@@ -0,0 +1,233 @@
+package flat_package
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/md5"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// appleRootCAFingerprint is the SHA-256 fingerprint of the Apple Root CA certificate, which
+// anchors Developer ID Installer certificates.
+const appleRootCAFingerprint = "b0b1730ecbc7ff4505142c49f1295e6eda6bcaed7e2c68c5be91b5a11001f024"
+
+// developerIDInstallerOID is the extension marking a Developer ID Installer certificate, which
+// Apple issues for signing packages distributed outside the App Store.
+var developerIDInstallerOID = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 1, 14}
+
+// ticketTrailerMagic ends a flat package with a stapled notarization ticket.
+const ticketTrailerMagic = "t8lr"
+
+// Certificate describes a certificate of the signing chain.
+type Certificate struct {
+	Subject           string
+	Issuer            string
+	SHA256Fingerprint string
+	NotAfter          string
+}
+
+// signatureResult is the outcome of checking a package signature.
+type signatureResult struct {
+	signed       bool
+	valid        bool
+	certificates []Certificate
+	err          error
+}
+
+// checkSignature verifies the archive's table of contents checksum, its RSA signature and that the
+// signing chain leads to a root with one of the trusted fingerprints. A package without a
+// signature is reported as unsigned rather than as an error.
+func checkSignature(a *xarArchive, trustedRoots []string) signatureResult {
+	sig := a.toc.Signature
+	if sig == nil {
+		return signatureResult{}
+	}
+
+	result := signatureResult{signed: true}
+
+	certs := make([]*x509.Certificate, 0, len(sig.Certificates))
+	for _, encoded := range sig.Certificates {
+		der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+		if err != nil {
+			result.err = fmt.Errorf("failed to decode signing certificate: %v", err)
+			return result
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			result.err = fmt.Errorf("failed to parse signing certificate: %v", err)
+			return result
+		}
+		certs = append(certs, cert)
+		result.certificates = append(result.certificates, describeCertificate(cert))
+	}
+	if len(certs) == 0 {
+		result.err = fmt.Errorf("signature has no certificates")
+		return result
+	}
+
+	digest, hashAlg, err := verifyTOCChecksum(a)
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	signature, err := a.readHeap(sig.Offset, sig.Size)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	pub, ok := certs[0].PublicKey.(*rsa.PublicKey)
+	if !ok {
+		result.err = fmt.Errorf("signing certificate does not have an RSA key")
+		return result
+	}
+	if err := rsa.VerifyPKCS1v15(pub, hashAlg, digest, signature); err != nil {
+		result.err = fmt.Errorf("signature does not match the table of contents: %v", err)
+		return result
+	}
+
+	if err := verifyChain(certs, trustedRoots, signingTime(a)); err != nil {
+		result.err = err
+		return result
+	}
+
+	result.valid = true
+	return result
+}
+
+// verifyTOCChecksum checks the checksum stored in the heap against the compressed table of
+// contents, returning the checksum and its hash algorithm.
+func verifyTOCChecksum(a *xarArchive) ([]byte, crypto.Hash, error) {
+	ref := a.toc.Checksum
+	if ref == nil {
+		return nil, 0, fmt.Errorf("table of contents has no checksum")
+	}
+
+	var h hash.Hash
+	var alg crypto.Hash
+	switch strings.ToLower(ref.Style) {
+	case "sha1":
+		h, alg = sha1.New(), crypto.SHA1
+	case "sha256":
+		h, alg = sha256.New(), crypto.SHA256
+	case "sha512":
+		h, alg = sha512.New(), crypto.SHA512
+	case "md5":
+		h, alg = md5.New(), crypto.MD5
+	default:
+		return nil, 0, fmt.Errorf("unsupported table of contents checksum %q", ref.Style)
+	}
+	h.Write(a.tocRaw)
+	digest := h.Sum(nil)
+
+	stored, err := a.readHeap(ref.Offset, ref.Size)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !bytes.Equal(stored, digest) {
+		return nil, 0, fmt.Errorf("table of contents checksum does not match")
+	}
+
+	return digest, alg, nil
+}
+
+// verifyChain verifies the leaf certificate, certs[0], against the other certificates of the
+// chain, at the time the package was signed. The leaf must be a Developer ID Installer
+// certificate, and only certificates of the chain with a trusted fingerprint are accepted as roots.
+func verifyChain(certs []*x509.Certificate, trustedRoots []string, at time.Time) error {
+	leaf := *certs[0]
+	if !slices.ContainsFunc(leaf.Extensions, func(ext pkix.Extension) bool { return ext.Id.Equal(developerIDInstallerOID) }) {
+		return fmt.Errorf("signing certificate %q is not a Developer ID Installer certificate", leaf.Subject.CommonName)
+	}
+	// Apple may mark the extension critical, which x509 would otherwise reject as unhandled.
+	leaf.UnhandledCriticalExtensions = slices.DeleteFunc(slices.Clone(leaf.UnhandledCriticalExtensions), func(oid asn1.ObjectIdentifier) bool {
+		return oid.Equal(developerIDInstallerOID)
+	})
+
+	roots := x509.NewCertPool()
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		if isTrusted(cert, trustedRoots) {
+			roots.AddCert(cert)
+		} else {
+			intermediates.AddCert(cert)
+		}
+	}
+
+	// Developer ID Installer certificates carry Apple's own package signing usage, which x509
+	// does not know, so extended key usage is not checked.
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("signing certificate chain is not trusted: %v", err)
+	}
+	return nil
+}
+
+// isTrusted reports whether the certificate has one of the trusted fingerprints.
+func isTrusted(cert *x509.Certificate, trustedRoots []string) bool {
+	fingerprint := fingerprintSHA256(cert)
+	for _, trusted := range trustedRoots {
+		if strings.EqualFold(fingerprint, trusted) {
+			return true
+		}
+	}
+	return false
+}
+
+// signingTime returns the archive's creation time, at which the signing certificates must have
+// been valid, or the current time when the archive does not record it.
+func signingTime(a *xarArchive) time.Time {
+	if t, err := time.Parse("2006-01-02T15:04:05", strings.TrimSuffix(a.toc.CreationTime, "Z")); err == nil {
+		return t
+	}
+	return time.Now()
+}
+
+// hasStapledTicket reports whether the package ends with the trailer of a stapled notarization
+// ticket. Only the trailer is looked for: the ticket is neither parsed nor checked with Apple, so
+// a package can claim one without being notarized.
+func hasStapledTicket(r io.ReaderAt, size int64) bool {
+	if size < 12 {
+		return false
+	}
+	trailer := make([]byte, 12)
+	if _, err := r.ReadAt(trailer, size-12); err != nil {
+		return false
+	}
+	return string(trailer[:4]) == ticketTrailerMagic
+}
+
+// describeCertificate returns the description of a certificate.
+func describeCertificate(cert *x509.Certificate) Certificate {
+	return Certificate{
+		Subject:           cert.Subject.String(),
+		Issuer:            cert.Issuer.String(),
+		SHA256Fingerprint: fingerprintSHA256(cert),
+		NotAfter:          cert.NotAfter.UTC().Format(time.RFC3339),
+	}
+}
+
+// fingerprintSHA256 returns the hex encoded SHA-256 fingerprint of a certificate.
+func fingerprintSHA256(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
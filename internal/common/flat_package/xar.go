@@ -0,0 +1,183 @@
+package flat_package
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// xarMagic opens every xar archive, the container format of flat packages.
+const xarMagic = 0x78617221 // "xar!"
+
+// maxMemberSize bounds the size of the archive members read into memory. Only the small XML
+// members describing the package are read, never the payload.
+const maxMemberSize = 16 << 20
+
+// xarHeader is the fixed part of the big-endian xar header.
+type xarHeader struct {
+	Magic                 uint32
+	Size                  uint16
+	Version               uint16
+	TOCLengthCompressed   uint64
+	TOCLengthUncompressed uint64
+	ChecksumAlgorithm     uint32
+}
+
+// xarTOC is the table of contents of a xar archive.
+type xarTOC struct {
+	CreationTime string        `xml:"toc>creation-time"`
+	Checksum     *xarHeapRef   `xml:"toc>checksum"`
+	Signature    *xarSignature `xml:"toc>signature"`
+	Files        []xarFile     `xml:"toc>file"`
+}
+
+// xarHeapRef locates data in the heap following the table of contents.
+type xarHeapRef struct {
+	Style  string `xml:"style,attr"`
+	Offset int64  `xml:"offset"`
+	Size   int64  `xml:"size"`
+}
+
+// xarSignature is the RSA signature of the table of contents checksum, with the signing
+// certificate chain, leaf first.
+type xarSignature struct {
+	xarHeapRef
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+// xarFile is a file or directory in the archive.
+type xarFile struct {
+	Name  string    `xml:"name"`
+	Type  string    `xml:"type"`
+	Data  *xarData  `xml:"data"`
+	Files []xarFile `xml:"file"`
+}
+
+// xarData locates the archived content of a file.
+type xarData struct {
+	Length   int64 `xml:"length"`
+	Offset   int64 `xml:"offset"`
+	Size     int64 `xml:"size"`
+	Encoding struct {
+		Style string `xml:"style,attr"`
+	} `xml:"encoding"`
+}
+
+// xarArchive is an opened xar archive.
+type xarArchive struct {
+	r          io.ReaderAt
+	toc        xarTOC
+	tocRaw     []byte
+	heapOffset int64
+}
+
+// openXar reads the header and table of contents of the xar archive in r.
+func openXar(r io.ReaderAt) (*xarArchive, error) {
+	var header xarHeader
+	err := binary.Read(io.NewSectionReader(r, 0, 28), binary.BigEndian, &header)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("not a flat package: file is too short")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read xar header: %v", err)
+	}
+	if header.Magic != xarMagic {
+		return nil, fmt.Errorf("not a flat package: missing xar header")
+	}
+	if header.TOCLengthCompressed > maxMemberSize || header.TOCLengthUncompressed > maxMemberSize {
+		return nil, fmt.Errorf("xar table of contents is too large")
+	}
+
+	tocRaw := make([]byte, header.TOCLengthCompressed)
+	if _, err := r.ReadAt(tocRaw, int64(header.Size)); err != nil {
+		return nil, fmt.Errorf("failed to read xar table of contents: %v", err)
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(tocRaw))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress xar table of contents: %v", err)
+	}
+	defer zr.Close()
+
+	var toc xarTOC
+	if err := xml.NewDecoder(io.LimitReader(zr, maxMemberSize)).Decode(&toc); err != nil {
+		return nil, fmt.Errorf("failed to parse xar table of contents: %v", err)
+	}
+
+	return &xarArchive{
+		r:          r,
+		toc:        toc,
+		tocRaw:     tocRaw,
+		heapOffset: int64(header.Size) + int64(header.TOCLengthCompressed),
+	}, nil
+}
+
+// readHeap returns size bytes of the heap from offset.
+func (a *xarArchive) readHeap(offset, size int64) ([]byte, error) {
+	if size < 0 || size > maxMemberSize {
+		return nil, fmt.Errorf("xar heap entry of %d bytes is too large", size)
+	}
+	data := make([]byte, size)
+	if _, err := a.r.ReadAt(data, a.heapOffset+offset); err != nil {
+		return nil, fmt.Errorf("failed to read xar heap: %v", err)
+	}
+	return data, nil
+}
+
+// readFile returns the extracted content of an archived file.
+func (a *xarArchive) readFile(f xarFile) ([]byte, error) {
+	if f.Data == nil {
+		return nil, fmt.Errorf("xar member %q has no data", f.Name)
+	}
+	if f.Data.Size > maxMemberSize {
+		return nil, fmt.Errorf("xar member %q is too large", f.Name)
+	}
+
+	raw, err := a.readHeap(f.Data.Offset, f.Data.Length)
+	if err != nil {
+		return nil, err
+	}
+
+	var r io.Reader
+	switch f.Data.Encoding.Style {
+	case "", "application/octet-stream":
+		return raw, nil
+	case "application/x-gzip":
+		zr, err := zlib.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress xar member %q: %v", f.Name, err)
+		}
+		defer zr.Close()
+		r = zr
+	case "application/x-bzip2":
+		r = bzip2.NewReader(bytes.NewReader(raw))
+	default:
+		return nil, fmt.Errorf("xar member %q has unsupported encoding %q", f.Name, f.Data.Encoding.Style)
+	}
+
+	return io.ReadAll(io.LimitReader(r, maxMemberSize))
+}
+
+// findFiles returns the files named name at the top level of the archive, or one directory down,
+// where product archives keep their component packages.
+func (a *xarArchive) findFiles(name string) []xarFile {
+	var out []xarFile
+	for _, f := range a.toc.Files {
+		if f.Name == name && f.Type == "file" {
+			out = append(out, f)
+		}
+		if f.Type == "directory" {
+			for _, child := range f.Files {
+				if child.Name == name && child.Type == "file" {
+					out = append(out, child)
+				}
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,274 @@
+package inventory_preload
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Sheet is a parsed inventory preload CSV: the fields and extension attributes it has columns for,
+// and a record per row. Fields without a column are left alone when records are updated.
+type Sheet struct {
+	Fields              []Field
+	ExtensionAttributes []string
+	Rows                []Record
+}
+
+// ParseCSV parses an inventory preload CSV. Headers are matched to fields ignoring case, spaces
+// and underscores, so both the headers of Jamf Pro's CSV template, e.g. "Serial Number", and the
+// attribute names, e.g. "serial_number", are accepted. Any other header is taken as the name of an
+// extension attribute, as in Jamf Pro's CSV import. A Serial Number column is required, and each
+// serial number may only appear once.
+func ParseCSV(content string) (*Sheet, error) {
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(content, "\ufeff")))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("the CSV is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CSV header: %v", err)
+	}
+
+	sheet := &Sheet{}
+	columns := make([]func(*Record, string), len(header))
+	seen := map[string]bool{}
+	hasSerialNumber := false
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		key := headerKey(name)
+		if key == "" {
+			return nil, fmt.Errorf("column %d of the CSV has no header", i+1)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("the CSV has more than one %q column", name)
+		}
+		seen[key] = true
+
+		if field, ok := fieldByHeader(key); ok {
+			sheet.Fields = append(sheet.Fields, field)
+			columns[i] = func(record *Record, value string) { *field.Value(record) = value }
+			hasSerialNumber = hasSerialNumber || field.Attribute == "serial_number"
+			continue
+		}
+
+		sheet.ExtensionAttributes = append(sheet.ExtensionAttributes, name)
+		columns[i] = func(record *Record, value string) { record.SetExtensionAttribute(name, value) }
+	}
+
+	if !hasSerialNumber {
+		return nil, fmt.Errorf("the CSV has no Serial Number column")
+	}
+
+	lines := map[string]int{}
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CSV: %v", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(values) > len(header) {
+			return nil, fmt.Errorf("line %d of the CSV has %d values, but there are only %d columns", line, len(values), len(header))
+		}
+
+		var record Record
+		for i := range header {
+			value := ""
+			if i < len(values) {
+				value = strings.TrimSpace(values[i])
+			}
+			columns[i](&record, value)
+		}
+
+		if record.SerialNumber == "" {
+			return nil, fmt.Errorf("line %d of the CSV has no serial number", line)
+		}
+		serialKey := strings.ToUpper(record.SerialNumber)
+		if previous, ok := lines[serialKey]; ok {
+			return nil, fmt.Errorf("serial number %q is on both line %d and line %d of the CSV", record.SerialNumber, previous, line)
+		}
+		lines[serialKey] = line
+
+		if slices.ContainsFunc(sheet.Fields, func(f Field) bool { return f.Attribute == "device_type" }) {
+			deviceType, err := normalizeDeviceType(record.DeviceType)
+			if err != nil {
+				return nil, fmt.Errorf("line %d of the CSV: %v", line, err)
+			}
+			record.DeviceType = deviceType
+		}
+
+		sheet.Rows = append(sheet.Rows, record)
+	}
+
+	return sheet, nil
+}
+
+// headerKey returns the header in the form headers are matched in.
+func headerKey(header string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(header))
+}
+
+func fieldByHeader(key string) (Field, bool) {
+	for _, field := range Fields {
+		if key == headerKey(field.Column) || key == headerKey(field.Attribute) {
+			return field, true
+		}
+	}
+	return Field{}, false
+}
+
+// normalizeDeviceType returns the device type as Jamf Pro spells it. A blank device type is
+// Unknown.
+func normalizeDeviceType(deviceType string) (string, error) {
+	if deviceType == "" {
+		return DeviceTypeUnknown, nil
+	}
+	for _, known := range DeviceTypes {
+		if strings.EqualFold(deviceType, known) {
+			return known, nil
+		}
+	}
+	return "", fmt.Errorf("device type %q is not one of %s", deviceType, strings.Join(DeviceTypes, ", "))
+}
+
+// Merge returns the record with the fields and extension attributes the sheet has columns for set
+// from the row. The serial number of the base, which matched the row's ignoring case, is kept. A
+// new record, with no base, has the Unknown device type unless the sheet sets one.
+func (s *Sheet) Merge(base *Record, row *Record) Record {
+	out := Record{DeviceType: DeviceTypeUnknown}
+	if base != nil {
+		out = *base
+		out.ExtensionAttributes = slices.Clone(base.ExtensionAttributes)
+	}
+
+	for _, field := range s.Fields {
+		if base != nil && field.Attribute == "serial_number" {
+			continue
+		}
+		*field.Value(&out) = *field.Value(row)
+	}
+	for _, name := range s.ExtensionAttributes {
+		out.SetExtensionAttribute(name, row.ExtensionAttributeValue(name))
+	}
+
+	return out
+}
+
+// Changes are the requests bringing Jamf Pro in line with a sheet.
+type Changes struct {
+	// Create holds the records of rows whose serial number has no record.
+	Create []Record
+	// Update holds the records of rows that differ from their record, with the record's ID.
+	Update []Record
+	// Delete holds the records of serial numbers no longer in the sheet.
+	Delete []Record
+	// Unchanged holds the IDs of rows matching their record, by serial number.
+	Unchanged map[string]string
+}
+
+// Diff returns the changes bringing the records in line with the sheet. Records of serial numbers
+// not in the sheet are only deleted when they are among the managed serial numbers, so records
+// added in other ways are left alone.
+func (s *Sheet) Diff(records []Record, managed []string) Changes {
+	bySerial := indexBySerialNumber(records)
+	changes := Changes{Unchanged: map[string]string{}}
+
+	inSheet := map[string]bool{}
+	for i := range s.Rows {
+		row := &s.Rows[i]
+		key := strings.ToUpper(row.SerialNumber)
+		inSheet[key] = true
+
+		existing, ok := bySerial[key]
+		if !ok {
+			changes.Create = append(changes.Create, s.Merge(nil, row))
+			continue
+		}
+
+		merged := s.Merge(existing, row)
+		if Equal(&merged, existing) {
+			changes.Unchanged[row.SerialNumber] = existing.ID
+			continue
+		}
+		changes.Update = append(changes.Update, merged)
+	}
+
+	for _, serialNumber := range managed {
+		key := strings.ToUpper(serialNumber)
+		if existing, ok := bySerial[key]; ok && !inSheet[key] {
+			changes.Delete = append(changes.Delete, *existing)
+			delete(bySerial, key)
+		}
+	}
+
+	return changes
+}
+
+// Digest returns a hash of the values the sheet sets, which is equal to ProjectDigest of records
+// holding the same values.
+func (s *Sheet) Digest() string {
+	rows := make(map[string]*Record, len(s.Rows))
+	for i := range s.Rows {
+		rows[strings.ToUpper(s.Rows[i].SerialNumber)] = &s.Rows[i]
+	}
+	return s.digest(rows)
+}
+
+// ProjectDigest returns a hash of the values of the records for the columns and serial numbers
+// of the sheet. It differs from Digest when a record is missing, or any value differs from the
+// sheet.
+func (s *Sheet) ProjectDigest(records []Record) string {
+	return s.digest(indexBySerialNumber(records))
+}
+
+func (s *Sheet) digest(records map[string]*Record) string {
+	keys := make([]string, 0, len(s.Rows))
+	for _, row := range s.Rows {
+		keys = append(keys, strings.ToUpper(row.SerialNumber))
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	writer := csv.NewWriter(hash)
+	for _, key := range keys {
+		record, ok := records[key]
+		if !ok {
+			_ = writer.Write([]string{key, "\x00missing"})
+			continue
+		}
+
+		values := []string{key}
+		for _, field := range s.Fields {
+			if field.Attribute != "serial_number" {
+				values = append(values, *field.Value(record))
+			}
+		}
+		for _, name := range s.ExtensionAttributes {
+			values = append(values, record.ExtensionAttributeValue(name))
+		}
+		_ = writer.Write(values)
+	}
+	writer.Flush()
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// indexBySerialNumber returns the records keyed by their upper case serial number.
+func indexBySerialNumber(records []Record) map[string]*Record {
+	out := make(map[string]*Record, len(records))
+	for i := range records {
+		out[strings.ToUpper(records[i].SerialNumber)] = &records[i]
+	}
+	return out
+}
@@ -0,0 +1,99 @@
+package inventory_preload
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV(t *testing.T) {
+	sheet, err := ParseCSV("\ufeffSerial Number,device_type,Full Name,Cost Center\n" +
+		"C02ABC123, computer ,Jane Appleseed,R&D\n" +
+		"\n" +
+		"DMP0001,,John Appleseed\n")
+	require.NoError(t, err)
+
+	require.Len(t, sheet.Fields, 3)
+	assert.Equal(t, "full_name", sheet.Fields[2].Attribute)
+	assert.Equal(t, []string{"Cost Center"}, sheet.ExtensionAttributes)
+
+	require.Len(t, sheet.Rows, 2)
+	assert.Equal(t, "C02ABC123", sheet.Rows[0].SerialNumber)
+	assert.Equal(t, DeviceTypeComputer, sheet.Rows[0].DeviceType)
+	assert.Equal(t, "R&D", sheet.Rows[0].ExtensionAttributeValue("cost center"))
+	assert.Equal(t, DeviceTypeUnknown, sheet.Rows[1].DeviceType, "a blank device type is Unknown")
+	assert.Equal(t, "", sheet.Rows[1].ExtensionAttributeValue("Cost Center"), "missing trailing values are blank")
+}
+
+func TestParseCSVErrors(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		err     string
+	}{
+		"empty":              {"", "the CSV is empty"},
+		"no serial number":   {"Username\njane\n", "no Serial Number column"},
+		"duplicate column":   {"Serial Number,serial_number\nA,A\n", `more than one "serial_number" column`},
+		"blank serial":       {"Serial Number,Username\n,jane\n", "line 2 of the CSV has no serial number"},
+		"duplicate serial":   {"Serial Number\nabc\nABC\n", "on both line 2 and line 3"},
+		"unknown type":       {"Serial Number,Device Type\nabc,Laptop\n", `device type "Laptop"`},
+		"too many values":    {"Serial Number\nabc,def\n", "line 2 of the CSV has 2 values"},
+		"unterminated quote": {"Serial Number\n\"abc\n", "failed to read the CSV"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseCSV(tc.content)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	sheet, err := ParseCSV("Serial Number,Username,Cost Center\nAAA,jane,R&D\nBBB,john,Sales\nCCC,kate,\n")
+	require.NoError(t, err)
+
+	records := []Record{
+		{ID: "1", SerialNumber: "aaa", DeviceType: DeviceTypeComputer, Username: "jane", Room: "4.01",
+			ExtensionAttributes: []ExtensionAttribute{{Name: "Cost Center", Value: "R&D"}}},
+		{ID: "2", SerialNumber: "BBB", DeviceType: DeviceTypeComputer, Username: "jim", Room: "2.10"},
+		{ID: "3", SerialNumber: "DDD", DeviceType: DeviceTypeUnknown},
+		{ID: "4", SerialNumber: "EEE", DeviceType: DeviceTypeUnknown},
+	}
+
+	changes := sheet.Diff(records, []string{"AAA", "DDD", "FFF"})
+
+	assert.Equal(t, map[string]string{"AAA": "1"}, changes.Unchanged, "serial numbers match ignoring case")
+
+	require.Len(t, changes.Update, 1)
+	assert.Equal(t, "2", changes.Update[0].ID)
+	assert.Equal(t, "john", changes.Update[0].Username)
+	assert.Equal(t, "2.10", changes.Update[0].Room, "fields without a column are kept")
+	assert.Equal(t, DeviceTypeComputer, changes.Update[0].DeviceType)
+	assert.Equal(t, "Sales", changes.Update[0].ExtensionAttributeValue("Cost Center"))
+
+	require.Len(t, changes.Create, 1)
+	assert.Equal(t, "CCC", changes.Create[0].SerialNumber)
+	assert.Equal(t, DeviceTypeUnknown, changes.Create[0].DeviceType)
+
+	require.Len(t, changes.Delete, 1, "only managed records no longer in the sheet are deleted")
+	assert.Equal(t, "3", changes.Delete[0].ID)
+}
+
+func TestDigest(t *testing.T) {
+	sheet, err := ParseCSV("Serial Number,Username,Asset\nBBB,john,\nAAA,jane,A-1\n")
+	require.NoError(t, err)
+
+	records := []Record{
+		{ID: "1", SerialNumber: "AAA", Username: "jane", Room: "4.01",
+			ExtensionAttributes: []ExtensionAttribute{{Name: "asset", Value: "A-1"}}},
+		{ID: "2", SerialNumber: "bbb", Username: "john"},
+	}
+	assert.Equal(t, sheet.Digest(), sheet.ProjectDigest(records), "values without a column are ignored")
+
+	records[1].Username = "jim"
+	assert.NotEqual(t, sheet.Digest(), sheet.ProjectDigest(records))
+
+	assert.NotEqual(t, sheet.Digest(), sheet.ProjectDigest(records[:1]), "a missing record changes the digest")
+}
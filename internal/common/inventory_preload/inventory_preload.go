@@ -0,0 +1,273 @@
+// Package inventory_preload reads and writes Jamf Pro inventory preload records, which hold the
+// user, location and purchasing details Jamf Pro applies to a computer or mobile device, matched
+// by serial number, when it enrolls or next submits inventory.
+//
+// The SDK has no support for inventory preload, so the Jamf Pro API is called through its HTTP
+// client. The fields of a record are described once, in Fields, for both the attributes of the
+// record resource and the columns of a CSV file.
+package inventory_preload
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+const uriInventoryPreloadRecords = "/api/v2/inventory-preload/records"
+
+// Device types of a record.
+const (
+	DeviceTypeComputer     = "Computer"
+	DeviceTypeMobileDevice = "Mobile Device"
+	DeviceTypeUnknown      = "Unknown"
+)
+
+// DeviceTypes lists the device types a record can have.
+var DeviceTypes = []string{DeviceTypeComputer, DeviceTypeMobileDevice, DeviceTypeUnknown}
+
+// Record is an inventory preload record.
+type Record struct {
+	ID                  string               `json:"id,omitempty"`
+	SerialNumber        string               `json:"serialNumber"`
+	DeviceType          string               `json:"deviceType"`
+	Username            string               `json:"username"`
+	FullName            string               `json:"fullName"`
+	EmailAddress        string               `json:"emailAddress"`
+	PhoneNumber         string               `json:"phoneNumber"`
+	Position            string               `json:"position"`
+	Department          string               `json:"department"`
+	Building            string               `json:"building"`
+	Room                string               `json:"room"`
+	PONumber            string               `json:"poNumber"`
+	PODate              string               `json:"poDate"`
+	WarrantyExpiration  string               `json:"warrantyExpiration"`
+	AppleCareID         string               `json:"appleCareId"`
+	LifeExpectancy      string               `json:"lifeExpectancy"`
+	PurchasePrice       string               `json:"purchasePrice"`
+	PurchasingContact   string               `json:"purchasingContact"`
+	PurchasingAccount   string               `json:"purchasingAccount"`
+	LeaseExpiration     string               `json:"leaseExpiration"`
+	BarCode1            string               `json:"barCode1"`
+	BarCode2            string               `json:"barCode2"`
+	AssetTag            string               `json:"assetTag"`
+	Vendor              string               `json:"vendor"`
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes"`
+}
+
+// ExtensionAttribute is the value of an extension attribute of a record, by the attribute's name.
+type ExtensionAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Field is a field of a record other than its ID and extension attributes.
+type Field struct {
+	// Attribute is the name of the field in Terraform, e.g. "serial_number".
+	Attribute string
+	// Column is the header of the field in Jamf Pro's CSV template, e.g. "Serial Number".
+	Column string
+	// Value returns the field of a record.
+	Value func(*Record) *string
+}
+
+// Fields lists the fields of a record in the order of Jamf Pro's CSV template.
+var Fields = []Field{
+	{"serial_number", "Serial Number", func(r *Record) *string { return &r.SerialNumber }},
+	{"device_type", "Device Type", func(r *Record) *string { return &r.DeviceType }},
+	{"username", "Username", func(r *Record) *string { return &r.Username }},
+	{"full_name", "Full Name", func(r *Record) *string { return &r.FullName }},
+	{"email_address", "Email Address", func(r *Record) *string { return &r.EmailAddress }},
+	{"phone_number", "Phone Number", func(r *Record) *string { return &r.PhoneNumber }},
+	{"position", "Position", func(r *Record) *string { return &r.Position }},
+	{"department", "Department", func(r *Record) *string { return &r.Department }},
+	{"building", "Building", func(r *Record) *string { return &r.Building }},
+	{"room", "Room", func(r *Record) *string { return &r.Room }},
+	{"po_number", "PO Number", func(r *Record) *string { return &r.PONumber }},
+	{"po_date", "PO Date", func(r *Record) *string { return &r.PODate }},
+	{"warranty_expiration", "Warranty Expiration", func(r *Record) *string { return &r.WarrantyExpiration }},
+	{"apple_care_id", "AppleCare ID", func(r *Record) *string { return &r.AppleCareID }},
+	{"life_expectancy", "Life Expectancy", func(r *Record) *string { return &r.LifeExpectancy }},
+	{"purchase_price", "Purchase Price", func(r *Record) *string { return &r.PurchasePrice }},
+	{"purchasing_contact", "Purchasing Contact", func(r *Record) *string { return &r.PurchasingContact }},
+	{"purchasing_account", "Purchasing Account", func(r *Record) *string { return &r.PurchasingAccount }},
+	{"lease_expiration", "Lease Expiration", func(r *Record) *string { return &r.LeaseExpiration }},
+	{"bar_code_1", "Bar Code 1", func(r *Record) *string { return &r.BarCode1 }},
+	{"bar_code_2", "Bar Code 2", func(r *Record) *string { return &r.BarCode2 }},
+	{"asset_tag", "Asset Tag", func(r *Record) *string { return &r.AssetTag }},
+	{"vendor", "Vendor", func(r *Record) *string { return &r.Vendor }},
+}
+
+// ExtensionAttributeValue returns the value of the named extension attribute of the record. Names
+// are matched ignoring case, as Jamf Pro does.
+func (r *Record) ExtensionAttributeValue(name string) string {
+	for _, attribute := range r.ExtensionAttributes {
+		if strings.EqualFold(attribute.Name, name) {
+			return attribute.Value
+		}
+	}
+	return ""
+}
+
+// SetExtensionAttribute sets the value of the named extension attribute of the record.
+func (r *Record) SetExtensionAttribute(name, value string) {
+	for i, attribute := range r.ExtensionAttributes {
+		if strings.EqualFold(attribute.Name, name) {
+			r.ExtensionAttributes[i].Value = value
+			return
+		}
+	}
+	r.ExtensionAttributes = append(r.ExtensionAttributes, ExtensionAttribute{Name: name, Value: value})
+}
+
+type responseRecordList struct {
+	TotalCount int      `json:"totalCount"`
+	Results    []Record `json:"results"`
+}
+
+type responseCreate struct {
+	ID string `json:"id"`
+}
+
+// listPageSize is the number of records fetched per request when listing.
+const listPageSize = 200
+
+// List returns the records matching the RSQL filter, or every record when the filter is empty,
+// fetching every page of results.
+func List(client *jamfpro.Client, filter string) ([]Record, error) {
+	var records []Record
+	for page := 0; ; page++ {
+		params := url.Values{}
+		params.Set("page", fmt.Sprint(page))
+		params.Set("page-size", fmt.Sprint(listPageSize))
+		params.Set("sort", "id:asc")
+		if filter != "" {
+			params.Set("filter", filter)
+		}
+
+		var out responseRecordList
+		resp, err := client.HTTP.DoRequest("GET", uriInventoryPreloadRecords+"?"+params.Encode(), nil, &out)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list inventory preload records: %v", err)
+		}
+
+		records = append(records, out.Results...)
+		if len(out.Results) < listPageSize || len(records) >= out.TotalCount {
+			return records, nil
+		}
+	}
+}
+
+// GetBySerialNumber returns the record of the serial number, or nil when there is none.
+func GetBySerialNumber(client *jamfpro.Client, serialNumber string) (*Record, error) {
+	records, err := List(client, fmt.Sprintf("serialNumber==%s", quoteRSQL(serialNumber)))
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		if strings.EqualFold(records[i].SerialNumber, serialNumber) {
+			return &records[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// quoteRSQL returns the value quoted for use in an RSQL filter.
+func quoteRSQL(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// Get returns the record with the ID.
+func Get(client *jamfpro.Client, id string) (*Record, error) {
+	var out Record
+	resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/%s", uriInventoryPreloadRecords, url.PathEscape(id)), nil, &out)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory preload record %s: %v", id, err)
+	}
+
+	return &out, nil
+}
+
+// Create creates the record and returns its ID.
+func Create(client *jamfpro.Client, record *Record) (string, error) {
+	var out responseCreate
+	resp, err := client.HTTP.DoRequest("POST", uriInventoryPreloadRecords, withoutID(record), &out)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create inventory preload record %q: %v", record.SerialNumber, err)
+	}
+
+	return out.ID, nil
+}
+
+// Update replaces the record with the ID.
+func Update(client *jamfpro.Client, id string, record *Record) error {
+	resp, err := client.HTTP.DoRequest("PUT", fmt.Sprintf("%s/%s", uriInventoryPreloadRecords, url.PathEscape(id)), withoutID(record), nil)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update inventory preload record %s (%q): %v", id, record.SerialNumber, err)
+	}
+
+	return nil
+}
+
+// Delete deletes the record with the ID.
+func Delete(client *jamfpro.Client, id string) error {
+	resp, err := client.HTTP.DoRequest("DELETE", fmt.Sprintf("%s/%s", uriInventoryPreloadRecords, url.PathEscape(id)), nil, nil)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete inventory preload record %s: %v", id, err)
+	}
+
+	return nil
+}
+
+// withoutID returns a copy of the record without its ID, which the API does not accept in a
+// request body, and with an empty rather than null list of extension attributes.
+func withoutID(record *Record) *Record {
+	out := *record
+	out.ID = ""
+	if out.ExtensionAttributes == nil {
+		out.ExtensionAttributes = []ExtensionAttribute{}
+	}
+	return &out
+}
+
+// Equal reports whether two records hold the same values, ignoring their IDs and the order of
+// their extension attributes. Extension attributes without a value are ignored.
+func Equal(a, b *Record) bool {
+	for _, field := range Fields {
+		if *field.Value(a) != *field.Value(b) {
+			return false
+		}
+	}
+
+	return extensionAttributesKey(a) == extensionAttributesKey(b)
+}
+
+// extensionAttributesKey returns the extension attributes of the record with a value, in a form
+// that compares equal regardless of their order.
+func extensionAttributesKey(record *Record) string {
+	values := map[string]string{}
+	for _, attribute := range record.ExtensionAttributes {
+		if attribute.Value != "" {
+			values[attribute.Name] = attribute.Value
+		}
+	}
+	key, _ := json.Marshal(values)
+	return string(key)
+}
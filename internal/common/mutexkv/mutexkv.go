@@ -0,0 +1,47 @@
+// Package mutexkv provides a set of named locks, used to serialise read-merge-write updates
+// against a single remote object from resources that Terraform runs in parallel.
+package mutexkv
+
+import (
+	"log"
+	"sync"
+)
+
+// MutexKV is a set of mutexes keyed by name. The zero value is not usable; use New.
+type MutexKV struct {
+	mu    sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// New returns an empty MutexKV.
+func New() *MutexKV {
+	return &MutexKV{store: map[string]*sync.Mutex{}}
+}
+
+// Lock acquires the mutex for key, creating it on first use.
+func (m *MutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock releases the mutex for key.
+func (m *MutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+// get returns the mutex for key. Mutexes are never removed, so a key always maps to the
+// same mutex for the life of the provider process.
+func (m *MutexKV) get(key string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
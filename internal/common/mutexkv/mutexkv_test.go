@@ -0,0 +1,50 @@
+package mutexkv
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutexKVSerialisesSameKey(t *testing.T) {
+	m := New()
+	var wg sync.WaitGroup
+	counter := 0
+
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Lock("group/1")
+			defer m.Unlock("group/1")
+
+			current := counter
+			time.Sleep(time.Microsecond)
+			counter = current + 1
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, counter)
+}
+
+func TestMutexKVIndependentKeys(t *testing.T) {
+	m := New()
+	m.Lock("group/1")
+	defer m.Unlock("group/1")
+
+	done := make(chan struct{})
+	go func() {
+		m.Lock("group/2")
+		m.Unlock("group/2")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("locking a different key blocked")
+	}
+}
@@ -0,0 +1,215 @@
+// Package name_references resolves the names of Jamf Pro objects a resource references to their
+// IDs, so that a configuration can refer to categories, sites, groups and packages whose IDs differ
+// between Jamf Pro instances.
+//
+// Names are resolved when planning, in CustomizeDiff, and state keeps the IDs they resolved to.
+// Each kind of object is listed at most once per client for the provider process, which Terraform
+// starts once per plan or apply, and listed again when a name is not found, in case the object was
+// created since.
+package name_references
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+// Kind is a type of Jamf Pro object referenced by name.
+type Kind struct {
+	// Name is the name of the kind in messages, e.g. "computer group".
+	Name string
+	// Key is the name of the kind in the keys of name_references, e.g. "computer_group".
+	Key string
+	// list returns every object of the kind.
+	list func(client *jamfpro.Client) ([]object, error)
+}
+
+// object is an object of a kind, by ID and name.
+type object struct {
+	ID   int
+	Name string
+}
+
+var (
+	Category = &Kind{Name: "category", Key: "category", list: func(client *jamfpro.Client) ([]object, error) {
+		response, err := client.GetCategories(nil)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]object, 0, len(response.Results))
+		for _, item := range response.Results {
+			out = appendObject(out, item.Id, item.Name)
+		}
+		return out, nil
+	}}
+	Site = &Kind{Name: "site", Key: "site", list: func(client *jamfpro.Client) ([]object, error) {
+		response, err := client.GetSites()
+		if err != nil {
+			return nil, err
+		}
+		out := make([]object, 0, len(response.Site))
+		for _, item := range response.Site {
+			out = append(out, object{ID: item.ID, Name: item.Name})
+		}
+		return out, nil
+	}}
+	ComputerGroup = &Kind{Name: "computer group", Key: "computer_group", list: func(client *jamfpro.Client) ([]object, error) {
+		response, err := client.GetComputerGroups()
+		if err != nil {
+			return nil, err
+		}
+		out := make([]object, 0, len(response.Results))
+		for _, item := range response.Results {
+			out = append(out, object{ID: item.ID, Name: item.Name})
+		}
+		return out, nil
+	}}
+	MobileDeviceGroup = &Kind{Name: "mobile device group", Key: "mobile_device_group", list: func(client *jamfpro.Client) ([]object, error) {
+		response, err := client.GetMobileDeviceGroups()
+		if err != nil {
+			return nil, err
+		}
+		out := make([]object, 0, len(response.MobileDeviceGroup))
+		for _, item := range response.MobileDeviceGroup {
+			out = append(out, object{ID: item.ID, Name: item.Name})
+		}
+		return out, nil
+	}}
+	Package = &Kind{Name: "package", Key: "package", list: func(client *jamfpro.Client) ([]object, error) {
+		response, err := client.GetPackages("", "")
+		if err != nil {
+			return nil, err
+		}
+		out := make([]object, 0, len(response.Results))
+		for _, item := range response.Results {
+			out = appendObject(out, item.ID, item.PackageName)
+		}
+		return out, nil
+	}}
+)
+
+// appendObject appends an object whose Pro API ID is a string.
+func appendObject(out []object, id, name string) []object {
+	if parsed, err := strconv.Atoi(id); err == nil {
+		out = append(out, object{ID: parsed, Name: name})
+	}
+	return out
+}
+
+// Key returns the key of a name of the kind in name_references, e.g. "computer_group/All Macs".
+func Key(kind *Kind, name string) string {
+	return kind.Key + "/" + name
+}
+
+// listing holds the objects of a kind listed for a client.
+type listing struct {
+	mu      sync.Mutex
+	objects []object
+	listed  bool
+}
+
+type listingKey struct {
+	client *jamfpro.Client
+	kind   *Kind
+}
+
+var (
+	listingsMu sync.Mutex
+	listings   = map[listingKey]*listing{}
+)
+
+// Resolve returns the ID of the object of the kind with the name. Names are matched exactly. It
+// fails when no object, or more than one, has the name.
+func Resolve(meta any, kind *Kind, name string) (int, error) {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return 0, fmt.Errorf("jamf client is not configured to resolve %s names", kind.Name)
+	}
+
+	listingsMu.Lock()
+	l, ok := listings[listingKey{client, kind}]
+	if !ok {
+		l = &listing{}
+		listings[listingKey{client, kind}] = l
+	}
+	listingsMu.Unlock()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.listed {
+		if id, err := match(kind, l.objects, name); err == nil || !isNotFound(err) {
+			return id, err
+		}
+	}
+
+	objects, err := kind.list(client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list %s objects to resolve %q: %v", kind.Name, name, err)
+	}
+	l.objects, l.listed = objects, true
+
+	return match(kind, objects, name)
+}
+
+// notFoundError is returned by match when no object has the name.
+type notFoundError struct{ message string }
+
+func (e *notFoundError) Error() string { return e.message }
+
+func isNotFound(err error) bool {
+	_, ok := err.(*notFoundError)
+	return ok
+}
+
+// match returns the ID of the only object with the name.
+func match(kind *Kind, objects []object, name string) (int, error) {
+	var ids []int
+	for _, o := range objects {
+		if o.Name == name {
+			ids = append(ids, o.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 1:
+		return ids[0], nil
+	case 0:
+		return 0, &notFoundError{fmt.Sprintf("no %s is named %q%s", kind.Name, name, suggest(objects, name))}
+	}
+
+	slices.Sort(ids)
+	listed := make([]string, len(ids))
+	for i, id := range ids {
+		listed[i] = strconv.Itoa(id)
+	}
+	return 0, fmt.Errorf("more than one %s is named %q (IDs %s), refer to it by ID instead",
+		kind.Name, name, strings.Join(listed, ", "))
+}
+
+// suggest returns a "did you mean" hint naming the object whose name differs from name only in
+// case, or else is closest to it, or an empty string when no name is close enough.
+func suggest(objects []object, name string) string {
+	maxDistance := max(1, len(name)/3)
+
+	best, bestDistance := "", maxDistance+1
+	for _, o := range objects {
+		if strings.EqualFold(o.Name, name) {
+			return fmt.Sprintf(", did you mean %q?", o.Name)
+		}
+		distance := fuzzy.LevenshteinDistance(strings.ToLower(name), strings.ToLower(o.Name))
+		if distance < bestDistance || (distance == bestDistance && o.Name < best) {
+			best, bestDistance = o.Name, distance
+		}
+	}
+
+	if bestDistance > maxDistance {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %q?", best)
+}
@@ -0,0 +1,44 @@
+package name_references
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	objects := []object{
+		{ID: 1, Name: "All Managed Macs"},
+		{ID: 4, Name: "Engineering"},
+		{ID: 9, Name: "Finance"},
+		{ID: 7, Name: "Finance"},
+	}
+
+	id, err := match(ComputerGroup, objects, "Engineering")
+	require.NoError(t, err)
+	assert.Equal(t, 4, id)
+
+	_, err = match(ComputerGroup, objects, "Finance")
+	assert.EqualError(t, err, `more than one computer group is named "Finance" (IDs 7, 9), refer to it by ID instead`)
+	assert.False(t, isNotFound(err))
+
+	_, err = match(ComputerGroup, objects, "all managed macs")
+	assert.EqualError(t, err, `no computer group is named "all managed macs", did you mean "All Managed Macs"?`)
+	assert.True(t, isNotFound(err))
+
+	_, err = match(ComputerGroup, objects, "Enginering")
+	assert.EqualError(t, err, `no computer group is named "Enginering", did you mean "Engineering"?`)
+
+	_, err = match(ComputerGroup, objects, "Marketing")
+	assert.EqualError(t, err, `no computer group is named "Marketing"`)
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "mobile_device_group/All iPads", Key(MobileDeviceGroup, "All iPads"))
+}
+
+func TestResolveWithoutClient(t *testing.T) {
+	_, err := Resolve(nil, Site, "Main")
+	assert.Error(t, err)
+}
@@ -0,0 +1,319 @@
+// Package package_cache provides an opt-in, persistent cache of package files downloaded from
+// HTTP(S) sources, and of the hashes calculated for package files.
+//
+// Without it every create or update of a package with a URL source downloads the file again,
+// and every plan and apply hashes local files again, which for multi-GB installers costs minutes
+// per run. Downloads are stored under the cache directory keyed by URL, along with the response's
+// ETag and Last-Modified validators and the file's hashes. A later fetch of the URL revalidates
+// the cached file with a conditional request, or skips the request altogether when the caller
+// pins a SHA-256 the cached file already has.
+//
+// Caches are keyed on the *jamfpro.Client stored in provider meta, and the directory outlives the
+// provider process, so cached files are reused across runs.
+package package_cache
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/files"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/mutexkv"
+)
+
+// entryFileName is the name of the file describing a cache entry within its directory.
+const entryFileName = "entry.json"
+
+// Hashes are the hashes Jamf Pro records for a package file.
+type Hashes struct {
+	SHA3512 string `json:"sha3_512"`
+	SHA256  string `json:"sha256"`
+	MD5     string `json:"md5"`
+}
+
+// entry describes a cached download, or the hashes of a local file.
+type entry struct {
+	URL          string `json:"url,omitempty"`
+	Path         string `json:"path,omitempty"`
+	FileName     string `json:"file_name,omitempty"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	Size         int64  `json:"size"`
+	ModTime      int64  `json:"mod_time"`
+	Hashes       Hashes `json:"hashes"`
+}
+
+// Cache is a package cache rooted at a directory.
+type Cache struct {
+	dir   string
+	locks *mutexkv.MutexKV
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[*jamfpro.Client]*Cache{}
+)
+
+// Enable registers a package cache in dir for the client, creating the directory. Called from
+// provider configuration when package_cache_dir is set.
+func Enable(client *jamfpro.Client, dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve package cache directory %q: %v", dir, err)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create package cache directory %q: %v", dir, err)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[client]; !ok {
+		registry[client] = &Cache{dir: dir, locks: mutexkv.New()}
+	}
+	return nil
+}
+
+// ForMeta returns the cache registered for the provider meta, or nil when caching is disabled.
+func ForMeta(meta any) *Cache {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	return registry[client]
+}
+
+// Contains reports whether path is within the cache directory, in which case the file must not be
+// cleaned up after use. It is false for a nil cache.
+func (c *Cache) Contains(path string) bool {
+	if c == nil {
+		return false
+	}
+	rel, err := filepath.Rel(c.dir, path)
+	return err == nil && !strings.HasPrefix(rel, "..")
+}
+
+// Fetch returns the path and hashes of the file at url, downloading it only when the cache holds
+// no current copy. When expectedSHA256 is set and a cached copy has that hash, the copy is used
+// without revalidating it against the server.
+func (c *Cache) Fetch(url, expectedSHA256 string) (string, Hashes, error) {
+	key := cacheKey("url", url)
+	c.locks.Lock(key)
+	defer c.locks.Unlock(key)
+
+	entryDir := filepath.Join(c.dir, key)
+	cached, ok := c.readEntry(entryDir)
+	if ok && cached.URL == url && fileMatches(filepath.Join(entryDir, cached.FileName), cached) {
+		path := filepath.Join(entryDir, cached.FileName)
+		if expectedSHA256 != "" && strings.EqualFold(cached.Hashes.SHA256, expectedSHA256) {
+			log.Printf("[INFO] Using cached package file %s for %s, which matches the pinned SHA-256", path, url)
+			return path, cached.Hashes, nil
+		}
+	} else {
+		cached = nil
+	}
+
+	return c.download(url, entryDir, cached)
+}
+
+// download fetches url into entryDir. When cached is set, the request is conditional on its
+// validators, and a 304 response reuses the cached file.
+func (c *Cache) download(url, entryDir string, cached *entry) (string, Hashes, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", Hashes{}, fmt.Errorf("failed to build request for %s: %v", url, err)
+	}
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := files.NewDownloadClient(url).Do(req)
+	if err != nil {
+		return "", Hashes{}, fmt.Errorf("failed to download file from %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		path := filepath.Join(entryDir, cached.FileName)
+		log.Printf("[INFO] Using cached package file %s for %s, which is unchanged", path, url)
+		return path, cached.Hashes, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", Hashes{}, fmt.Errorf("failed to download file from %s: unexpected status %s", url, resp.Status)
+	}
+
+	if err := os.MkdirAll(entryDir, 0o750); err != nil {
+		return "", Hashes{}, fmt.Errorf("failed to create package cache entry: %v", err)
+	}
+	tmpFile, err := os.CreateTemp(entryDir, "download-*")
+	if err != nil {
+		return "", Hashes{}, fmt.Errorf("failed to create temporary file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	hashes, err := copyHashing(tmpFile, resp.Body)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", Hashes{}, fmt.Errorf("failed to write package file from %s: %v", url, err)
+	}
+
+	fileName := files.ResponseFileName(resp)
+	if cached != nil && cached.FileName != fileName {
+		os.Remove(filepath.Join(entryDir, cached.FileName))
+	}
+	path := filepath.Join(entryDir, fileName)
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return "", Hashes{}, fmt.Errorf("failed to move downloaded file into the package cache: %v", err)
+	}
+
+	downloaded := &entry{
+		URL:          url,
+		FileName:     fileName,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Hashes:       hashes,
+	}
+	if err := c.writeEntry(entryDir, path, downloaded); err != nil {
+		return "", Hashes{}, err
+	}
+
+	log.Printf("[INFO] Downloaded %s to package cache file %s", url, path)
+	return path, hashes, nil
+}
+
+// HashFile returns the hashes of the local file at path. With a cache, the hashes are stored and
+// reused until the file's size or modification time changes. A nil cache always hashes the file.
+func (c *Cache) HashFile(path string) (Hashes, error) {
+	if c == nil {
+		return hashFile(path)
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return Hashes{}, fmt.Errorf("failed to resolve package file %q: %v", path, err)
+	}
+
+	key := cacheKey("file", abs)
+	c.locks.Lock(key)
+	defer c.locks.Unlock(key)
+
+	entryDir := filepath.Join(c.dir, key)
+	if cached, ok := c.readEntry(entryDir); ok && cached.Path == abs && fileMatches(abs, cached) {
+		return cached.Hashes, nil
+	}
+
+	hashes, err := hashFile(abs)
+	if err != nil {
+		return Hashes{}, err
+	}
+	if err := os.MkdirAll(entryDir, 0o750); err != nil {
+		return Hashes{}, fmt.Errorf("failed to create package cache entry: %v", err)
+	}
+	if err := c.writeEntry(entryDir, abs, &entry{Path: abs, Hashes: hashes}); err != nil {
+		return Hashes{}, err
+	}
+
+	return hashes, nil
+}
+
+// readEntry returns the entry stored in entryDir, if any.
+func (c *Cache) readEntry(entryDir string) (*entry, bool) {
+	data, err := os.ReadFile(filepath.Join(entryDir, entryFileName))
+	if err != nil {
+		return nil, false
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		log.Printf("[WARN] Ignoring unreadable package cache entry in %s: %v", entryDir, err)
+		return nil, false
+	}
+	return &e, true
+}
+
+// writeEntry records the size and modification time of the file at path in e, and stores e in
+// entryDir.
+func (c *Cache) writeEntry(entryDir, path string, e *entry) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat package file %q: %v", path, err)
+	}
+	e.Size = info.Size()
+	e.ModTime = info.ModTime().UnixNano()
+
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal package cache entry: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(entryDir, entryFileName), data, 0o640); err != nil {
+		return fmt.Errorf("failed to write package cache entry: %v", err)
+	}
+	return nil
+}
+
+// fileMatches reports whether the file at path still has the size and modification time
+// recorded in e.
+func fileMatches(path string, e *entry) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Size() == e.Size && info.ModTime().UnixNano() == e.ModTime
+}
+
+// cacheKey returns the directory name of the cache entry for a URL or local path.
+func cacheKey(kind, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return kind + "-" + hex.EncodeToString(sum[:])
+}
+
+// hashFile returns the hashes of the file at path, read in a single pass.
+func hashFile(path string) (Hashes, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Hashes{}, fmt.Errorf("failed to open package file for hashing: %v", err)
+	}
+	defer file.Close()
+
+	hashes, err := copyHashing(io.Discard, file)
+	if err != nil {
+		return Hashes{}, fmt.Errorf("failed to hash package file %q: %v", path, err)
+	}
+	return hashes, nil
+}
+
+// copyHashing copies src to dst, returning the hashes of the copied data.
+func copyHashing(dst io.Writer, src io.Reader) (Hashes, error) {
+	sha3512 := sha3.New512()
+	sha256Hash := sha256.New()
+	md5Hash := md5.New()
+
+	if _, err := io.Copy(io.MultiWriter(dst, sha3512, sha256Hash, md5Hash), src); err != nil {
+		return Hashes{}, err
+	}
+
+	return Hashes{
+		SHA3512: hex.EncodeToString(sha3512.Sum(nil)),
+		SHA256:  hex.EncodeToString(sha256Hash.Sum(nil)),
+		MD5:     hex.EncodeToString(md5Hash.Sum(nil)),
+	}, nil
+}
@@ -0,0 +1,124 @@
+package package_cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer serves a package file with an ETag, answering conditional requests with 304.
+type testServer struct {
+	content     string
+	requests    int
+	notModified int
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests++
+	etag := `"` + sha256Hex(s.content) + `"`
+	if r.Header.Get("If-None-Match") == etag {
+		s.notModified++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.Write([]byte(s.content))
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func testCache(t *testing.T) *Cache {
+	t.Helper()
+	client := &jamfpro.Client{}
+	require.NoError(t, Enable(client, t.TempDir()))
+	cache := ForMeta(client)
+	require.NotNil(t, cache)
+	return cache
+}
+
+func TestForMetaDisabled(t *testing.T) {
+	assert.Nil(t, ForMeta(&jamfpro.Client{}))
+	assert.False(t, ForMeta(nil).Contains("/tmp/file.pkg"))
+}
+
+func TestFetch(t *testing.T) {
+	cache := testCache(t)
+	server := &testServer{content: "package v1"}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	url := ts.URL + "/downloads/Agent.pkg"
+
+	path, hashes, err := cache.Fetch(url, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Agent.pkg", filepath.Base(path))
+	assert.True(t, cache.Contains(path))
+	assert.Equal(t, sha256Hex("package v1"), hashes.SHA256)
+	assert.NotEmpty(t, hashes.SHA3512)
+	assert.NotEmpty(t, hashes.MD5)
+
+	// The cached copy is revalidated rather than downloaded again.
+	again, againHashes, err := cache.Fetch(url, "")
+	require.NoError(t, err)
+	assert.Equal(t, path, again)
+	assert.Equal(t, hashes, againHashes)
+	assert.Equal(t, 2, server.requests)
+	assert.Equal(t, 1, server.notModified)
+
+	// A pinned hash matching the cached copy skips the request.
+	_, _, err = cache.Fetch(url, sha256Hex("package v1"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.requests)
+
+	// Changed content is downloaded again.
+	server.content = "package v2"
+	path, hashes, err = cache.Fetch(url, "")
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex("package v2"), hashes.SHA256)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "package v2", string(data))
+}
+
+func TestFetchError(t *testing.T) {
+	cache := testCache(t)
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	_, _, err := cache.Fetch(ts.URL+"/missing.pkg", "")
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestHashFile(t *testing.T) {
+	cache := testCache(t)
+	path := filepath.Join(t.TempDir(), "local.pkg")
+	require.NoError(t, os.WriteFile(path, []byte("local v1"), 0o644))
+
+	hashes, err := cache.HashFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex("local v1"), hashes.SHA256)
+
+	uncached, err := (*Cache)(nil).HashFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, hashes, uncached)
+
+	// A changed file is hashed again.
+	require.NoError(t, os.WriteFile(path, []byte("local v2, longer"), 0o644))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+
+	hashes, err = cache.HashFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex("local v2, longer"), hashes.SHA256)
+}
@@ -20,11 +20,30 @@ import (
 func ProcessConfigurationProfileForDiffSuppression(plistData string, fieldsToRemove []string) (string, error) {
 	log.Println("Starting ProcessConfigurationProfile")
 
+	sortedData, err := normalizeConfigurationProfileForDiff(plistData, fieldsToRemove)
+	if err != nil {
+		return "", err
+	}
+
+	// Step 8: Encode back to plist
+	encodedPlist, err := EncodePlist(sortedData)
+	if err != nil {
+		log.Printf("Error encoding plist data: %v\n", err)
+		return "", err
+	}
+
+	// Step 9: Remove trailing whitespace
+	return trimTrailingWhitespace(encodedPlist), nil
+}
+
+// normalizeConfigurationProfileForDiff decodes the plist data and applies the normalization steps
+// shared by diff suppression and payload diff summaries, returning the normalized plist map.
+func normalizeConfigurationProfileForDiff(plistData string, fieldsToRemove []string) (map[string]any, error) {
 	// Step 1: Unmarshal
 	var rawData map[string]any
 	if _, err := plist.Unmarshal([]byte(plistData), &rawData); err != nil {
 		log.Printf("Error unmarshalling plist data: %v\n", err)
-		return "", err
+		return nil, err
 	}
 
 	// Step 2: Remove specified fields
@@ -43,17 +62,7 @@ func ProcessConfigurationProfileForDiffSuppression(plistData string, fieldsToRem
 	normalizedData := normalizeHTMLEntitiesForDiff(normalizedStrings)
 
 	// Step 7: Sort keys
-	sortedData := SortPlistKeys(normalizedData.(map[string]any))
-
-	// Step 8: Encode back to plist
-	encodedPlist, err := EncodePlist(sortedData)
-	if err != nil {
-		log.Printf("Error encoding plist data: %v\n", err)
-		return "", err
-	}
-
-	// Step 9: Remove trailing whitespace
-	return trimTrailingWhitespace(encodedPlist), nil
+	return SortPlistKeys(normalizedData.(map[string]any)), nil
 }
 
 // removeSpecifiedXMLFields( removes specified fields from the plist data recursively.
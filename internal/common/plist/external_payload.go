@@ -0,0 +1,193 @@
+// common/configurationprofiles/plist/external_payload.go
+// contains the functions to normalize configuration profiles authored outside of Jamf Pro.
+package plist
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"howett.net/plist"
+)
+
+// oidSignedData identifies a CMS (PKCS #7) SignedData content type.
+var oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+
+// ExternalPayloadDefaults holds the root-level values applied to an external profile when the
+// corresponding key is missing.
+type ExternalPayloadDefaults struct {
+	// PayloadDisplayName is used when the profile has no display name.
+	PayloadDisplayName string
+	// PayloadScope is used when the profile has no scope, e.g. "System" or "User".
+	PayloadScope string
+}
+
+// NormalizeExternalPayload converts a configuration profile authored outside of Jamf Pro, e.g. in
+// iMazing, Apple Configurator or ProfileCreator, into the form Jamf Pro stores. The payload may
+// be an XML plist, or a base64 encoded binary plist or signed (CMS) profile as produced by
+// filebase64(). The function:
+//
+//  1. Strips the CMS signature wrapper from signed profiles, as Jamf Pro stores the unsigned
+//     profile and signs it at install time.
+//  2. Sets the root PayloadIdentifier to the root PayloadUUID, as Jamf Pro does on upload.
+//  3. Adds a PayloadUUID and PayloadIdentifier to any payload missing them, derived from the
+//     payload content so that the result is stable between plans.
+//  4. Fills the root PayloadDisplayName and PayloadScope from defaults when missing.
+//  5. Converts <date> values to UTC with second precision, which is how Jamf Pro returns them.
+//
+// <data> values are kept as-is and are compared by their decoded bytes.
+func NormalizeExternalPayload(payload string, defaults ExternalPayloadDefaults) (string, error) {
+	raw, err := ExtractPlist(payload)
+	if err != nil {
+		return "", err
+	}
+
+	var profile map[string]any
+	if _, err := plist.Unmarshal(raw, &profile); err != nil {
+		return "", fmt.Errorf("failed to decode plist: %v", err)
+	}
+
+	if _, ok := profile["PayloadDisplayName"].(string); !ok && defaults.PayloadDisplayName != "" {
+		profile["PayloadDisplayName"] = defaults.PayloadDisplayName
+	}
+	if _, ok := profile["PayloadScope"].(string); !ok && defaults.PayloadScope != "" {
+		profile["PayloadScope"] = defaults.PayloadScope
+	}
+
+	if err := assignPayloadIdentifiers(profile); err != nil {
+		return "", err
+	}
+	profile["PayloadIdentifier"] = profile["PayloadUUID"]
+
+	normalized, err := plist.MarshalIndent(normalizePlistDates(profile), plist.XMLFormat, "\t")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode plist: %v", err)
+	}
+
+	return trimTrailingWhitespace(string(normalized)), nil
+}
+
+// ExtractPlist returns the plist document held in payload. XML plists are returned unchanged;
+// anything else is base64 decoded and, when it is a signed (CMS) profile, unwrapped to the
+// encapsulated plist.
+func ExtractPlist(payload string) ([]byte, error) {
+	trimmed := strings.TrimSpace(payload)
+	if strings.HasPrefix(trimmed, "<") {
+		return []byte(trimmed), nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(trimmed), ""))
+	if err != nil {
+		return nil, fmt.Errorf("payload is neither an XML plist nor base64 encoded: %v", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(decoded, []byte("bplist")), bytes.HasPrefix(bytes.TrimSpace(decoded), []byte("<")):
+		return decoded, nil
+	case len(decoded) > 0 && decoded[0] == 0x30:
+		return unwrapSignedData(decoded)
+	default:
+		return nil, fmt.Errorf("base64 decoded payload is not a plist or a signed profile")
+	}
+}
+
+// unwrapSignedData returns the encapsulated content of a DER encoded CMS SignedData structure
+// (RFC 5652), without verifying the signature.
+func unwrapSignedData(der []byte) ([]byte, error) {
+	var contentInfo struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue
+	}
+	if _, err := asn1.Unmarshal(der, &contentInfo); err != nil {
+		return nil, fmt.Errorf("failed to parse signed profile: %v", err)
+	}
+	if !contentInfo.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("signed profile has unsupported content type %s", contentInfo.ContentType)
+	}
+	if contentInfo.Content.Class != asn1.ClassContextSpecific || contentInfo.Content.Tag != 0 {
+		return nil, fmt.Errorf("signed profile content is not tagged as expected")
+	}
+
+	var signedData struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		EncapContentInfo struct {
+			EContentType asn1.ObjectIdentifier
+			EContent     []byte `asn1:"explicit,optional,tag:0"`
+		}
+		Rest []asn1.RawValue `asn1:"optional"`
+	}
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return nil, fmt.Errorf("failed to parse signed profile content: %v", err)
+	}
+	if len(signedData.EncapContentInfo.EContent) == 0 {
+		return nil, fmt.Errorf("signed profile is detached and does not contain the profile")
+	}
+
+	return signedData.EncapContentInfo.EContent, nil
+}
+
+// assignPayloadIdentifiers adds a content-derived PayloadUUID to the profile and each nested
+// payload missing one, and a PayloadIdentifier to nested payloads missing one.
+func assignPayloadIdentifiers(profile map[string]any) error {
+	if err := assignPayloadUUID(profile); err != nil {
+		return err
+	}
+
+	contents, _ := profile["PayloadContent"].([]any)
+	for _, content := range contents {
+		payload, ok := content.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if err := assignPayloadUUID(payload); err != nil {
+			return err
+		}
+		if _, ok := payload["PayloadIdentifier"].(string); !ok {
+			payloadType, _ := payload["PayloadType"].(string)
+			payload["PayloadIdentifier"] = fmt.Sprintf("%s.%s", payloadType, payload["PayloadUUID"])
+		}
+	}
+
+	return nil
+}
+
+// assignPayloadUUID sets PayloadUUID to a name-based UUID of the payload's content when missing.
+func assignPayloadUUID(payload map[string]any) error {
+	if _, ok := payload["PayloadUUID"].(string); ok {
+		return nil
+	}
+
+	content, err := plist.Marshal(payload, plist.XMLFormat)
+	if err != nil {
+		return fmt.Errorf("failed to derive PayloadUUID: %v", err)
+	}
+	payload["PayloadUUID"] = strings.ToUpper(uuid.NewSHA1(uuid.NameSpaceOID, content).String())
+
+	return nil
+}
+
+// normalizePlistDates converts every date in the plist to UTC truncated to the second.
+func normalizePlistDates(data any) any {
+	switch v := data.(type) {
+	case time.Time:
+		return v.UTC().Truncate(time.Second)
+	case map[string]any:
+		for k, item := range v {
+			v[k] = normalizePlistDates(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = normalizePlistDates(item)
+		}
+		return v
+	default:
+		return data
+	}
+}
@@ -0,0 +1,189 @@
+package plist
+
+import (
+	"encoding/asn1"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"howett.net/plist"
+)
+
+// externalTestProfile resembles an iMazing export: the root PayloadIdentifier is a reverse-DNS
+// name rather than the UUID, there is no PayloadScope and the nested payload has no UUID.
+const externalTestProfile = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.apple.screensaver</string>
+			<key>PayloadVersion</key>
+			<integer>1</integer>
+			<key>idleTime</key>
+			<integer>300</integer>
+			<key>Certificate</key>
+			<data>
+			SGVsbG8g
+			V29ybGQ=
+			</data>
+		</dict>
+	</array>
+	<key>PayloadDisplayName</key>
+	<string>Screensaver</string>
+	<key>PayloadIdentifier</key>
+	<string>com.example.screensaver</string>
+	<key>PayloadOrganization</key>
+	<string>Example</string>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+	<key>PayloadUUID</key>
+	<string>9A7F1E0C-2B3D-4C5E-8F60-718293A4B5C6</string>
+	<key>PayloadVersion</key>
+	<integer>1</integer>
+</dict>
+</plist>`
+
+var externalTestDefaults = ExternalPayloadDefaults{PayloadDisplayName: "fallback", PayloadScope: "System"}
+
+// signTestProfile wraps content in a minimal CMS SignedData structure, without signer infos.
+func signTestProfile(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	type encapContentInfo struct {
+		EContentType asn1.ObjectIdentifier
+		EContent     []byte `asn1:"explicit,tag:0"`
+	}
+	signedData, err := asn1.Marshal(struct {
+		Version          int
+		DigestAlgorithms []asn1.RawValue `asn1:"set"`
+		EncapContentInfo encapContentInfo
+		SignerInfos      []asn1.RawValue `asn1:"set"`
+	}{
+		Version:          1,
+		DigestAlgorithms: []asn1.RawValue{},
+		EncapContentInfo: encapContentInfo{EContentType: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}, EContent: content},
+		SignerInfos:      []asn1.RawValue{},
+	})
+	require.NoError(t, err)
+
+	der, err := asn1.Marshal(struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue
+	}{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+	})
+	require.NoError(t, err)
+	return der
+}
+
+func TestNormalizeExternalPayload(t *testing.T) {
+	normalized, err := NormalizeExternalPayload(externalTestProfile, externalTestDefaults)
+	require.NoError(t, err)
+
+	profile, err := UnmarshalPayload(normalized)
+	require.NoError(t, err)
+	assert.Equal(t, "9A7F1E0C-2B3D-4C5E-8F60-718293A4B5C6", profile.PayloadIdentifier)
+	assert.Equal(t, profile.PayloadUUID, profile.PayloadIdentifier)
+	assert.Equal(t, "System", profile.PayloadScope)
+	assert.Equal(t, "Screensaver", profile.PayloadDisplayName)
+	assert.Empty(t, ValidatePayloadFields(profile))
+
+	require.Len(t, profile.PayloadContent, 1)
+	nested := profile.PayloadContent[0]
+	assert.NotEmpty(t, nested.PayloadUUID)
+	assert.Equal(t, "com.apple.screensaver."+nested.PayloadUUID, nested.PayloadIdentifier)
+	assert.Equal(t, []byte("Hello World"), nested.ConfigurationItems["Certificate"])
+
+	again, err := NormalizeExternalPayload(normalized, externalTestDefaults)
+	require.NoError(t, err)
+	assert.Equal(t, normalized, again, "normalization should be idempotent")
+
+	repeated, err := NormalizeExternalPayload(externalTestProfile, externalTestDefaults)
+	require.NoError(t, err)
+	assert.Equal(t, normalized, repeated, "derived UUIDs should be stable")
+}
+
+func TestNormalizeExternalPayloadEncodings(t *testing.T) {
+	expected, err := NormalizeExternalPayload(externalTestProfile, externalTestDefaults)
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	_, err = plist.Unmarshal([]byte(externalTestProfile), &decoded)
+	require.NoError(t, err)
+	binary, err := plist.Marshal(decoded, plist.BinaryFormat)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{name: "Base64 XML plist", payload: base64.StdEncoding.EncodeToString([]byte(externalTestProfile))},
+		{name: "Base64 binary plist", payload: base64.StdEncoding.EncodeToString(binary)},
+		{name: "Signed profile", payload: base64.StdEncoding.EncodeToString(signTestProfile(t, []byte(externalTestProfile)))},
+		{name: "Signed binary profile", payload: base64.StdEncoding.EncodeToString(signTestProfile(t, binary))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeExternalPayload(tt.payload, externalTestDefaults)
+			require.NoError(t, err)
+			assert.Equal(t, expected, got)
+		})
+	}
+}
+
+func TestNormalizeExternalPayloadDates(t *testing.T) {
+	local := time.FixedZone("UTC+2", 2*60*60)
+	binary, err := plist.Marshal(map[string]any{
+		"PayloadType":        "Configuration",
+		"PayloadUUID":        "11111111-2222-3333-4444-555555555555",
+		"RemovalDate":        time.Date(2030, 1, 2, 5, 4, 5, 500_000_000, local),
+		"PayloadDisplayName": "Dates",
+	}, plist.BinaryFormat)
+	require.NoError(t, err)
+
+	normalized, err := NormalizeExternalPayload(base64.StdEncoding.EncodeToString(binary), externalTestDefaults)
+	require.NoError(t, err)
+	assert.Contains(t, normalized, "<date>2030-01-02T03:04:05Z</date>")
+}
+
+func TestNormalizeExternalPayloadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr string
+	}{
+		{name: "Not base64", payload: "not a plist!", wantErr: "neither an XML plist nor base64"},
+		{name: "Unknown binary", payload: base64.StdEncoding.EncodeToString([]byte("GIF89a")), wantErr: "not a plist or a signed profile"},
+		{name: "Invalid DER", payload: base64.StdEncoding.EncodeToString([]byte{0x30, 0x03, 0x01}), wantErr: "failed to parse signed profile"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NormalizeExternalPayload(tt.payload, externalTestDefaults)
+			require.Error(t, err)
+			assert.True(t, strings.Contains(err.Error(), tt.wantErr), err.Error())
+		})
+	}
+}
+
+func TestNormalizeExternalPayloadDiffSuppression(t *testing.T) {
+	normalized, err := NormalizeExternalPayload(externalTestProfile, externalTestDefaults)
+	require.NoError(t, err)
+
+	// Jamf Pro replaces the root identifiers and re-wraps <data> values on upload.
+	stored := strings.ReplaceAll(normalized, "9A7F1E0C-2B3D-4C5E-8F60-718293A4B5C6", "00000000-AAAA-BBBB-CCCC-DDDDDDDDDDDD")
+	stored = strings.Replace(stored, "SGVsbG8gV29ybGQ=", "SGVsbG8g\nV29ybGQ=", 1)
+	require.NotEqual(t, normalized, stored)
+
+	changes, err := DiffPayloads(stored, normalized, []string{"PayloadUUID", "PayloadIdentifier", "PayloadOrganization", "PayloadDisplayName"})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,149 @@
+// common/configurationprofiles/plist/payload_diff.go
+// contains the functions to summarise the key-level changes between two configuration profiles.
+package plist
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// maxPayloadDiffEntries caps the number of changes listed by DiffPayloads so that replacing a
+// large profile does not flood the plan.
+const maxPayloadDiffEntries = 100
+
+// maxPayloadDiffValueLength is the number of characters of a string value shown in a change.
+const maxPayloadDiffValueLength = 64
+
+// absent marks a key or array element that only exists on one side of a payload diff.
+type absent struct{}
+
+// DiffPayloads compares two configuration profile plists after applying the same normalization
+// used for diff suppression and returns one line per changed key path, sorted by path, e.g.
+// "PayloadContent[0].AllowUserOverrides: true -> false". Keys that only exist on one side are
+// shown as "(absent)". The fieldsToRemove are excluded from the comparison, as they are for
+// diff suppression. An empty result means the payloads are equivalent.
+func DiffPayloads(oldPayload, newPayload string, fieldsToRemove []string) ([]string, error) {
+	oldData, err := normalizeConfigurationProfileForDiff(oldPayload, fieldsToRemove)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize old payload: %v", err)
+	}
+
+	newData, err := normalizeConfigurationProfileForDiff(newPayload, fieldsToRemove)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize new payload: %v", err)
+	}
+
+	var changes []string
+	diffPlistValues("", oldData, newData, &changes)
+
+	if len(changes) > maxPayloadDiffEntries {
+		remaining := len(changes) - maxPayloadDiffEntries
+		changes = append(changes[:maxPayloadDiffEntries], fmt.Sprintf("... and %d more changes", remaining))
+	}
+
+	return changes, nil
+}
+
+// diffPlistValues recursively compares two decoded plist values and appends a line for every
+// changed leaf. Dictionaries are compared key by key and arrays element by element.
+func diffPlistValues(path string, oldValue, newValue any, changes *[]string) {
+	_, oldAbsent := oldValue.(absent)
+	_, newAbsent := newValue.(absent)
+
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if (oldIsMap || oldAbsent) && (newIsMap || newAbsent) && len(oldMap)+len(newMap) > 0 {
+		for _, key := range unionKeys(oldMap, newMap) {
+			diffPlistValues(joinPlistPath(path, key), lookupPlistKey(oldMap, key), lookupPlistKey(newMap, key), changes)
+		}
+		return
+	}
+
+	oldArray, oldIsArray := oldValue.([]any)
+	newArray, newIsArray := newValue.([]any)
+	if (oldIsArray || oldAbsent) && (newIsArray || newAbsent) && len(oldArray)+len(newArray) > 0 {
+		for i := 0; i < max(len(oldArray), len(newArray)); i++ {
+			diffPlistValues(fmt.Sprintf("%s[%d]", path, i), lookupPlistIndex(oldArray, i), lookupPlistIndex(newArray, i), changes)
+		}
+		return
+	}
+
+	if reflect.DeepEqual(oldValue, newValue) {
+		return
+	}
+
+	*changes = append(*changes, fmt.Sprintf("%s: %s -> %s", path, formatPlistValue(oldValue), formatPlistValue(newValue)))
+}
+
+// unionKeys returns the keys present in either map, sorted.
+func unionKeys(a, b map[string]any) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		seen[k] = struct{}{}
+	}
+	for k := range b {
+		seen[k] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func lookupPlistKey(m map[string]any, key string) any {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return absent{}
+}
+
+func lookupPlistIndex(a []any, i int) any {
+	if i < len(a) {
+		return a[i]
+	}
+	return absent{}
+}
+
+func joinPlistPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// formatPlistValue renders a plist leaf value for a diff line, quoting strings and truncating
+// long ones.
+func formatPlistValue(value any) string {
+	switch v := value.(type) {
+	case absent:
+		return "(absent)"
+	case string:
+		if utf8.RuneCountInString(v) > maxPayloadDiffValueLength {
+			v = string([]rune(v)[:maxPayloadDiffValueLength]) + "..."
+		}
+		return strconv.Quote(v)
+	case []byte:
+		return fmt.Sprintf("<data, %d bytes>", len(v))
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case map[string]any:
+		if len(v) == 0 {
+			return "{}"
+		}
+		return fmt.Sprintf("{%d keys}", len(v))
+	case []any:
+		if len(v) == 0 {
+			return "[]"
+		}
+		return fmt.Sprintf("[%d items]", len(v))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,117 @@
+package plist
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// diffTestProfile wraps payload content dict entries in a minimal configuration profile.
+func diffTestProfile(content string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.example.settings</string>
+			<key>PayloadUUID</key>
+			<string>11111111-2222-3333-4444-555555555555</string>
+			` + content + `
+		</dict>
+	</array>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+</dict>
+</plist>`
+}
+
+func TestDiffPayloads(t *testing.T) {
+	fieldsToRemove := []string{"PayloadUUID", "PayloadIdentifier", "PayloadOrganization", "PayloadDisplayName"}
+
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want []string
+	}{
+		{
+			name: "Equivalent payloads",
+			old:  diffTestProfile(`<key>A</key><true/><key>B</key><string>  </string>`),
+			new:  diffTestProfile(`<key>B</key><string></string><key>A</key><true/>`),
+			want: nil,
+		},
+		{
+			name: "Changed boolean",
+			old:  diffTestProfile(`<key>AllowUserOverrides</key><true/>`),
+			new:  diffTestProfile(`<key>AllowUserOverrides</key><false/>`),
+			want: []string{"PayloadContent[0].AllowUserOverrides: true -> false"},
+		},
+		{
+			name: "Added and removed keys",
+			old:  diffTestProfile(`<key>Removed</key><integer>5</integer>`),
+			new:  diffTestProfile(`<key>Added</key><string>value</string>`),
+			want: []string{
+				`PayloadContent[0].Added: (absent) -> "value"`,
+				`PayloadContent[0].Removed: 5 -> (absent)`,
+			},
+		},
+		{
+			name: "Nested dictionary and array changes",
+			old:  diffTestProfile(`<key>Settings</key><dict><key>Items</key><array><dict><key>Name</key><string>a</string></dict></array></dict>`),
+			new:  diffTestProfile(`<key>Settings</key><dict><key>Items</key><array><dict><key>Name</key><string>b</string></dict><dict><key>Name</key><string>c</string></dict></array></dict>`),
+			want: []string{
+				`PayloadContent[0].Settings.Items[0].Name: "a" -> "b"`,
+				`PayloadContent[0].Settings.Items[1].Name: (absent) -> "c"`,
+			},
+		},
+		{
+			name: "Excluded fields are ignored",
+			old:  strings.Replace(diffTestProfile(""), "11111111-2222-3333-4444-555555555555", "AAAAAAAA-2222-3333-4444-555555555555", 1),
+			new:  diffTestProfile(""),
+			want: nil,
+		},
+		{
+			name: "Empty dictionary replaced",
+			old:  diffTestProfile(`<key>Settings</key><dict/>`),
+			new:  diffTestProfile(`<key>Settings</key><string>x</string>`),
+			want: []string{`PayloadContent[0].Settings: {} -> "x"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffPayloads(tt.old, tt.new, fieldsToRemove)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiffPayloadsTruncation(t *testing.T) {
+	var oldKeys, newKeys strings.Builder
+	for i := range maxPayloadDiffEntries + 5 {
+		fmt.Fprintf(&oldKeys, "<key>Key%03d</key><integer>%d</integer>", i, i)
+		fmt.Fprintf(&newKeys, "<key>Key%03d</key><integer>%d</integer>", i, i+1)
+	}
+	longValue := strings.Repeat("x", maxPayloadDiffValueLength+10)
+
+	got, err := DiffPayloads(diffTestProfile(oldKeys.String()), diffTestProfile(newKeys.String()), nil)
+	require.NoError(t, err)
+	require.Len(t, got, maxPayloadDiffEntries+1)
+	assert.Equal(t, "... and 5 more changes", got[maxPayloadDiffEntries])
+
+	got, err = DiffPayloads(diffTestProfile(""), diffTestProfile("<key>Long</key><string>"+longValue+"</string>"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("PayloadContent[0].Long: (absent) -> %q", longValue[:maxPayloadDiffValueLength]+"...")}, got)
+}
+
+func TestDiffPayloadsInvalidPlist(t *testing.T) {
+	_, err := DiffPayloads("not a plist", diffTestProfile(""), nil)
+	assert.Error(t, err)
+}
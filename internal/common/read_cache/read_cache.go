@@ -0,0 +1,249 @@
+// Package read_cache provides an opt-in, per-run cache which serves per-ID reads from a
+// single list request for each resource type.
+//
+// During a refresh every resource issues its own GetXByID, so a workspace with hundreds of
+// objects of one type makes hundreds of requests. Where the Jamf Pro API list endpoint
+// returns the complete object, the first read of a type fetches the whole list and later
+// reads for that type are answered from it. IDs missing from the list (created after the
+// prefetch, or deleted) fall through to the by-ID getter, so not-found handling is unchanged.
+//
+// The Classic API lists of policies, macOS and mobile device configuration profiles, and computer
+// and mobile device groups return only IDs and names, and the Pro API group list lacks their
+// criteria, sites and members, so those types cannot be prefetched. Their reads are memoized
+// instead: each object is read by ID once per run, and the other resources reading it, e.g. the
+// scope targets of a policy or the memberships of a group, are answered from that read.
+//
+// Caches are keyed on the *jamfpro.Client stored in provider meta and live for the provider
+// process, which Terraform starts once per plan or apply.
+package read_cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// Cache holds the prefetched objects of each resource type for a single client.
+type Cache struct {
+	mu      sync.Mutex
+	types   map[string]*typeCache
+	written map[string]bool
+}
+
+// typeCache holds the prefetched objects of a single resource type, keyed by ID.
+type typeCache struct {
+	once  sync.Once
+	mu    sync.Mutex
+	items map[string]any
+	err   error
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[*jamfpro.Client]*Cache{}
+)
+
+// Enable registers a read cache for the client. Called from provider configuration when
+// enable_read_cache is set.
+func Enable(client *jamfpro.Client) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[client]; !ok {
+		registry[client] = &Cache{types: map[string]*typeCache{}, written: map[string]bool{}}
+	}
+}
+
+// forMeta returns the cache registered for the provider meta, or nil when caching is disabled.
+func forMeta(meta any) *Cache {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	return registry[client]
+}
+
+// typeCache returns the cache for a resource type, creating it on first use.
+func (c *Cache) typeCache(resourceType string) *typeCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tc, ok := c.types[resourceType]
+	if !ok {
+		tc = &typeCache{}
+		c.types[resourceType] = tc
+	}
+	return tc
+}
+
+// isWritten reports whether the ID has been written during this run.
+func (c *Cache) isWritten(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.written[id]
+}
+
+// Getter wraps a by-ID getter so that it is served from the prefetched list when the cache is
+// enabled for meta. When disabled, getByID is returned unchanged.
+//
+// Parameters:
+// - meta: The provider meta object containing the authenticated client
+// - resourceType: A key identifying the resource type, unique within the provider
+// - list: A function returning every object of the type with the same content as getByID
+// - idOf: A function returning the ID of a listed object
+// - getByID: The SDK function used when an ID is not present in the prefetched list
+func Getter[T any](
+	meta any,
+	resourceType string,
+	list func() ([]T, error),
+	idOf func(*T) string,
+	getByID func(string) (*T, error),
+) func(string) (*T, error) {
+	cache := forMeta(meta)
+	if cache == nil {
+		return getByID
+	}
+
+	return func(id string) (*T, error) {
+		if cache.isWritten(id) {
+			return getByID(id)
+		}
+
+		tc := cache.typeCache(resourceType)
+
+		tc.once.Do(func() {
+			items, err := list()
+			if err != nil {
+				tc.err = fmt.Errorf("failed to prefetch %s list: %v", resourceType, err)
+				return
+			}
+
+			prefetched := make(map[string]any, len(items))
+			for i := range items {
+				prefetched[idOf(&items[i])] = items[i]
+			}
+
+			tc.mu.Lock()
+			tc.items = prefetched
+			tc.mu.Unlock()
+			log.Printf("[DEBUG] read cache prefetched %d %s objects", len(items), resourceType)
+		})
+
+		if tc.err != nil {
+			log.Printf("[WARN] %v, reading %s (ID: %s) directly", tc.err, resourceType, id)
+			return getByID(id)
+		}
+
+		tc.mu.Lock()
+		item, ok := tc.items[id]
+		tc.mu.Unlock()
+
+		if !ok {
+			return getByID(id)
+		}
+
+		cached := item.(T)
+		return &cached, nil
+	}
+}
+
+// memoEntry is a by-ID read shared by every reader of the ID.
+type memoEntry struct {
+	once sync.Once
+	data []byte
+	err  error
+}
+
+// Memoized wraps a by-ID getter so that, when the cache is enabled for meta, each ID is read once
+// and later reads of it are answered from that read. Readers get their own deep copy, so they may
+// modify it. A failed read is not kept. When disabled, getByID is returned unchanged.
+//
+// Parameters:
+// - meta: The provider meta object containing the authenticated client
+// - resourceType: A key identifying the resource type, unique within the provider
+// - getByID: The SDK function reading an object by ID
+func Memoized[T any](
+	meta any,
+	resourceType string,
+	getByID func(string) (*T, error),
+) func(string) (*T, error) {
+	cache := forMeta(meta)
+	if cache == nil {
+		return getByID
+	}
+
+	return func(id string) (*T, error) {
+		if cache.isWritten(id) {
+			return getByID(id)
+		}
+
+		tc := cache.typeCache(resourceType)
+		tc.mu.Lock()
+		if tc.items == nil {
+			tc.items = map[string]any{}
+		}
+		entry, ok := tc.items[id].(*memoEntry)
+		if !ok {
+			entry = &memoEntry{}
+			tc.items[id] = entry
+		}
+		tc.mu.Unlock()
+
+		entry.once.Do(func() {
+			var item *T
+			item, entry.err = getByID(id)
+			if entry.err == nil {
+				entry.data, entry.err = json.Marshal(item)
+			}
+		})
+
+		if entry.err != nil {
+			tc.mu.Lock()
+			if tc.items[id] == entry {
+				delete(tc.items, id)
+			}
+			tc.mu.Unlock()
+			return nil, entry.err
+		}
+
+		var item T
+		if err := json.Unmarshal(entry.data, &item); err != nil {
+			return nil, fmt.Errorf("failed to copy cached %s (ID: %s): %v", resourceType, id, err)
+		}
+		return &item, nil
+	}
+}
+
+// Invalidate drops an ID from every resource type cached for meta and marks it as written, so
+// every later read of that ID in the run goes to the API, including when a prefetch was already
+// in flight at the time of the write. IDs are not unique across types, but invalidating a
+// matching ID of another type only costs that type one direct read. Called by the CRUD helpers
+// after every write, and by the resources writing parts of another resource's object.
+func Invalidate(meta any, id string) {
+	cache := forMeta(meta)
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	cache.written[id] = true
+	types := make([]*typeCache, 0, len(cache.types))
+	for _, tc := range cache.types {
+		types = append(types, tc)
+	}
+	cache.mu.Unlock()
+
+	for _, tc := range types {
+		tc.mu.Lock()
+		delete(tc.items, id)
+		tc.mu.Unlock()
+	}
+}
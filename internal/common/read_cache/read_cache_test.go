@@ -0,0 +1,143 @@
+package read_cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testObject struct {
+	ID   string
+	Name string
+}
+
+type testAPI struct {
+	objects   []testObject
+	listCalls int
+	getCalls  int
+}
+
+func (a *testAPI) list() ([]testObject, error) {
+	a.listCalls++
+	return a.objects, nil
+}
+
+func (a *testAPI) getByID(id string) (*testObject, error) {
+	a.getCalls++
+	for _, object := range a.objects {
+		if object.ID == id {
+			return &object, nil
+		}
+	}
+	return nil, errors.New(`{"status_code":404,"message":"Not Found"}`)
+}
+
+func (a *testAPI) getter(meta any) func(string) (*testObject, error) {
+	return Getter(meta, "test_object", a.list, func(o *testObject) string { return o.ID }, a.getByID)
+}
+
+func TestGetterDisabled(t *testing.T) {
+	api := &testAPI{objects: []testObject{{ID: "1", Name: "one"}}}
+	meta := &jamfpro.Client{}
+
+	object, err := api.getter(meta)("1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "one", object.Name)
+	assert.Zero(t, api.listCalls)
+	assert.Equal(t, 1, api.getCalls)
+}
+
+func TestGetterServesReadsFromPrefetch(t *testing.T) {
+	api := &testAPI{objects: []testObject{{ID: "1", Name: "one"}, {ID: "2", Name: "two"}}}
+	meta := &jamfpro.Client{}
+	Enable(meta)
+
+	for _, id := range []string{"1", "2", "1"} {
+		_, err := api.getter(meta)(id)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, api.listCalls)
+	assert.Zero(t, api.getCalls)
+
+	_, err := api.getter(meta)("3")
+	assert.ErrorContains(t, err, "404")
+	assert.Equal(t, 1, api.getCalls)
+}
+
+func TestInvalidateBypassesPrefetch(t *testing.T) {
+	api := &testAPI{objects: []testObject{{ID: "1", Name: "one"}}}
+	meta := &jamfpro.Client{}
+	Enable(meta)
+
+	_, err := api.getter(meta)("1")
+	require.NoError(t, err)
+
+	api.objects[0].Name = "renamed"
+	Invalidate(meta, "1")
+
+	object, err := api.getter(meta)("1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "renamed", object.Name)
+	assert.Equal(t, 1, api.getCalls)
+}
+
+func TestMemoizedReadsEachIDOnce(t *testing.T) {
+	api := &testAPI{objects: []testObject{{ID: "1", Name: "one"}, {ID: "2", Name: "two"}}}
+	meta := &jamfpro.Client{}
+	Enable(meta)
+	get := Memoized(meta, "test_object", api.getByID)
+
+	for _, id := range []string{"1", "2", "1", "1"} {
+		_, err := get(id)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, api.getCalls)
+
+	object, err := get("1")
+	require.NoError(t, err)
+	object.Name = "modified by a reader"
+
+	object, err = get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "one", object.Name, "readers share the cached object")
+}
+
+func TestMemoizedDoesNotKeepFailures(t *testing.T) {
+	api := &testAPI{}
+	meta := &jamfpro.Client{}
+	Enable(meta)
+	get := Memoized(meta, "test_object", api.getByID)
+
+	_, err := get("1")
+	assert.ErrorContains(t, err, "404")
+
+	api.objects = []testObject{{ID: "1", Name: "one"}}
+	object, err := get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "one", object.Name)
+	assert.Equal(t, 2, api.getCalls)
+}
+
+func TestInvalidateBypassesMemoizedRead(t *testing.T) {
+	api := &testAPI{objects: []testObject{{ID: "1", Name: "one"}}}
+	meta := &jamfpro.Client{}
+	Enable(meta)
+	get := Memoized(meta, "test_object", api.getByID)
+
+	_, err := get("1")
+	require.NoError(t, err)
+
+	api.objects[0].Name = "renamed"
+	Invalidate(meta, "1")
+
+	object, err := get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", object.Name)
+	assert.Equal(t, 2, api.getCalls)
+}
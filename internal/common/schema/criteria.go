@@ -138,3 +138,17 @@ func CriteriaResource(ctx context.Context) resourceschema.ListNestedBlock {
 		},
 	}
 }
+
+// ValidateCriteria returns the option, shared by resources with a criteria block, to check the
+// criteria against those available in Jamf Pro at plan time.
+func ValidateCriteria(ctx context.Context) resourceschema.BoolAttribute {
+	return resourceschema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Description: "When true, the plan checks each criterion name against the criteria available in Jamf Pro, " +
+			"including extension attributes, and checks that each search type can be used with its criterion's " +
+			"data type. Jamf Pro has no API listing its built-in criteria, so they are bundled with the provider " +
+			"and an unknown name is reported as a warning suggesting similar names, rather than an error.",
+	}
+}
@@ -0,0 +1,33 @@
+// Package scope_locks holds the locks serialising changes to the scope of policies and
+// configuration profiles.
+//
+// Jamf Pro only accepts the whole object, so both the resources owning a policy or profile and the
+// resources managing a single scope target read the object, change it and write it back. Each holds
+// the object's lock from the read until the write, as Terraform applies them in parallel.
+package scope_locks
+
+import "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/mutexkv"
+
+var locks = mutexkv.New()
+
+// Policy locks the scope of the policy with the ID, returning the function which releases it.
+func Policy(id string) func() {
+	return lock("policy/" + id)
+}
+
+// MacOSConfigurationProfile locks the scope of the macOS configuration profile with the ID,
+// returning the function which releases it.
+func MacOSConfigurationProfile(id string) func() {
+	return lock("macos_configuration_profile/" + id)
+}
+
+// MobileDeviceConfigurationProfile locks the scope of the mobile device configuration profile with
+// the ID, returning the function which releases it.
+func MobileDeviceConfigurationProfile(id string) func() {
+	return lock("mobile_device_configuration_profile/" + id)
+}
+
+func lock(key string) func() {
+	locks.Lock(key)
+	return func() { locks.Unlock(key) }
+}
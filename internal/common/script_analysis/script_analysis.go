@@ -0,0 +1,214 @@
+// Package script_analysis performs static checks of Jamf Pro script contents.
+//
+// Jamf Pro runs scripts with the mount point, computer name and username as $1 to $3, and the
+// values of parameters 4 to 11 set by a policy as $4 to $11. Jamf Pro itself does not check
+// scripts, so a script saved with Windows line endings, or one reading a parameter that has no
+// label, is only found to be broken once it runs on a computer.
+package script_analysis
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Parameters passed by Jamf Pro that a policy can set.
+const (
+	FirstParameter = 4
+	LastParameter  = 11
+)
+
+// Interpreter is the language of a script, taken from its shebang.
+type Interpreter string
+
+const (
+	InterpreterBash      Interpreter = "bash"
+	InterpreterZsh       Interpreter = "zsh"
+	InterpreterSh        Interpreter = "sh"
+	InterpreterPython    Interpreter = "python"
+	InterpreterOsascript Interpreter = "osascript"
+)
+
+// SupportedInterpreters lists the interpreters whose scripts can be analysed.
+var SupportedInterpreters = []Interpreter{
+	InterpreterBash, InterpreterZsh, InterpreterSh, InterpreterPython, InterpreterOsascript,
+}
+
+// Parameters describes the parameters a script reads.
+type Parameters struct {
+	// Read holds the parameter numbers the script reads, e.g. 4 for $4.
+	Read map[int]bool
+	// All is set when the script reads its arguments as a whole, e.g. "$@" or sys.argv, so that the
+	// parameters it reads cannot be known.
+	All bool
+}
+
+// Reads reports whether the script may read the parameter.
+func (p Parameters) Reads(number int) bool {
+	return p.All || p.Read[number]
+}
+
+var (
+	shellBracedParameter   = regexp.MustCompile(`\$\{(\d+)`)
+	shellParameter         = regexp.MustCompile(`\$(\d)`)
+	zshParameter           = regexp.MustCompile(`\$(\d+)`)
+	shellTwoDigitParameter = regexp.MustCompile(`\$(1[01])`)
+	shellAllParameters     = regexp.MustCompile(`\$\{?[@*]|\$argv\b|\bshift\b`)
+	pythonParameter        = regexp.MustCompile(`\bsys\.argv\[(\d+)\]`)
+	pythonAllParameters    = regexp.MustCompile(`\bsys\.argv\b`)
+	osascriptParameter     = regexp.MustCompile(`(?i)\bitem\s+(\d+)\s+of\s+argv\b`)
+	osascriptAllParameters = regexp.MustCompile(`(?i)\bargv\b`)
+)
+
+// ParseInterpreter returns the interpreter named by the shebang of the script, e.g. "#!/bin/zsh"
+// or "#!/usr/bin/env python3", and an error when there is no shebang or the interpreter is not
+// supported.
+func ParseInterpreter(contents string) (Interpreter, error) {
+	firstLine, _, _ := strings.Cut(contents, "\n")
+	firstLine = strings.TrimSuffix(firstLine, "\r")
+	if !strings.HasPrefix(firstLine, "#!") {
+		return "", fmt.Errorf("the script does not start with a shebang, e.g. #!/bin/zsh")
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(firstLine, "#!"))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("the shebang does not name an interpreter")
+	}
+
+	program := path.Base(fields[0])
+	if program == "env" && len(fields) > 1 {
+		program = path.Base(fields[1])
+	}
+
+	switch {
+	case program == "bash", program == "zsh", program == "sh", program == "osascript":
+		return Interpreter(program), nil
+	case strings.HasPrefix(program, "python"):
+		return InterpreterPython, nil
+	default:
+		return "", fmt.Errorf("the shebang names the interpreter %q, which is not one of %s", program, joinInterpreters())
+	}
+}
+
+func joinInterpreters() string {
+	names := make([]string, len(SupportedInterpreters))
+	for i, interpreter := range SupportedInterpreters {
+		names[i] = string(interpreter)
+	}
+	return strings.Join(names, ", ")
+}
+
+// ReadParameters returns the parameters a script written for the interpreter reads. Comment lines
+// are ignored.
+func ReadParameters(contents string, interpreter Interpreter) Parameters {
+	code := stripCommentLines(contents, interpreter)
+	params := Parameters{Read: map[int]bool{}}
+	add := func(pattern *regexp.Regexp) {
+		for _, match := range pattern.FindAllStringSubmatch(code, -1) {
+			number, _ := strconv.Atoi(match[1])
+			params.Read[number] = true
+		}
+	}
+
+	switch interpreter {
+	case InterpreterPython:
+		add(pythonParameter)
+		// Reading a single argument also refers to sys.argv, so only other uses read every argument.
+		params.All = pythonAllParameters.MatchString(pythonParameter.ReplaceAllString(code, ""))
+	case InterpreterOsascript:
+		add(osascriptParameter)
+		params.All = osascriptAllParameters.MatchString(osascriptParameter.ReplaceAllString(code, ""))
+	case InterpreterZsh:
+		add(shellBracedParameter)
+		add(zshParameter)
+		params.All = shellAllParameters.MatchString(code)
+	default:
+		// Outside zsh, $10 is $1 followed by a 0.
+		add(shellBracedParameter)
+		add(shellParameter)
+		params.All = shellAllParameters.MatchString(code)
+	}
+
+	return params
+}
+
+// Analyze returns a description of each problem found in the script contents: a missing or
+// unsupported shebang, CRLF line endings, content that is not UTF-8, and parameters 4 to 11 that
+// are read without a label, or labelled but never read. labels holds the label of each parameter,
+// keyed by its number.
+func Analyze(contents string, labels map[int]string) []string {
+	var findings []string
+
+	if !utf8.ValidString(contents) {
+		findings = append(findings, fmt.Sprintf("the script is not valid UTF-8, from byte %d", invalidUTF8Offset(contents)))
+	}
+
+	if index := strings.Index(contents, "\r\n"); index >= 0 {
+		line := strings.Count(contents[:index], "\n") + 1
+		findings = append(findings, fmt.Sprintf("the script has Windows (CRLF) line endings, from line %d", line))
+	}
+
+	interpreter, err := ParseInterpreter(contents)
+	if err != nil {
+		return append(findings, err.Error())
+	}
+
+	if interpreter == InterpreterBash || interpreter == InterpreterSh {
+		code := stripCommentLines(contents, interpreter)
+		for _, match := range shellTwoDigitParameter.FindAllStringSubmatch(code, -1) {
+			finding := fmt.Sprintf("$%s is read as $1 followed by %q in %s, use ${%s}", match[1], match[1][1:], interpreter, match[1])
+			if !slices.Contains(findings, finding) {
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	params := ReadParameters(contents, interpreter)
+	for number := FirstParameter; number <= LastParameter; number++ {
+		label := strings.TrimSpace(labels[number])
+		switch {
+		case params.Read[number] && label == "":
+			findings = append(findings, fmt.Sprintf("the script reads parameter %d, but parameter%d has no label", number, number))
+		case !params.Reads(number) && label != "":
+			findings = append(findings, fmt.Sprintf("parameter%d is labelled %q, but the script never reads parameter %d", number, label, number))
+		}
+	}
+
+	return findings
+}
+
+// stripCommentLines blanks the lines of the script that are comments, which keeps the shebang out
+// of the analysis too.
+func stripCommentLines(contents string, interpreter Interpreter) string {
+	prefixes := []string{"#"}
+	if interpreter == InterpreterOsascript {
+		prefixes = append(prefixes, "--")
+	}
+
+	lines := strings.Split(contents, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(trimmed, prefix) {
+				lines[i] = ""
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// invalidUTF8Offset returns the offset of the first byte of contents that is not valid UTF-8.
+func invalidUTF8Offset(contents string) int {
+	for offset := 0; offset < len(contents); {
+		r, size := utf8.DecodeRuneInString(contents[offset:])
+		if r == utf8.RuneError && size <= 1 {
+			return offset
+		}
+		offset += size
+	}
+	return len(contents)
+}
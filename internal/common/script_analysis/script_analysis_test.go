@@ -0,0 +1,65 @@
+package script_analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterpreter(t *testing.T) {
+	for contents, want := range map[string]Interpreter{
+		"#!/bin/zsh\n":                InterpreterZsh,
+		"#!/bin/bash -e\n":            InterpreterBash,
+		"#!/bin/sh":                   InterpreterSh,
+		"#!/usr/bin/env python3\n":    InterpreterPython,
+		"#!/usr/bin/osascript\r\n":    InterpreterOsascript,
+		"#! /usr/local/bin/python3\n": InterpreterPython,
+	} {
+		got, err := ParseInterpreter(contents)
+		require.NoError(t, err, contents)
+		assert.Equal(t, want, got, contents)
+	}
+
+	_, err := ParseInterpreter("echo hello\n")
+	assert.ErrorContains(t, err, "shebang")
+
+	_, err = ParseInterpreter("#!/usr/bin/perl\n")
+	assert.ErrorContains(t, err, `"perl"`)
+}
+
+func TestReadParameters(t *testing.T) {
+	shell := ReadParameters("#!/bin/bash\n# $6 is documented only\nuser=\"$4\"\nmode=${5:-fast}\necho $10\n", InterpreterBash)
+	assert.Equal(t, map[int]bool{1: true, 4: true, 5: true}, shell.Read)
+	assert.False(t, shell.All)
+
+	zsh := ReadParameters("#!/bin/zsh\necho $10 ${11}\n", InterpreterZsh)
+	assert.Equal(t, map[int]bool{10: true, 11: true}, zsh.Read)
+
+	assert.True(t, ReadParameters("#!/bin/sh\nfor arg in \"$@\"; do echo $arg; done\n", InterpreterSh).All)
+
+	python := ReadParameters("#!/usr/bin/env python3\nimport sys\nname = sys.argv[4]\n", InterpreterPython)
+	assert.Equal(t, map[int]bool{4: true}, python.Read)
+	assert.False(t, python.All)
+	assert.True(t, ReadParameters("#!/usr/bin/env python3\nimport sys\nargs = sys.argv[4:]\n", InterpreterPython).All)
+
+	osascript := ReadParameters("#!/usr/bin/osascript\non run argv\n  display dialog (item 4 of argv)\nend run\n", InterpreterOsascript)
+	assert.Equal(t, map[int]bool{4: true}, osascript.Read)
+	assert.True(t, osascript.All, "on run argv refers to the whole argument list")
+}
+
+func TestAnalyze(t *testing.T) {
+	contents := "#!/bin/bash\r\necho \"$4\" \"$10\"\r\necho \xff\r\n"
+	findings := Analyze(contents, map[int]string{5: "Mode"})
+
+	assert.Equal(t, []string{
+		"the script is not valid UTF-8, from byte 35",
+		"the script has Windows (CRLF) line endings, from line 1",
+		`$10 is read as $1 followed by "0" in bash, use ${10}`,
+		"the script reads parameter 4, but parameter4 has no label",
+		`parameter5 is labelled "Mode", but the script never reads parameter 5`,
+	}, findings)
+
+	assert.Empty(t, Analyze("#!/bin/zsh\necho \"$4\" \"$10\"\n", map[int]string{4: "User", 10: "Flag"}))
+	assert.Equal(t, []string{"the script does not start with a shebang, e.g. #!/bin/zsh"}, Analyze("echo hi\n", nil))
+}
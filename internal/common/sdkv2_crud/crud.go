@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +36,7 @@ type providerStateFunc[resourceType any] func(d *schema.ResourceData, resource *
 type providerReadFunc func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics
 
 // Create is a shared helper that handles the creation of a new resource in Jamf Pro with retry logic and state management.
+// Only transient errors (409, 429, 5xx and network failures) are retried; other API errors fail immediately.
 // It accepts generic types for the SDK payload and response to maintain type safety while being reusable.
 //
 // Parameters:
@@ -70,13 +72,13 @@ func Create[sdkPayloadType any, sdkResponseType any](
 		var apiErr error
 		outcomeResponse, apiErr = serverOutcomeFunc(payload)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return classifyAPIError(apiErr, false)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return append(diags, diag.FromErr(fmt.Errorf("failed to create %s after retries: %v", payloadtypeName, err))...)
+		return append(diags, diag.FromErr(fmt.Errorf("failed to create %s %s: %v", payloadtypeName, describeFailure(err), err))...)
 	}
 
 	idField, err := getIDField(outcomeResponse)
@@ -85,6 +87,7 @@ func Create[sdkPayloadType any, sdkResponseType any](
 	}
 
 	d.SetId(idField.(string))
+	read_cache.Invalidate(meta, d.Id())
 
 	return append(diags, reader(ctx, d, meta)...)
 }
@@ -125,20 +128,24 @@ func Update[sdkPayloadType any, sdkResponseType any](
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := outcomeFunc(resourceID, payload)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return classifyAPIError(apiErr, false)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf pro %s (ID: %s) after retries: %v", payloadtypeName, resourceID, err))
+		return diag.FromErr(fmt.Errorf("failed to update Jamf pro %s (ID: %s) %s: %v", payloadtypeName, resourceID, describeFailure(err), err))
 	}
 
+	read_cache.Invalidate(meta, resourceID)
+
 	return append(diags, reader(ctx, d, meta)...)
 }
 
 // Read is a shared helper that retrieves the current state of a resource from Jamf Pro and updates the Terraform state.
-// It includes retry logic and can optionally remove deleted resources from state.
+// It includes retry logic and can optionally remove deleted resources from state. A 404 is only retried while
+// the resource is new, allowing for read-after-create delays without stalling refreshes of deleted resources.
+// Resources with an identity schema have their ID recorded in the identity.
 //
 // Parameters:
 // - ctx: The context for the operation, used for timeouts and cancellation
@@ -167,7 +174,7 @@ func Read[sdkResponseType any](
 		var apiErr error
 		response, apiErr = serverOutcomeFunc(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return classifyAPIError(apiErr, d.IsNewResource())
 		}
 		return nil
 	})
@@ -176,7 +183,12 @@ func Read[sdkResponseType any](
 		return append(diags, errors.HandleResourceNotFoundError(err, d, removeDeleteResourcesFromState)...)
 	}
 
-	return append(diags, providerStateFunc(d, response)...)
+	diags = append(diags, providerStateFunc(d, response)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, SetIDIdentity(d)...)
 }
 
 // Delete is a shared helper that removes a resource from Jamf Pro with retry logic.
@@ -197,7 +209,7 @@ func Delete(ctx context.Context, d *schema.ResourceData, meta any, serverOutcome
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := serverOutcomeFunc(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return classifyAPIError(apiErr, false)
 		}
 		return nil
 	})
@@ -208,11 +220,12 @@ func Delete(ctx context.Context, d *schema.ResourceData, meta any, serverOutcome
 			resourceName = nameVal.(string)
 		}
 		if resourceName != "" {
-			return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro resource '%s' (ID: %s) after retries: %v", resourceName, resourceID, err))
+			return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro resource '%s' (ID: %s) %s: %v", resourceName, resourceID, describeFailure(err), err))
 		}
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro resource (ID: %s) after retries: %v", resourceID, err))
+		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro resource (ID: %s) %s: %v", resourceID, describeFailure(err), err))
 	}
 
+	read_cache.Invalidate(meta, resourceID)
 	d.SetId("")
 
 	return diags
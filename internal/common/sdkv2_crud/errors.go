@@ -0,0 +1,168 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// apiErrorInfo represents the HTTP status and message extracted from an SDK error
+type apiErrorInfo struct {
+	StatusCode int
+	Message    string
+}
+
+// statusCodePatterns match the status code as rendered by go-api-http-client's APIError, either
+// as its JSON serialisation or its fallback text form. The SDK wraps these errors with %v, so the
+// structured value is usually lost and only the text survives.
+var statusCodePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`"status_code":\s*(\d{3})`),
+	regexp.MustCompile(`StatusCode=(\d{3})`),
+}
+
+// messagePattern matches the message field of a JSON serialised APIError
+var messagePattern = regexp.MustCompile(`"message":\s*"((?:[^"\\]|\\.)*)"`)
+
+// networkErrorPatterns are fragments of transport level failures which are safe to retry
+var networkErrorPatterns = []string{
+	"connection refused",
+	"connection reset",
+	"broken pipe",
+	"no such host",
+	"i/o timeout",
+	"tls handshake timeout",
+	"unexpected eof",
+	"server closed idle connection",
+	"client.timeout exceeded",
+	// Admission timeouts of the request limiter (internal/common/throttle), which the SDK surfaces as text
+	"timed out waiting for a request slot",
+	"timed out waiting for request rate limit",
+	"timed out waiting for jamf pro throttling to clear",
+}
+
+// extractAPIErrorInfo inspects an error returned by the SDK and extracts the HTTP status code and
+// API message where present. A zero StatusCode means no HTTP response was associated with the error.
+func extractAPIErrorInfo(err error) apiErrorInfo {
+	if err == nil {
+		return apiErrorInfo{}
+	}
+
+	var apiErr *response.APIError
+	if errors.As(err, &apiErr) {
+		return apiErrorInfo{StatusCode: apiErr.StatusCode, Message: apiErr.Message}
+	}
+
+	info := apiErrorInfo{}
+	errorText := err.Error()
+
+	for _, pattern := range statusCodePatterns {
+		if match := pattern.FindStringSubmatch(errorText); match != nil {
+			info.StatusCode, _ = strconv.Atoi(match[1])
+			break
+		}
+	}
+
+	if match := messagePattern.FindStringSubmatch(errorText); match != nil {
+		if unquoted, unquoteErr := strconv.Unquote(`"` + match[1] + `"`); unquoteErr == nil {
+			info.Message = unquoted
+		} else {
+			info.Message = match[1]
+		}
+	}
+
+	return info
+}
+
+// isNetworkError reports whether an error without an HTTP status was caused by the transport
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	errorTextLower := strings.ToLower(err.Error())
+	for _, pattern := range networkErrorPatterns {
+		if strings.Contains(errorTextLower, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Retryable/non-retryable codes based on knowledge of the JP's API behaviors.
+// Conflicts, throttling and server side faults are transient; every other 4xx is permanent.
+func isRetryableAPIError(err error, info apiErrorInfo) bool {
+	if info.StatusCode == 0 {
+		return isNetworkError(err)
+	}
+
+	retryableCodes := []int{
+		http.StatusRequestTimeout,
+		http.StatusConflict,
+		http.StatusTooManyRequests,
+	}
+
+	return slices.Contains(retryableCodes, info.StatusCode) || info.StatusCode >= http.StatusInternalServerError
+}
+
+// classifyAPIError wraps an SDK error as retryable or non-retryable for use within retry.RetryContext.
+// When retryNotFound is true a 404 is also retried, covering read-after-create consistency delays.
+func classifyAPIError(err error, retryNotFound bool) *retry.RetryError {
+	info := extractAPIErrorInfo(err)
+
+	if isRetryableAPIError(err, info) || (retryNotFound && info.StatusCode == http.StatusNotFound) {
+		return retry.RetryableError(err)
+	}
+
+	return retry.NonRetryableError(&permanentAPIError{info: info, err: err})
+}
+
+// ClassifyAPIError wraps an SDK error as retryable or non-retryable for use within
+// retry.RetryContext, for reads made outside of the CRUD helpers such as data sources. Client
+// errors other than conflicts and throttling, e.g. a 400 for an invalid filter, are not retried.
+func ClassifyAPIError(err error) *retry.RetryError {
+	return classifyAPIError(err, false)
+}
+
+// permanentAPIError is returned for errors which were not retried, carrying the status and message
+// reported by Jamf Pro so that they surface directly in the diagnostic.
+type permanentAPIError struct {
+	info apiErrorInfo
+	err  error
+}
+
+func (e *permanentAPIError) Error() string {
+	if e.info.StatusCode == 0 {
+		return e.err.Error()
+	}
+
+	message := e.info.Message
+	if message == "" {
+		message = http.StatusText(e.info.StatusCode)
+	}
+
+	return fmt.Sprintf("Jamf Pro returned %d %s: %s: %v", e.info.StatusCode, http.StatusText(e.info.StatusCode), message, e.err)
+}
+
+func (e *permanentAPIError) Unwrap() error {
+	return e.err
+}
+
+// describeFailure returns the suffix used in diagnostics, distinguishing errors which failed
+// immediately from those which exhausted their retries
+func describeFailure(err error) string {
+	var permanentErr *permanentAPIError
+	if errors.As(err, &permanentErr) {
+		return "(non-retryable error)"
+	}
+	return "after retries"
+}
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/throttle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sdkError(statusCode int, message string) error {
+	apiErr := &response.APIError{StatusCode: statusCode, Method: "POST", URL: "https://example.jamfcloud.com/JSSResource/policies/id/0", Message: message}
+	return fmt.Errorf("failed to create policy: %v", apiErr)
+}
+
+func TestExtractAPIErrorInfo(t *testing.T) {
+	t.Run("wrapped JSON error text", func(t *testing.T) {
+		info := extractAPIErrorInfo(sdkError(400, "Problem with category"))
+		assert.Equal(t, 400, info.StatusCode)
+		assert.Equal(t, "Problem with category", info.Message)
+	})
+
+	t.Run("structured error", func(t *testing.T) {
+		info := extractAPIErrorInfo(fmt.Errorf("wrapped: %w", &response.APIError{StatusCode: 503, Message: "Service Unavailable"}))
+		assert.Equal(t, 503, info.StatusCode)
+		assert.Equal(t, "Service Unavailable", info.Message)
+	})
+
+	t.Run("error without status", func(t *testing.T) {
+		info := extractAPIErrorInfo(errors.New("failed to marshal payload"))
+		assert.Zero(t, info.StatusCode)
+	})
+}
+
+func TestClassifyAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		retryNotFound bool
+		wantRetryable bool
+	}{
+		{name: "bad request", err: sdkError(400, "Bad Request"), wantRetryable: false},
+		{name: "unauthorized", err: sdkError(401, "Unauthorized"), wantRetryable: false},
+		{name: "not found", err: sdkError(404, "Not Found"), wantRetryable: false},
+		{name: "not found while new", err: sdkError(404, "Not Found"), retryNotFound: true, wantRetryable: true},
+		{name: "conflict", err: sdkError(409, "Conflict"), wantRetryable: true},
+		{name: "throttled", err: sdkError(429, "Too Many Requests"), wantRetryable: true},
+		{name: "server error", err: sdkError(500, "Internal Server Error"), wantRetryable: true},
+		{name: "bad gateway", err: sdkError(502, "Bad Gateway"), wantRetryable: true},
+		{name: "network error", err: errors.New("dial tcp 10.0.0.1:443: connect: connection refused"), wantRetryable: true},
+		{name: "unknown error", err: errors.New("failed to marshal payload"), wantRetryable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryErr := classifyAPIError(tt.err, tt.retryNotFound)
+			assert.Equal(t, tt.wantRetryable, retryErr.Retryable)
+		})
+	}
+}
+
+func TestClassifyLimiterTimeout(t *testing.T) {
+	limiter := throttle.NewLimiter(throttle.Config{MaxConcurrentRequests: 1, MaxQueueWait: time.Millisecond})
+	release, err := limiter.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	_, err = limiter.Acquire(context.Background())
+	require.Error(t, err)
+
+	retryErr := classifyAPIError(fmt.Errorf("failed to get policy: Get \"https://example.jamfcloud.com/JSSResource/policies/id/1\": %v", err), false)
+	assert.True(t, retryErr.Retryable, "waiting for a request slot timed out: %v", err)
+}
+
+func TestPermanentAPIErrorMessage(t *testing.T) {
+	retryErr := classifyAPIError(sdkError(400, "Problem with category"), false)
+
+	assert.Contains(t, retryErr.Err.Error(), "400 Bad Request")
+	assert.Contains(t, retryErr.Err.Error(), "Problem with category")
+	assert.Equal(t, "(non-retryable error)", describeFailure(retryErr.Err))
+	assert.Equal(t, "after retries", describeFailure(errors.New("timeout while waiting for state to become 'success'")))
+}
@@ -0,0 +1,52 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// identityIDAttribute is the identity attribute holding the Jamf Pro ID of the resource.
+const identityIDAttribute = "id"
+
+// IDIdentity returns the resource identity for resources identified by their Jamf Pro ID. The
+// identity is returned by list resources so that `terraform query` results can be imported.
+func IDIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				identityIDAttribute: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "The Jamf Pro ID of the resource.",
+				},
+			}
+		},
+	}
+}
+
+// ImportStatePassthroughWithIdentity imports a resource by its ID, given either as the import ID
+// or as the `id` identity attribute.
+func ImportStatePassthroughWithIdentity() schema.StateContextFunc {
+	return schema.ImportStatePassthroughWithIdentity(identityIDAttribute)
+}
+
+// SetIDIdentity records the resource ID in its identity. Resources without an identity schema and
+// resources removed from state are left unchanged, so it is safe to call from any read.
+func SetIDIdentity(d *schema.ResourceData) diag.Diagnostics {
+	if d.Id() == "" {
+		return nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return nil
+	}
+
+	if err := identity.Set(identityIDAttribute, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set resource identity: %v", err))
+	}
+
+	return nil
+}
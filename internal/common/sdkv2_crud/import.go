@@ -0,0 +1,26 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SingletonImportID is accepted as the import ID by every settings resource which exists exactly
+// once per Jamf Pro instance.
+const SingletonImportID = "jamfpro_settings"
+
+// ImportStateSingleton returns an importer for settings resources which exist exactly once per
+// Jamf Pro instance. The import ID must be SingletonImportID or the resource's own singleton ID;
+// the resource's read then populates state from the API.
+func ImportStateSingleton(singletonID string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id := d.Id(); id != SingletonImportID && id != singletonID {
+			return nil, fmt.Errorf("unexpected import ID %q, this resource is a singleton and must be imported with the ID %q or %q", id, SingletonImportID, singletonID)
+		}
+
+		d.SetId(singletonID)
+		return []*schema.ResourceData{d}, nil
+	}
+}
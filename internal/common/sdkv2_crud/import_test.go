@@ -0,0 +1,35 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportStateSingleton(t *testing.T) {
+	const singletonID = "jamfpro_example_singleton"
+	importer := ImportStateSingleton(singletonID)
+
+	for _, id := range []string{SingletonImportID, singletonID} {
+		t.Run(id, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+			d.SetId(id)
+
+			resources, err := importer(context.Background(), d, nil)
+			require.NoError(t, err)
+			require.Len(t, resources, 1)
+			assert.Equal(t, singletonID, resources[0].Id())
+		})
+	}
+
+	t.Run("unexpected ID", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+		d.SetId("1")
+
+		_, err := importer(context.Background(), d, nil)
+		assert.ErrorContains(t, err, SingletonImportID)
+	})
+}
@@ -0,0 +1,215 @@
+// Package sdkv2_list provides Framework list resources for managed resources implemented with
+// the SDKv2 provider.
+//
+// List resources are served by the Framework provider, while most managed resources are still
+// served by the SDKv2 provider through the mux server. The Framework therefore cannot look up
+// the managed resource and identity schemas itself, so they are supplied from the SDKv2
+// resource definition through RawV6Schemas. Results carry the resource identity, which
+// `terraform query` turns into import blocks.
+package sdkv2_list
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource                 = &ListResource{}
+	_ list.ListResourceWithConfigure    = &ListResource{}
+	_ list.ListResourceWithRawV6Schemas = &ListResource{}
+)
+
+// Item is a single object returned by the Jamf Pro list endpoint of a resource type.
+type Item struct {
+	ID   string
+	Name string
+}
+
+// ListFunc returns every object of a resource type.
+type ListFunc func(client *jamfpro.Client) ([]Item, error)
+
+// ListResource lists the objects of an SDKv2 managed resource type.
+type ListResource struct {
+	typeName string
+	resource func() *schema.Resource
+	list     ListFunc
+	client   *jamfpro.Client
+}
+
+// listResourceModel describes the list block configuration.
+type listResourceModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+}
+
+// New creates a list resource for an SDKv2 managed resource.
+//
+// Parameters:
+// - typeName: The type name suffix shared with the managed resource, e.g. "_script"
+// - resource: The SDKv2 managed resource constructor, which must define an identity
+// - list: A function returning every object of the type
+func New(typeName string, resource func() *schema.Resource, list ListFunc) *ListResource {
+	return &ListResource{
+		typeName: typeName,
+		resource: resource,
+		list:     list,
+	}
+}
+
+// Metadata returns the list resource type name, which matches the managed resource.
+func (r *ListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *ListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jamfpro.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *jamfpro.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ListResourceConfigSchema defines the schema of the list block.
+func (r *ListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the `jamfpro%s` objects in Jamf Pro. Each result carries the resource identity, "+
+			"so results can be imported with `terraform query -generate-config-out`.", r.typeName),
+		Attributes: map[string]listschema.Attribute{
+			"name_contains": listschema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return objects whose name contains this value. The match is case-insensitive.",
+			},
+		},
+	}
+}
+
+// RawV6Schemas supplies the managed resource and identity schemas from the SDKv2 resource.
+func (r *ListResource) RawV6Schemas(ctx context.Context, req list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	managed := r.resource()
+	resp.ProtoV6Schema = protoV6Schema(managed.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = protoV6IdentitySchema(managed.ProtoIdentitySchema(ctx)())
+}
+
+// List streams an identity for every object of the type matching the configured filter. When
+// Terraform requests the resource, each object is read through the SDKv2 resource so that
+// generated configuration matches what an import would produce.
+func (r *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data listResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := r.list(r.client)
+	if err != nil {
+		diags.AddError(
+			"Error Listing Jamf Pro Objects",
+			fmt.Sprintf("Failed to list jamfpro%s objects: %v", r.typeName, err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items = filterByName(items, data.NameContains.ValueString())
+	managed := r.resource()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.Name
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), item.ID)...)
+
+			if req.IncludeResource {
+				raw, err := r.readResource(ctx, managed, item.ID, req.ResourceSchema.Type().TerraformType(ctx))
+				if err != nil {
+					result.Diagnostics.AddWarning(
+						"Error Reading Jamf Pro Object",
+						fmt.Sprintf("Failed to read jamfpro%s '%s' (ID: %s), only its identity is returned: %v", r.typeName, item.Name, item.ID, err),
+					)
+				} else {
+					result.Resource.Raw = raw
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readResource reads an object through the SDKv2 resource's read function and returns its state
+// as a value of the managed resource schema type.
+func (r *ListResource) readResource(ctx context.Context, managed *schema.Resource, id string, valueType tftypes.Type) (tftypes.Value, error) {
+	d := managed.Data(&terraform.InstanceState{ID: id})
+
+	if diags := managed.ReadContext(ctx, d, r.client); diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == sdkdiag.Error {
+				return tftypes.Value{}, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+			}
+		}
+	}
+
+	if d.Id() == "" {
+		return tftypes.Value{}, fmt.Errorf("object no longer exists")
+	}
+
+	state, err := d.State().AttrsAsObjectValue(managed.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	packed, err := msgpack.Marshal(state, state.Type())
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return (&tfprotov6.DynamicValue{MsgPack: packed}).Unmarshal(valueType)
+}
+
+// filterByName returns the items whose name contains the filter, ignoring case.
+func filterByName(items []Item, filter string) []Item {
+	if filter == "" {
+		return items
+	}
+
+	filter = strings.ToLower(filter)
+	filtered := make([]Item, 0, len(items))
+	for _, item := range items {
+		if strings.Contains(strings.ToLower(item.Name), filter) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,156 @@
+package sdkv2_list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNames = map[string]string{
+	"1": "Install Rosetta",
+	"2": "Install Xcode",
+	"3": "Rename Computer",
+}
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			name, ok := testNames[d.Id()]
+			if !ok {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(d.Set("name", name))
+		},
+		Identity: crud.IDIdentity(),
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testItems(*jamfpro.Client) ([]Item, error) {
+	return []Item{
+		{ID: "1", Name: "Install Rosetta"},
+		{ID: "2", Name: "Install Xcode"},
+		{ID: "3", Name: "Rename Computer"},
+		{ID: "4", Name: "Install Deleted"},
+	}, nil
+}
+
+func TestRawV6Schemas(t *testing.T) {
+	ctx := context.Background()
+	resp := list.RawV6SchemaResponse{}
+
+	New("_script", testResource, testItems).RawV6Schemas(ctx, list.RawV6SchemaRequest{}, &resp)
+
+	require.NotNil(t, resp.ProtoV6Schema)
+	require.NotNil(t, resp.ProtoV6IdentitySchema)
+
+	names := map[string]bool{}
+	for _, attribute := range resp.ProtoV6Schema.Block.Attributes {
+		names[attribute.Name] = true
+	}
+	assert.True(t, names["id"])
+	assert.True(t, names["name"])
+	require.Len(t, resp.ProtoV6Schema.Block.BlockTypes, 1)
+	assert.Equal(t, "tags", resp.ProtoV6Schema.Block.BlockTypes[0].TypeName)
+
+	require.Len(t, resp.ProtoV6IdentitySchema.IdentityAttributes, 1)
+	assert.Equal(t, "id", resp.ProtoV6IdentitySchema.IdentityAttributes[0].Name)
+	assert.True(t, resp.ProtoV6IdentitySchema.IdentityAttributes[0].RequiredForImport)
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	r := New("_script", testResource, testItems)
+
+	schemaResp := list.ListResourceSchemaResponse{}
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+
+	listRequest := func(filter tftypes.Value, limit int64) list.ListRequest {
+		return list.ListRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"name_contains": filter,
+				}),
+			},
+			IncludeResource: true,
+			Limit:           limit,
+			ResourceSchema: resourceschema.Schema{
+				Attributes: map[string]resourceschema.Attribute{
+					"id":   resourceschema.StringAttribute{Computed: true},
+					"name": resourceschema.StringAttribute{Required: true},
+				},
+				Blocks: map[string]resourceschema.Block{
+					"tags": resourceschema.ListNestedBlock{
+						NestedObject: resourceschema.NestedBlockObject{
+							Attributes: map[string]resourceschema.Attribute{
+								"value": resourceschema.StringAttribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+			ResourceIdentitySchema: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"id": identityschema.StringAttribute{RequiredForImport: true},
+				},
+			},
+		}
+	}
+
+	collect := func(req list.ListRequest) []list.ListResult {
+		stream := list.ListResultsStream{}
+		r.List(ctx, req, &stream)
+
+		var results []list.ListResult
+		for result := range stream.Results {
+			require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+			results = append(results, result)
+		}
+		return results
+	}
+
+	t.Run("filters by name and reads resources", func(t *testing.T) {
+		results := collect(listRequest(tftypes.NewValue(tftypes.String, "install"), 0))
+		require.Len(t, results, 3)
+
+		var id, name string
+		require.False(t, results[1].Identity.GetAttribute(ctx, path.Root("id"), &id).HasError())
+		require.False(t, results[1].Resource.GetAttribute(ctx, path.Root("name"), &name).HasError())
+		assert.Equal(t, "2", id)
+		assert.Equal(t, "Install Xcode", name)
+		assert.Equal(t, "Install Xcode", results[1].DisplayName)
+
+		assert.True(t, results[2].Resource.Raw.IsNull())
+		assert.Len(t, results[2].Diagnostics.Warnings(), 1)
+	})
+
+	t.Run("honours limit", func(t *testing.T) {
+		results := collect(listRequest(tftypes.NewValue(tftypes.String, nil), 2))
+		assert.Len(t, results, 2)
+	})
+}
@@ -0,0 +1,81 @@
+package sdkv2_list
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// protoV6Schema converts an SDKv2 protocol version 5 resource schema to protocol version 6, as
+// the mux server does for the SDKv2 provider.
+func protoV6Schema(in *tfprotov5.Schema) *tfprotov6.Schema {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.Schema{
+		Version: in.Version,
+		Block:   protoV6SchemaBlock(in.Block),
+	}
+}
+
+func protoV6SchemaBlock(in *tfprotov5.SchemaBlock) *tfprotov6.SchemaBlock {
+	if in == nil {
+		return nil
+	}
+
+	block := &tfprotov6.SchemaBlock{
+		Version:            in.Version,
+		Description:        in.Description,
+		DescriptionKind:    tfprotov6.StringKind(in.DescriptionKind),
+		Deprecated:         in.Deprecated,
+		DeprecationMessage: in.DeprecationMessage,
+	}
+
+	for _, attribute := range in.Attributes {
+		block.Attributes = append(block.Attributes, &tfprotov6.SchemaAttribute{
+			Name:               attribute.Name,
+			Type:               attribute.Type,
+			Description:        attribute.Description,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Computed:           attribute.Computed,
+			Sensitive:          attribute.Sensitive,
+			DescriptionKind:    tfprotov6.StringKind(attribute.DescriptionKind),
+			Deprecated:         attribute.Deprecated,
+			WriteOnly:          attribute.WriteOnly,
+			DeprecationMessage: attribute.DeprecationMessage,
+		})
+	}
+
+	for _, nested := range in.BlockTypes {
+		block.BlockTypes = append(block.BlockTypes, &tfprotov6.SchemaNestedBlock{
+			TypeName: nested.TypeName,
+			Block:    protoV6SchemaBlock(nested.Block),
+			Nesting:  tfprotov6.SchemaNestedBlockNestingMode(nested.Nesting),
+			MinItems: nested.MinItems,
+			MaxItems: nested.MaxItems,
+		})
+	}
+
+	return block
+}
+
+// protoV6IdentitySchema converts an SDKv2 protocol version 5 identity schema to protocol version 6.
+func protoV6IdentitySchema(in *tfprotov5.ResourceIdentitySchema) *tfprotov6.ResourceIdentitySchema {
+	if in == nil {
+		return nil
+	}
+
+	identity := &tfprotov6.ResourceIdentitySchema{Version: in.Version}
+	for _, attribute := range in.IdentityAttributes {
+		identity.IdentityAttributes = append(identity.IdentityAttributes, &tfprotov6.ResourceIdentitySchemaAttribute{
+			Name:              attribute.Name,
+			Type:              attribute.Type,
+			RequiredForImport: attribute.RequiredForImport,
+			OptionalForImport: attribute.OptionalForImport,
+			Description:       attribute.Description,
+		})
+	}
+
+	return identity
+}
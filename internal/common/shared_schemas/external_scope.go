@@ -0,0 +1,126 @@
+package sharedschemas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GetSharedSchemaIgnoreExternalScope defines the option, shared by resources with a scope block,
+// to leave scope entries managed elsewhere alone.
+func GetSharedSchemaIgnoreExternalScope() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "When true, scope targets, limitations and exclusions added outside of this resource, e.g. by " +
+			"`jamfpro_policy_scope_target` or `jamfpro_profile_scope_target`, are ignored when reading and kept when " +
+			"updating, instead of being removed.",
+	}
+}
+
+// FilterExternalScope removes from scope, the flattened scope block about to be set in state,
+// every ID and name that is not in the resource's prior state, when ignore_external_scope is set.
+// Those entries were added outside of the resource, so reporting them would plan their removal.
+// Nothing is filtered when there is no prior scope, e.g. on import.
+func FilterExternalScope(d *schema.ResourceData, scope map[string]any) {
+	if !d.Get("ignore_external_scope").(bool) {
+		return
+	}
+
+	prior, ok := d.Get("scope").([]any)
+	if !ok || len(prior) == 0 || prior[0] == nil {
+		return
+	}
+
+	filterScopeBlock(scope, prior[0].(map[string]any))
+}
+
+// filterScopeBlock keeps the entries of each list in block that are also in the matching list of
+// prior, recursing into nested blocks such as limitations and exclusions. Nested blocks left
+// without entries are removed, as the state functions omit empty blocks. It reports whether any
+// list in block still holds entries.
+func filterScopeBlock(block, prior map[string]any) bool {
+	var hasEntries bool
+	for key, value := range block {
+		var nested map[string]any
+		switch v := value.(type) {
+		case []int:
+			var kept []int
+			for _, id := range v {
+				if priorContains(prior, key, id) {
+					kept = append(kept, id)
+				}
+			}
+			block[key] = kept
+			hasEntries = hasEntries || len(kept) > 0
+			continue
+		case []string:
+			var kept []string
+			for _, name := range v {
+				if priorContains(prior, key, name) {
+					kept = append(kept, name)
+				}
+			}
+			block[key] = kept
+			hasEntries = hasEntries || len(kept) > 0
+			continue
+		case []map[string]any:
+			if len(v) > 0 {
+				nested = v[0]
+			}
+		case []any:
+			if len(v) > 0 {
+				nested, _ = v[0].(map[string]any)
+			}
+		}
+
+		if nested == nil {
+			continue
+		}
+		if filterScopeBlock(nested, priorBlock(prior, key)) {
+			hasEntries = true
+		} else {
+			delete(block, key)
+		}
+	}
+	return hasEntries
+}
+
+// priorBlock returns the nested block named key of prior, or an empty block.
+func priorBlock(prior map[string]any, key string) map[string]any {
+	if list, ok := prior[key].([]any); ok && len(list) > 0 {
+		if block, ok := list[0].(map[string]any); ok {
+			return block
+		}
+	}
+	return map[string]any{}
+}
+
+// priorContains reports whether the set named key of prior contains the value.
+func priorContains(prior map[string]any, key string, value any) bool {
+	set, ok := prior[key].(*schema.Set)
+	return ok && set.Contains(value)
+}
+
+// PriorScopeData returns resource data holding the prior state of the scope block, whose schema is
+// scopeElem, and of name_references, so that the resource's scope constructor can rebuild the scope
+// it last applied.
+func PriorScopeData(d *schema.ResourceData, scopeElem *schema.Resource) (*schema.ResourceData, error) {
+	prior, _ := d.GetChange("scope")
+	priorReferences, _ := d.GetChange("name_references")
+
+	scopeSchema := &schema.Schema{Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: scopeElem}
+	data := (&schema.Resource{Schema: map[string]*schema.Schema{
+		"scope":           scopeSchema,
+		"name_references": GetSharedSchemaNameReferences(),
+	}}).Data(nil)
+	if err := data.Set("scope", prior); err != nil {
+		return nil, fmt.Errorf("failed to read prior scope: %v", err)
+	}
+	if err := data.Set("name_references", priorReferences); err != nil {
+		return nil, fmt.Errorf("failed to read prior name references: %v", err)
+	}
+
+	return data, nil
+}
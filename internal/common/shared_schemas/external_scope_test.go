@@ -0,0 +1,82 @@
+package sharedschemas
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var externalScopeTestSchema = map[string]*schema.Schema{
+	"ignore_external_scope": GetSharedSchemaIgnoreExternalScope(),
+	"scope": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     GetSharedmacOSComputerSchemaScope(),
+	},
+}
+
+func externalScopeTestData(t *testing.T, ignore bool) *schema.ResourceData {
+	t.Helper()
+
+	return schema.TestResourceDataRaw(t, externalScopeTestSchema, map[string]any{
+		"ignore_external_scope": ignore,
+		"scope": []any{map[string]any{
+			"all_computers":      false,
+			"computer_group_ids": []any{1},
+			"exclusions": []any{map[string]any{
+				"directory_service_or_local_usernames": []any{"jdoe"},
+			}},
+		}},
+	})
+}
+
+// remoteTestScope is the flattened scope read from Jamf Pro: group 2, the network segment
+// limitation and the building exclusion were added outside of the resource.
+func remoteTestScope() map[string]any {
+	return map[string]any{
+		"all_computers":      false,
+		"computer_group_ids": []int{1, 2},
+		"limitations": []map[string]any{{
+			"network_segment_ids": []int{7},
+		}},
+		"exclusions": []map[string]any{{
+			"building_ids":                         []int{3},
+			"directory_service_or_local_usernames": []string{"jdoe"},
+		}},
+	}
+}
+
+func TestFilterExternalScope(t *testing.T) {
+	scope := remoteTestScope()
+	FilterExternalScope(externalScopeTestData(t, true), scope)
+
+	assert.Equal(t, []int{1}, scope["computer_group_ids"])
+	assert.NotContains(t, scope, "limitations", "blocks left empty are removed")
+	require.Contains(t, scope, "exclusions")
+	exclusions := scope["exclusions"].([]map[string]any)[0]
+	assert.Empty(t, exclusions["building_ids"])
+	assert.Equal(t, []string{"jdoe"}, exclusions["directory_service_or_local_usernames"])
+}
+
+func TestFilterExternalScopeDisabled(t *testing.T) {
+	scope := remoteTestScope()
+	FilterExternalScope(externalScopeTestData(t, false), scope)
+
+	assert.Equal(t, remoteTestScope(), scope)
+}
+
+func TestPriorScopeData(t *testing.T) {
+	// Rebuild the data from its state, so that the configured scope is the prior state.
+	config := externalScopeTestData(t, true)
+	config.SetId("1")
+	state := config.State()
+	d := (&schema.Resource{Schema: externalScopeTestSchema}).Data(state)
+
+	prior, err := PriorScopeData(d, GetSharedmacOSComputerSchemaScope())
+	require.NoError(t, err)
+
+	assert.True(t, prior.Get("scope.0.computer_group_ids").(*schema.Set).Contains(1))
+}
@@ -0,0 +1,269 @@
+package sharedschemas
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var errNameReferenceNotResolved = errors.New("failed to resolve referenced names to IDs")
+
+// GetSharedSchemaCategoryWithName defines category_id for resources that also accept
+// category_name. Rather than defaulting to -1, it is set when planning by
+// ResolveCategoryAndSiteNames.
+func GetSharedSchemaCategoryWithName() *schema.Schema {
+	out := GetSharedSchemaCategory()
+	out.Default = nil
+	out.Computed = true
+	out.Description = "The ID of the Jamf Pro category. Defaults to -1, no category, unless `category_name` is set."
+	return out
+}
+
+// GetSharedSchemaCategoryName defines category_name, the name of the category resolved to
+// category_id when planning.
+func GetSharedSchemaCategoryName() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"category_id"},
+		ValidateFunc:  validation.StringIsNotWhiteSpace,
+		Description: "The name of the Jamf Pro category, resolved to `category_id` when planning, for " +
+			"configurations shared by Jamf Pro instances whose category IDs differ.",
+	}
+}
+
+// GetSharedSchemaSiteWithName defines site_id for resources that also accept site_name. Rather
+// than defaulting to -1, it is set when planning by ResolveCategoryAndSiteNames.
+func GetSharedSchemaSiteWithName() *schema.Schema {
+	out := GetSharedSchemaSite()
+	out.Default = nil
+	out.Computed = true
+	out.Description = "The ID of the Jamf Pro site. Defaults to -1, no site, unless `site_name` is set."
+	return out
+}
+
+// GetSharedSchemaSiteName defines site_name, the name of the site resolved to site_id when
+// planning.
+func GetSharedSchemaSiteName() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"site_id"},
+		ValidateFunc:  validation.StringIsNotWhiteSpace,
+		Description: "The name of the Jamf Pro site, resolved to `site_id` when planning, for configurations " +
+			"shared by Jamf Pro instances whose site IDs differ.",
+	}
+}
+
+// GetSharedSchemaNameReferences defines name_references, which holds the IDs the names a resource
+// references objects by resolved to when planning, for its constructor to read.
+func GetSharedSchemaNameReferences() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "The IDs of the objects referenced by name, keyed by kind and name, e.g. " +
+			"`computer_group/All Managed Macs`, as resolved when planning.",
+	}
+}
+
+// WithScopeGroupNames adds <group>_names, the names of groups as an alternative to <group>_ids, to
+// the scope block and its exclusions, e.g. computer_group_names for the group "computer_group".
+// The names are resolved to IDs when planning by ResolveNameReferences.
+func WithScopeGroupNames(scope *schema.Resource, group string, kind *name_references.Kind) *schema.Resource {
+	names := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotWhiteSpace},
+			Description: description,
+		}
+	}
+
+	scope.Schema[group+"_names"] = names(fmt.Sprintf(
+		"The %ss in scope by name, resolved to their IDs when planning. Groups may be given by ID or by name, or both.", kind.Name))
+	exclusions := scope.Schema["exclusions"].Elem.(*schema.Resource)
+	exclusions.Schema[group+"_names"] = names(fmt.Sprintf(
+		"The %ss excluded from scope by name, resolved to their IDs when planning.", kind.Name))
+
+	return scope
+}
+
+// ScopeGroupNamePaths returns the paths of <group>_names in the scope block and its exclusions.
+func ScopeGroupNamePaths(group string, kind *name_references.Kind) []NameReferencePath {
+	return []NameReferencePath{
+		{Path: "scope.0." + group + "_names", Kind: kind},
+		{Path: "scope.0.exclusions.0." + group + "_names", Kind: kind},
+	}
+}
+
+// ResolveCategoryAndSiteNames sets category_id and site_id, when planning, to the IDs category_name
+// and site_name resolve to, or to -1 when neither the ID nor the name is configured.
+func ResolveCategoryAndSiteNames(diff *schema.ResourceDiff, meta any) error {
+	if err := resolveIDByName(diff, meta, "category_id", "category_name", name_references.Category); err != nil {
+		return err
+	}
+	return resolveIDByName(diff, meta, "site_id", "site_name", name_references.Site)
+}
+
+func resolveIDByName(diff *schema.ResourceDiff, meta any, idKey, nameKey string, kind *name_references.Kind) error {
+	if !diff.NewValueKnown(nameKey) {
+		return diff.SetNewComputed(idKey)
+	}
+
+	if name := diff.Get(nameKey).(string); name != "" {
+		id, err := name_references.Resolve(meta, kind, name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", nameKey, err)
+		}
+		return diff.SetNew(idKey, id)
+	}
+
+	config := diff.GetRawConfig()
+	if config.IsKnown() && !config.IsNull() && config.GetAttr(idKey).IsNull() {
+		return diff.SetNew(idKey, -1)
+	}
+	return nil
+}
+
+// NameReferencePath is the path of a name, or of a set or list of names, of objects of Kind.
+type NameReferencePath struct {
+	Path string
+	Kind *name_references.Kind
+}
+
+// ResolveNameReferences resolves the names configured at the paths when planning, and sets
+// name_references to the IDs they resolve to. Every name that is missing or ambiguous is reported,
+// with its path. name_references is unknown until every name is.
+func ResolveNameReferences(diff *schema.ResourceDiff, meta any, paths []NameReferencePath) error {
+	references := map[string]any{}
+	var problems []string
+	for _, path := range paths {
+		if !diff.NewValueKnown(path.Path) {
+			return diff.SetNewComputed("name_references")
+		}
+
+		for _, name := range namesAt(diff.Get(path.Path)) {
+			key := name_references.Key(path.Kind, name)
+			if _, resolved := references[key]; resolved {
+				continue
+			}
+
+			id, err := name_references.Resolve(meta, path.Kind, name)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", path.Path, err))
+				continue
+			}
+			references[key] = strconv.Itoa(id)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w:\n  %s", errNameReferenceNotResolved, strings.Join(problems, "\n  "))
+	}
+
+	prior, _ := diff.Get("name_references").(map[string]any)
+	if diff.Id() != "" && maps.Equal(prior, references) {
+		return nil
+	}
+	return diff.SetNew("name_references", references)
+}
+
+// namesAt returns the non-empty names of a name, or a set or list of names.
+func namesAt(value any) []string {
+	var values []any
+	switch v := value.(type) {
+	case string:
+		values = []any{v}
+	case *schema.Set:
+		values = v.List()
+	case []any:
+		values = v
+	}
+
+	var names []string
+	for _, value := range values {
+		if name, ok := value.(string); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// NameReferenceIDs returns the IDs the names at path, a name or a set or list of names, resolved
+// to when planning, in the order of the names.
+func NameReferenceIDs(d *schema.ResourceData, path string, kind *name_references.Kind) ([]int, error) {
+	references, _ := d.Get("name_references").(map[string]any)
+
+	var ids []int
+	for _, name := range namesAt(d.Get(path)) {
+		id, err := strconv.Atoi(fmt.Sprint(references[name_references.Key(kind, name)]))
+		if err != nil {
+			return nil, fmt.Errorf("%s %q at %s has not been resolved to an ID", kind.Name, name, path)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// FlattenScopeGroupNames moves the IDs of <group>_ids in the flattened scope block, and in its
+// exclusions, that a name in the prior <group>_names resolved to, into <group>_names, so that
+// groups configured by name are read back by name. It is called before FilterExternalScope.
+func FlattenScopeGroupNames(d *schema.ResourceData, scope map[string]any, group string, kind *name_references.Kind) {
+	references, _ := d.Get("name_references").(map[string]any)
+
+	flattenGroupNames(scope, d.Get("scope.0."+group+"_names"), references, group, kind)
+	if exclusions := nestedBlock(scope["exclusions"]); exclusions != nil {
+		flattenGroupNames(exclusions, d.Get("scope.0.exclusions.0."+group+"_names"), references, group, kind)
+	}
+}
+
+func flattenGroupNames(block map[string]any, priorNames any, references map[string]any, group string, kind *name_references.Kind) {
+	nameOf := map[int]string{}
+	for _, name := range namesAt(priorNames) {
+		if id, err := strconv.Atoi(fmt.Sprint(references[name_references.Key(kind, name)])); err == nil {
+			nameOf[id] = name
+		}
+	}
+	if len(nameOf) == 0 {
+		return
+	}
+
+	ids, _ := block[group+"_ids"].([]int)
+	var keptIDs []int
+	var names []string
+	for _, id := range ids {
+		if name, ok := nameOf[id]; ok {
+			names = append(names, name)
+		} else {
+			keptIDs = append(keptIDs, id)
+		}
+	}
+	slices.Sort(names)
+
+	block[group+"_ids"] = keptIDs
+	block[group+"_names"] = names
+}
+
+// nestedBlock returns the single nested block of a flattened block list.
+func nestedBlock(value any) map[string]any {
+	switch v := value.(type) {
+	case []map[string]any:
+		if len(v) > 0 {
+			return v[0]
+		}
+	case []any:
+		if len(v) > 0 {
+			block, _ := v[0].(map[string]any)
+			return block
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package sharedschemas
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var nameReferencesTestSchema = map[string]*schema.Schema{
+	"ignore_external_scope": GetSharedSchemaIgnoreExternalScope(),
+	"name_references":       GetSharedSchemaNameReferences(),
+	"scope": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     WithScopeGroupNames(GetSharedmacOSComputerSchemaScope(), "computer_group", name_references.ComputerGroup),
+	},
+}
+
+func nameReferencesTestData(t *testing.T) *schema.ResourceData {
+	t.Helper()
+
+	d := schema.TestResourceDataRaw(t, nameReferencesTestSchema, map[string]any{
+		"ignore_external_scope": true,
+		"scope": []any{map[string]any{
+			"computer_group_ids":   []any{1},
+			"computer_group_names": []any{"Engineering", "Finance"},
+			"exclusions": []any{map[string]any{
+				"computer_group_names": []any{"Kiosks"},
+			}},
+		}},
+	})
+	require.NoError(t, d.Set("name_references", map[string]any{
+		"computer_group/Engineering": "4",
+		"computer_group/Finance":     "9",
+		"computer_group/Kiosks":      "12",
+	}))
+	return d
+}
+
+func TestNameReferenceIDs(t *testing.T) {
+	d := nameReferencesTestData(t)
+
+	ids, err := NameReferenceIDs(d, "scope.0.computer_group_names", name_references.ComputerGroup)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{4, 9}, ids)
+
+	require.NoError(t, d.Set("name_references", map[string]any{"computer_group/Finance": "9"}))
+	_, err = NameReferenceIDs(d, "scope.0.computer_group_names", name_references.ComputerGroup)
+	assert.ErrorContains(t, err, `computer group "Engineering"`)
+}
+
+func TestFlattenScopeGroupNames(t *testing.T) {
+	d := nameReferencesTestData(t)
+	scope := map[string]any{
+		"computer_group_ids": []int{1, 2, 4, 9},
+		"exclusions": []map[string]any{{
+			"computer_group_ids": []int{12},
+		}},
+	}
+
+	FlattenScopeGroupNames(d, scope, "computer_group", name_references.ComputerGroup)
+	assert.Equal(t, []int{1, 2}, scope["computer_group_ids"])
+	assert.Equal(t, []string{"Engineering", "Finance"}, scope["computer_group_names"])
+	exclusions := scope["exclusions"].([]map[string]any)[0]
+	assert.Empty(t, exclusions["computer_group_ids"])
+	assert.Equal(t, []string{"Kiosks"}, exclusions["computer_group_names"])
+
+	FilterExternalScope(d, scope)
+	assert.Equal(t, []int{1}, scope["computer_group_ids"], "group 2 was added outside of the resource")
+	assert.Equal(t, []string{"Engineering", "Finance"}, scope["computer_group_names"])
+}
+
+func TestPriorScopeDataKeepsNameReferences(t *testing.T) {
+	// Rebuild the data from its state, so that the configured scope is the prior state.
+	config := nameReferencesTestData(t)
+	config.SetId("1")
+	d := (&schema.Resource{Schema: nameReferencesTestSchema}).Data(config.State())
+
+	prior, err := PriorScopeData(d, WithScopeGroupNames(GetSharedmacOSComputerSchemaScope(), "computer_group", name_references.ComputerGroup))
+	require.NoError(t, err)
+
+	ids, err := NameReferenceIDs(prior, "scope.0.exclusions.0.computer_group_names", name_references.ComputerGroup)
+	require.NoError(t, err)
+	assert.Equal(t, []int{12}, ids)
+}
@@ -0,0 +1,223 @@
+package sharedschemas
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// The functions in this file operate on the scope structs of the Jamf Pro SDK, e.g.
+// jamfpro.PolicySubsetScope or jamfpro.MacOSConfigurationProfileSubsetScope, by reflection. The
+// policy and profile scopes hold the same lists under the same field names, but each uses its own
+// element types and differs in whether lists and nested blocks are pointers, so reflection lets the
+// scope target resources and the external scope handling share one implementation.
+
+// HasScopeEntry reports whether the list at field, e.g. []string{"Exclusions", "ComputerGroups"},
+// of the scope struct pointed to by scope contains the ID.
+func HasScopeEntry(scope any, field []string, id int) (bool, error) {
+	list, err := scopeList(reflect.ValueOf(scope), field, false)
+	if err != nil || !list.IsValid() {
+		return false, err
+	}
+
+	for i := 0; i < list.Len(); i++ {
+		if entryKey(list.Index(i)) == idKey(id) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AddScopeEntry appends the ID to the list at field of the scope struct pointed to by scope,
+// creating nested blocks as needed. It reports whether the entry was added, i.e. it was not
+// already present.
+func AddScopeEntry(scope any, field []string, id int) (bool, error) {
+	if present, err := HasScopeEntry(scope, field, id); err != nil || present {
+		return false, err
+	}
+
+	list, err := scopeList(reflect.ValueOf(scope), field, true)
+	if err != nil {
+		return false, err
+	}
+
+	entry := reflect.New(list.Type().Elem()).Elem()
+	idField := entry.FieldByName("ID")
+	if !idField.IsValid() || idField.Kind() != reflect.Int {
+		return false, fmt.Errorf("scope field %s does not hold entries with an ID", strings.Join(field, "."))
+	}
+	idField.SetInt(int64(id))
+	list.Set(reflect.Append(list, entry))
+
+	return true, nil
+}
+
+// RemoveScopeEntry removes the ID from the list at field of the scope struct pointed to by scope.
+// It reports whether the entry was removed, i.e. it was present.
+func RemoveScopeEntry(scope any, field []string, id int) (bool, error) {
+	list, err := scopeList(reflect.ValueOf(scope), field, false)
+	if err != nil || !list.IsValid() {
+		return false, err
+	}
+
+	kept := reflect.MakeSlice(list.Type(), 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		if entryKey(list.Index(i)) != idKey(id) {
+			kept = reflect.Append(kept, list.Index(i))
+		}
+	}
+	if kept.Len() == list.Len() {
+		return false, nil
+	}
+
+	list.Set(kept)
+	return true, nil
+}
+
+// MergeExternalScope adds the entries of remote which are missing from previous to desired.
+// All three must point to scope structs of the same type: desired is the scope built from the
+// configuration, remote the scope currently held by Jamf Pro and previous the scope built from
+// the prior state. Entries in remote but not in previous were added outside of the resource, so
+// they are kept; entries in previous but not in desired were removed from the configuration, so
+// they are not.
+func MergeExternalScope(desired, remote, previous any) {
+	mergeScopeValue(reflect.ValueOf(desired).Elem(), reflect.ValueOf(remote).Elem(), reflect.ValueOf(previous).Elem())
+}
+
+func mergeScopeValue(desired, remote, previous reflect.Value) {
+	remote = derefValue(remote)
+	if !remote.IsValid() {
+		return
+	}
+	previous = derefValue(previous)
+
+	switch remote.Kind() {
+	case reflect.Struct:
+		for i := 0; i < remote.NumField(); i++ {
+			name := remote.Type().Field(i).Name
+			if !remote.Field(i).CanInterface() || !isScopeContainer(remote.Field(i)) {
+				continue
+			}
+
+			var previousField reflect.Value
+			if previous.IsValid() {
+				previousField = previous.FieldByName(name)
+			}
+
+			target := desired.FieldByName(name)
+			if target.Kind() == reflect.Pointer {
+				if target.IsNil() {
+					target.Set(reflect.New(target.Type().Elem()))
+				}
+				target = target.Elem()
+			}
+			mergeScopeValue(target, remote.Field(i), previousField)
+		}
+	case reflect.Slice:
+		known := map[string]bool{}
+		for i := 0; i < desired.Len(); i++ {
+			known[entryKey(desired.Index(i))] = true
+		}
+		if previous.IsValid() {
+			for i := 0; i < previous.Len(); i++ {
+				known[entryKey(previous.Index(i))] = true
+			}
+		}
+
+		for i := 0; i < remote.Len(); i++ {
+			entry := remote.Index(i)
+			if key := entryKey(entry); key != "" && !known[key] {
+				desired.Set(reflect.Append(desired, entry))
+				known[key] = true
+			}
+		}
+	}
+}
+
+// isScopeContainer reports whether the value is a list of scope entries or a nested scope block,
+// rather than a flag such as all_computers.
+func isScopeContainer(v reflect.Value) bool {
+	t := v.Type()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Struct
+	case reflect.Struct:
+		return true
+	default:
+		return false
+	}
+}
+
+// scopeList walks field from the scope struct pointed to by v and returns the settable slice it
+// names. When create is false, a nil pointer on the way yields an invalid value; otherwise it is
+// allocated.
+func scopeList(v reflect.Value, field []string, create bool) (reflect.Value, error) {
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("scope must be a pointer to a struct, got %s", v.Type())
+	}
+	v = v.Elem()
+
+	for _, name := range field {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !create {
+					return reflect.Value{}, nil
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("scope field %s is not a struct", strings.Join(field, "."))
+		}
+
+		v = v.FieldByName(name)
+		if !v.IsValid() {
+			return reflect.Value{}, fmt.Errorf("scope has no field %s", strings.Join(field, "."))
+		}
+	}
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			if !create {
+				return reflect.Value{}, nil
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return reflect.Value{}, fmt.Errorf("scope field %s is not a list", strings.Join(field, "."))
+	}
+
+	return v, nil
+}
+
+func derefValue(v reflect.Value) reflect.Value {
+	if v.IsValid() && v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		return v.Elem()
+	}
+	return v
+}
+
+// entryKey identifies a scope entry by its ID, or by its name for entries such as directory
+// service users which have none.
+func entryKey(entry reflect.Value) string {
+	if id := entry.FieldByName("ID"); id.IsValid() && id.Kind() == reflect.Int && id.Int() != 0 {
+		return idKey(int(id.Int()))
+	}
+	if name := entry.FieldByName("Name"); name.IsValid() && name.Kind() == reflect.String && name.String() != "" {
+		return "name:" + name.String()
+	}
+	return ""
+}
+
+func idKey(id int) string {
+	return fmt.Sprintf("id:%d", id)
+}
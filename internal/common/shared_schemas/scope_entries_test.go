@@ -0,0 +1,90 @@
+package sharedschemas
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopeEntriesPolicy(t *testing.T) {
+	scope := &jamfpro.PolicySubsetScope{}
+	field := []string{"Exclusions", "ComputerGroups"}
+
+	present, err := HasScopeEntry(scope, field, 5)
+	require.NoError(t, err)
+	assert.False(t, present, "nil exclusions hold no entries")
+
+	added, err := AddScopeEntry(scope, field, 5)
+	require.NoError(t, err)
+	assert.True(t, added)
+	require.NotNil(t, scope.Exclusions)
+	assert.Equal(t, []jamfpro.PolicySubsetComputerGroup{{ID: 5}}, *scope.Exclusions.ComputerGroups)
+
+	added, err = AddScopeEntry(scope, field, 5)
+	require.NoError(t, err)
+	assert.False(t, added, "adding an existing entry is a no-op")
+
+	removed, err := RemoveScopeEntry(scope, field, 5)
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.Empty(t, *scope.Exclusions.ComputerGroups)
+
+	removed, err = RemoveScopeEntry(scope, field, 5)
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestScopeEntriesProfile(t *testing.T) {
+	scope := &jamfpro.MacOSConfigurationProfileSubsetScope{
+		Buildings: []jamfpro.MacOSConfigurationProfileSubsetScopeEntity{{ID: 1, Name: "HQ"}},
+	}
+
+	present, err := HasScopeEntry(scope, []string{"Buildings"}, 1)
+	require.NoError(t, err)
+	assert.True(t, present)
+
+	added, err := AddScopeEntry(scope, []string{"Limitations", "NetworkSegments"}, 9)
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.Equal(t, 9, scope.Limitations.NetworkSegments[0].ID)
+
+	_, err = AddScopeEntry(scope, []string{"Limitations", "Users"}, 1)
+	require.NoError(t, err, "user entries have an ID field")
+
+	_, err = AddScopeEntry(scope, []string{"Unknown"}, 1)
+	assert.Error(t, err)
+
+	_, err = AddScopeEntry(scope, []string{"AllComputers"}, 1)
+	assert.Error(t, err)
+}
+
+func TestMergeExternalScope(t *testing.T) {
+	groups := func(ids ...int) *[]jamfpro.PolicySubsetComputerGroup {
+		out := []jamfpro.PolicySubsetComputerGroup{}
+		for _, id := range ids {
+			out = append(out, jamfpro.PolicySubsetComputerGroup{ID: id})
+		}
+		return &out
+	}
+
+	// The configuration replaced group 1 with group 2. Jamf Pro also holds group 3 and an
+	// excluded user, both added outside of the resource.
+	previous := jamfpro.PolicySubsetScope{ComputerGroups: groups(1)}
+	desired := jamfpro.PolicySubsetScope{ComputerGroups: groups(2), AllComputers: false}
+	remote := jamfpro.PolicySubsetScope{
+		AllComputers:   true,
+		ComputerGroups: groups(1, 3),
+		Exclusions: &jamfpro.PolicySubsetScopeExclusions{
+			Users: &[]jamfpro.PolicySubsetUser{{Name: "jdoe"}},
+		},
+	}
+
+	MergeExternalScope(&desired, &remote, &previous)
+
+	assert.Equal(t, groups(2, 3), desired.ComputerGroups)
+	assert.False(t, desired.AllComputers, "flags are not merged")
+	require.NotNil(t, desired.Exclusions)
+	assert.Equal(t, &[]jamfpro.PolicySubsetUser{{Name: "jdoe"}}, desired.Exclusions.Users)
+}
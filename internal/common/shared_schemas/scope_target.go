@@ -0,0 +1,42 @@
+package sharedschemas
+
+import "fmt"
+
+// Target types accepted by the scope target resources.
+const (
+	ScopeTargetComputerGroup     = "computer_group"
+	ScopeTargetMobileDeviceGroup = "mobile_device_group"
+	ScopeTargetBuilding          = "building"
+	ScopeTargetDepartment        = "department"
+	ScopeTargetNetworkSegment    = "network_segment"
+	ScopeTargetJSSUserGroup      = "jss_user_group"
+)
+
+// scopeTargetFields maps each target type to the list holding it in an SDK scope struct.
+var scopeTargetFields = map[string]string{
+	ScopeTargetComputerGroup:     "ComputerGroups",
+	ScopeTargetMobileDeviceGroup: "MobileDeviceGroups",
+	ScopeTargetBuilding:          "Buildings",
+	ScopeTargetDepartment:        "Departments",
+	ScopeTargetNetworkSegment:    "NetworkSegments",
+	ScopeTargetJSSUserGroup:      "JSSUserGroups",
+}
+
+// ScopeTargetField returns the field path, for use with AddScopeEntry and friends, of a target or
+// exclusion of the given type. Network segments cannot be scope targets, so a network segment
+// target is a limitation.
+func ScopeTargetField(targetType string, exclusion bool) ([]string, error) {
+	field, ok := scopeTargetFields[targetType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported scope target type %q", targetType)
+	}
+
+	switch {
+	case exclusion:
+		return []string{"Exclusions", field}, nil
+	case targetType == ScopeTargetNetworkSegment:
+		return []string{"Limitations", field}, nil
+	default:
+		return []string{field}, nil
+	}
+}
@@ -0,0 +1,31 @@
+package sharedschemas
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// GetSharedSchemaValidateCriteria defines the option, shared by resources with a criteria list, to
+// check the criteria against those available in Jamf Pro at plan time.
+func GetSharedSchemaValidateCriteria() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "When true, the plan checks each criterion name against the criteria available in Jamf Pro, " +
+			"including extension attributes, and checks that each search type can be used with its criterion's " +
+			"data type. An unknown name fails the plan, suggesting similar names. Jamf Pro has no API listing its " +
+			"built-in criteria, so they are bundled with the provider; set `allow_unknown_criteria` for criteria " +
+			"newer than the provider.",
+	}
+}
+
+// GetSharedSchemaAllowUnknownCriteria defines the option relaxing validate_criteria for criterion
+// names missing from the criteria bundled with the provider.
+func GetSharedSchemaAllowUnknownCriteria() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "When true, criterion names unknown to `validate_criteria` are logged as warnings rather than " +
+			"failing the plan, e.g. for criteria added in a Jamf Pro release newer than the provider. Search types " +
+			"are still checked.",
+	}
+}
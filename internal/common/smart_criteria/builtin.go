@@ -0,0 +1,137 @@
+package smart_criteria
+
+// builtinCriteria holds the criteria Jamf Pro offers for each subject, other than extension
+// attributes and dynamic criteria, keyed by name with their data type. Jamf Pro has no endpoint
+// listing them, so they are maintained by hand from the criteria offered in the Jamf Pro interface.
+var builtinCriteria = map[Subject]map[string]DataType{
+	SubjectComputer:     computerCriteria,
+	SubjectMobileDevice: mobileDeviceCriteria,
+	SubjectUser:         userCriteria,
+}
+
+// withType returns the criteria names, each with the data type.
+func withType(dataType DataType, names ...string) map[string]DataType {
+	out := make(map[string]DataType, len(names))
+	for _, name := range names {
+		out[name] = dataType
+	}
+	return out
+}
+
+// merge combines the criteria of several data types into a single catalog.
+func merge(groups ...map[string]DataType) map[string]DataType {
+	out := map[string]DataType{}
+	for _, group := range groups {
+		for name, dataType := range group {
+			out[name] = dataType
+		}
+	}
+	return out
+}
+
+var computerCriteria = merge(
+	withType(DataTypeString,
+		// General
+		"Computer Name", "Serial Number", "UDID", "IP Address", "Last Reported IP Address",
+		"Reported IP Address", "Jamf Binary Version", "Platform", "Architecture Type", "Managed",
+		"Supervised", "MDM Capability", "MDM Capability Username", "User Approved MDM",
+		"Enrolled via Automated Device Enrollment", "Enrollment Method: PreStage enrollment",
+		"Enrollment Method: User-initiated - invitation", "Enrollment Method: User-initiated - no invitation",
+		"Site", "Remote Management", "Bootstrap Token Allowed", "Bootstrap Token Escrowed",
+		"Declarative Device Management Enabled", "Activation Lock Enabled", "Recovery Lock Enabled",
+		"Device Compliance Registration Status", "Cloud Backup Enabled",
+		// User and location
+		"Username", "Full Name", "Email Address", "Phone Number", "Position", "Building",
+		"Department", "Room",
+		// Hardware
+		"Make", "Model", "Model Identifier", "Processor Type", "Boot ROM", "MAC Address",
+		"Alt MAC Address", "Optical Drive", "NIC Speed", "Battery Capacity", "Apple Silicon",
+		"Drive Model", "Drive Serial Number", "Drive Type", "SMART Status",
+		// Operating system
+		"Operating System", "Operating System Name", "Operating System Build",
+		"Operating System Rapid Security Response", "Service Pack", "Secure Boot Level",
+		"External Boot Level", "Gatekeeper", "System Integrity Protection", "Firewall Enabled",
+		"Active Directory Status", "Master Password Set", "Available SWUs",
+		"Software Update Device ID", "Apple Push Notification Service Status",
+		// Disk encryption
+		"FileVault 2 Status", "FileVault 2 Partition Encryption State", "FileVault 2 Individual Key Validation",
+		"FileVault 2 Enabled User", "FileVault 2 Eligibility", "FileVault Status", "Disk Encryption Configuration",
+		"Recovery Key Type", "Institutional Recovery Key", "Personal Recovery Key",
+		// Software
+		"Application Title", "Application Bundle ID", "Plug-in Title", "Font Title",
+		"Packages Installed By Casper", "Packages Installed By Installer.app/SWU", "Cached Packages",
+		"Running Services", "Local User Accounts", "Licensed Software", "Profile Name", "Profile Identifier",
+		"Mac App Store Apps", "Printer Name",
+		// Purchasing
+		"Bar Code 1", "Bar Code 2", "Asset Tag", "PO Number", "Purchasing Account",
+		"Purchasing Contact", "Vendor", "AppleCare ID", "Purchased or Leased", "Purchase Price",
+	),
+	withType(DataTypeInteger,
+		"Processor Speed MHz", "Number of Processors", "Number of Cores", "Total RAM MB",
+		"Bus Speed MHz", "Cache Size KB", "Available RAM Slots", "Boot Drive Available MB",
+		"Boot Drive Percentage Full", "Drive Capacity MB", "Number of Available Updates",
+		"Life Expectancy",
+	),
+	withType(DataTypeDate,
+		"Last Check-in", "Last Inventory Update", "Last Enrollment", "Last iCloud Backup",
+		"Warranty Expiration", "Lease Expiration", "PO Date", "MDM Profile Expiration Date",
+		"Last Reboot",
+	),
+	withType(DataTypeVersion,
+		"Operating System Version", "Application Version", "Plug-in Version", "Font Version",
+		"XProtect Definitions Version",
+	),
+	withType(DataTypeGroup, "Computer Group"),
+)
+
+var mobileDeviceCriteria = merge(
+	withType(DataTypeString,
+		// General
+		"Display Name", "Device Name", "Serial Number", "UDID", "IP Address", "Managed", "Supervised",
+		"Device Ownership Type", "Enrollment Method: PreStage enrollment", "Site", "Shared iPad",
+		"Apple TV", "Tethered", "Model", "Model Identifier", "Model Number", "OS Type",
+		"iOS Build", "Operating System Build", "Activation Lock Enabled", "Lost Mode Enabled",
+		"Device Locator Service Enabled", "Do Not Disturb Enabled", "Jailbreak Detected",
+		"iTunes Store Account Active", "Cloud Backup Enabled", "Personal Hotspot Enabled",
+		"Declarative Device Management Enabled", "Device Compliance Registration Status",
+		// User and location
+		"Username", "Full Name", "Email Address", "Phone Number", "Position", "Building",
+		"Department", "Room",
+		// Network
+		"Wi-Fi MAC Address", "Bluetooth MAC Address", "Ethernet MAC Address", "Cellular Technology",
+		"Current Carrier Network", "Home Carrier Network", "Carrier Settings Version", "IMEI",
+		"ICCID", "MEID", "Modem Firmware Version", "Data Roaming Enabled", "Voice Roaming Enabled",
+		"Roaming",
+		// Security
+		"Passcode Status", "Passcode Compliance", "Passcode Compliance with Profile", "Data Protection",
+		"Block Level Encryption Capable", "File Level Encryption Capable", "Hardware Encryption",
+		// Software
+		"App Name", "App Identifier", "Profile Name", "Profile Identifier", "Provisioning Profile Name",
+		"Certificate Name", "Exchange Device ID",
+		// Purchasing
+		"Asset Tag", "PO Number", "Vendor", "AppleCare ID", "Purchased or Leased", "Purchase Price",
+		"Purchasing Account", "Purchasing Contact",
+	),
+	withType(DataTypeInteger,
+		"Capacity MB", "Available Space MB", "Percentage of Capacity Used", "Battery Level",
+		"Life Expectancy",
+	),
+	withType(DataTypeDate,
+		"Last Inventory Update", "Last Enrollment", "Last Backup", "Warranty Expiration",
+		"Lease Expiration", "PO Date", "Certificate Expiration Date",
+	),
+	withType(DataTypeVersion,
+		"iOS Version", "Operating System Version", "App Version",
+	),
+	withType(DataTypeGroup, "Mobile Device Group"),
+)
+
+var userCriteria = merge(
+	withType(DataTypeString,
+		"Username", "Full Name", "Email Address", "Phone Number", "Position", "Site",
+		"LDAP Server", "Managed Apple ID", "Roster Name", "Roster Source", "Computer Name",
+		"Mobile Device Name", "VPP Apple ID",
+	),
+	withType(DataTypeInteger, "Number of Computers", "Number of Mobile Devices"),
+	withType(DataTypeGroup, "User Group"),
+)
@@ -0,0 +1,200 @@
+// Package smart_criteria validates the criteria of smart groups and advanced searches against
+// the criteria Jamf Pro offers.
+//
+// A criterion name that Jamf Pro does not know, e.g. "Operating Sytem Version", is accepted by the
+// API and silently produces a group or search matching nothing. Jamf Pro has no endpoint listing
+// its built-in criteria, so the catalog combines the built-in criteria bundled with the provider
+// with the extension attributes of the server, fetched once per provider run.
+//
+// The bundled list cannot be complete for every Jamf Pro version, so an unknown name is only a
+// warning: the framework resources report it as a plan warning, while the SDKv2 resources, whose
+// CustomizeDiff cannot warn, log it. Search types are only checked for known criteria, and an
+// invalid one fails the plan.
+package smart_criteria
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// Subject is the inventory record type criteria apply to.
+type Subject string
+
+const (
+	SubjectComputer     Subject = "computer"
+	SubjectMobileDevice Subject = "mobile device"
+	SubjectUser         Subject = "user"
+)
+
+// DataType is the type of value a criterion compares, which determines its search types.
+type DataType string
+
+const (
+	DataTypeString  DataType = "string"
+	DataTypeInteger DataType = "integer"
+	DataTypeDate    DataType = "date"
+	DataTypeVersion DataType = "version"
+	DataTypeGroup   DataType = "group"
+	// DataTypeAny is used where the data type is not known, e.g. user extension attributes, whose
+	// list does not include it. Every search type is accepted.
+	DataTypeAny DataType = ""
+)
+
+var (
+	equalitySearchTypes   = []string{"is", "is not"}
+	likeSearchTypes       = []string{"like", "not like"}
+	hasSearchTypes        = []string{"has", "does not have"}
+	regexSearchTypes      = []string{"matches regex", "does not match regex"}
+	comparisonSearchTypes = []string{
+		"more than", "greater than", "less than", "greater than or equal", "less than or equal",
+	}
+	dateSearchTypes = []string{
+		"before (yyyy-mm-dd)", "after (yyyy-mm-dd)", "more than x days ago", "less than x days ago",
+		"in more than x days", "in less than x days",
+	}
+)
+
+// searchTypes lists the search types Jamf Pro offers for each data type.
+var searchTypes = map[DataType][]string{
+	DataTypeString:  slices.Concat(equalitySearchTypes, likeSearchTypes, hasSearchTypes, regexSearchTypes),
+	DataTypeInteger: slices.Concat(equalitySearchTypes, likeSearchTypes, comparisonSearchTypes, regexSearchTypes),
+	DataTypeDate:    slices.Concat(equalitySearchTypes, dateSearchTypes, regexSearchTypes),
+	DataTypeVersion: slices.Concat(equalitySearchTypes, likeSearchTypes, hasSearchTypes, comparisonSearchTypes, regexSearchTypes),
+	DataTypeGroup:   {"member of", "not member of"},
+}
+
+// dynamicCriteria are the prefixes of criteria Jamf Pro names after other objects, e.g.
+// "Patch Reporting: Google Chrome" for each patch management software title, with their data type.
+var dynamicCriteria = map[Subject]map[string]DataType{
+	SubjectComputer: {"Patch Reporting: ": DataTypeAny},
+}
+
+// Catalog holds the criteria available for a subject, keyed by name.
+type Catalog struct {
+	subject  Subject
+	criteria map[string]DataType
+}
+
+// lookup returns the data type of the named criterion, and whether it is in the catalog.
+func (c *Catalog) lookup(name string) (DataType, bool) {
+	if dataType, ok := c.criteria[name]; ok {
+		return dataType, true
+	}
+	for prefix, dataType := range dynamicCriteria[c.subject] {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			return dataType, true
+		}
+	}
+	return DataTypeAny, false
+}
+
+// NewCatalog returns the catalog of the built-in criteria of the subject plus the given extension
+// attributes, keyed by name with their data type.
+func NewCatalog(subject Subject, extensionAttributes map[string]DataType) *Catalog {
+	criteria := make(map[string]DataType, len(builtinCriteria[subject])+len(extensionAttributes))
+	for name, dataType := range builtinCriteria[subject] {
+		criteria[name] = dataType
+	}
+	for name, dataType := range extensionAttributes {
+		criteria[name] = dataType
+	}
+
+	return &Catalog{subject: subject, criteria: criteria}
+}
+
+type catalogKey struct {
+	client  *jamfpro.Client
+	subject Subject
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[catalogKey]*Catalog{}
+)
+
+// Load returns the catalog of the subject for the client. Extension attributes are fetched on
+// first use and reused for the rest of the provider run. A failed fetch is not cached. The registry
+// is not locked during the fetch, so concurrent first uses may each fetch, and the first stored wins.
+func Load(client *jamfpro.Client, subject Subject) (*Catalog, error) {
+	key := catalogKey{client, subject}
+
+	if catalog, ok := cachedCatalog(key); ok {
+		return catalog, nil
+	}
+
+	attributes, err := extensionAttributes(client, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if catalog, ok := registry[key]; ok {
+		return catalog, nil
+	}
+	catalog := NewCatalog(subject, attributes)
+	registry[key] = catalog
+	return catalog, nil
+}
+
+func cachedCatalog(key catalogKey) (*Catalog, bool) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	catalog, ok := registry[key]
+	return catalog, ok
+}
+
+// extensionAttributes returns the names and data types of the subject's extension attributes.
+func extensionAttributes(client *jamfpro.Client, subject Subject) (map[string]DataType, error) {
+	out := map[string]DataType{}
+
+	switch subject {
+	case SubjectComputer:
+		response, err := client.GetComputerExtensionAttributes(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list computer extension attributes: %v", err)
+		}
+		for _, attribute := range response.Results {
+			out[attribute.Name] = extensionAttributeDataType(attribute.DataType)
+		}
+	case SubjectMobileDevice:
+		response, err := client.GetMobileDeviceExtensionAttributes(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list mobile device extension attributes: %v", err)
+		}
+		for _, attribute := range response.Results {
+			out[attribute.Name] = extensionAttributeDataType(attribute.DataType)
+		}
+	case SubjectUser:
+		response, err := client.GetUserExtensionAttributes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user extension attributes: %v", err)
+		}
+		for _, attribute := range response.UserExtensionAttributes {
+			out[attribute.Name] = DataTypeAny
+		}
+	}
+
+	return out, nil
+}
+
+// extensionAttributeDataType maps the data type of an extension attribute, e.g. "INTEGER", to
+// the data type of its criterion.
+func extensionAttributeDataType(dataType string) DataType {
+	switch strings.ToUpper(dataType) {
+	case "STRING":
+		return DataTypeString
+	case "INTEGER":
+		return DataTypeInteger
+	case "DATE", "DATE_TIME":
+		return DataTypeDate
+	default:
+		return DataTypeAny
+	}
+}
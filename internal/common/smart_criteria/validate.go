@@ -0,0 +1,202 @@
+package smart_criteria
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+// maxSuggestions is the number of similar criterion names suggested for an unknown name.
+const maxSuggestions = 3
+
+// Criterion is the part of a configured criterion that is validated. Empty fields, e.g. values not
+// known until apply, are not checked.
+type Criterion struct {
+	Name       string
+	SearchType string
+}
+
+// Problem describes an invalid criterion: the index of the criterion, the attribute at fault,
+// "name" or "search_type", and what is wrong with it. Unknown names are warnings, as the built-in
+// criteria bundled with the provider may lag behind Jamf Pro.
+type Problem struct {
+	Index     int
+	Attribute string
+	Detail    string
+	Warning   bool
+}
+
+// Check returns a warning for each criterion whose name is not in the catalog, suggesting the
+// closest names, and a problem for each criterion whose search type is not offered for its data type.
+func (c *Catalog) Check(criteria []Criterion) []Problem {
+	var problems []Problem
+	for index, criterion := range criteria {
+		if criterion.Name == "" {
+			continue
+		}
+
+		dataType, ok := c.lookup(criterion.Name)
+		if !ok {
+			detail := fmt.Sprintf("%q is not a known %s criterion or extension attribute in Jamf Pro", criterion.Name, c.subject)
+			if suggestions := c.suggest(criterion.Name); len(suggestions) > 0 {
+				detail += fmt.Sprintf(", did you mean %s?", joinQuoted(suggestions, " or "))
+			}
+			problems = append(problems, Problem{Index: index, Attribute: "name", Detail: detail, Warning: true})
+			continue
+		}
+
+		allowed, typed := searchTypes[dataType]
+		if criterion.SearchType == "" || !typed || slices.Contains(allowed, criterion.SearchType) {
+			continue
+		}
+		problems = append(problems, Problem{
+			Index:     index,
+			Attribute: "search_type",
+			Detail: fmt.Sprintf("search type %q cannot be used with the %s criterion %q, use one of %s",
+				criterion.SearchType, dataType, criterion.Name, joinQuoted(allowed, ", ")),
+		})
+	}
+
+	return problems
+}
+
+// suggest returns the names in the catalog closest to name, ignoring case, that are close enough
+// to be a likely typo.
+func (c *Catalog) suggest(name string) []string {
+	wanted := strings.ToLower(name)
+	maxDistance := max(2, len(wanted)/4)
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for known := range c.criteria {
+		distance := fuzzy.LevenshteinDistance(wanted, strings.ToLower(known))
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name: known, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	suggestions := make([]string, 0, maxSuggestions)
+	for _, candidate := range candidates[:min(len(candidates), maxSuggestions)] {
+		suggestions = append(suggestions, candidate.name)
+	}
+	return suggestions
+}
+
+func joinQuoted(values []string, separator string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return strings.Join(quoted, separator)
+}
+
+// ValidateResourceDiff checks, when validate_criteria is set, the criteria list of an SDKv2
+// resource against the catalog of the subject, failing the plan for unknown names and invalid
+// search types. CustomizeDiff cannot report warnings, so when allow_unknown_criteria is set
+// unknown names are only logged.
+func ValidateResourceDiff(d *schema.ResourceDiff, meta any, subject Subject) error {
+	if !d.Get("validate_criteria").(bool) {
+		return nil
+	}
+
+	configured, _ := d.Get("criteria").([]any)
+	if len(configured) == 0 {
+		return nil
+	}
+
+	criteria := make([]Criterion, len(configured))
+	for i := range configured {
+		prefix := fmt.Sprintf("criteria.%d.", i)
+		if d.NewValueKnown(prefix + "name") {
+			criteria[i].Name = d.Get(prefix + "name").(string)
+		}
+		if d.NewValueKnown(prefix + "search_type") {
+			criteria[i].SearchType = d.Get(prefix + "search_type").(string)
+		}
+	}
+
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return fmt.Errorf("jamf client is not configured for criteria validation")
+	}
+
+	catalog, err := Load(client, subject)
+	if err != nil {
+		return fmt.Errorf("failed to load the %s criteria available in Jamf Pro: %v", subject, err)
+	}
+
+	allowUnknown := d.Get("allow_unknown_criteria").(bool)
+
+	var lines []string
+	for _, problem := range catalog.Check(criteria) {
+		line := fmt.Sprintf("criteria.%d.%s: %s", problem.Index, problem.Attribute, problem.Detail)
+		if problem.Warning && allowUnknown {
+			log.Printf("[WARN] %s", line)
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid criteria:\n  %s", strings.Join(lines, "\n  "))
+}
+
+// ValidatePlan checks, when validate is true, the criteria list of a framework resource plan
+// against the catalog of the subject, adding a warning at the name of each unknown criterion and an
+// error at the search type of each invalid one. Nothing is checked before the provider is configured.
+func ValidatePlan(client *jamfpro.Client, subject Subject, validate types.Bool, planned types.List, diags *diag.Diagnostics) {
+	if client == nil || !validate.ValueBool() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	elements := planned.Elements()
+	criteria := make([]Criterion, len(elements))
+	for i, element := range elements {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		attributes := object.Attributes()
+		if name, ok := attributes["name"].(types.String); ok {
+			criteria[i].Name = name.ValueString()
+		}
+		if searchType, ok := attributes["search_type"].(types.String); ok {
+			criteria[i].SearchType = searchType.ValueString()
+		}
+	}
+
+	catalog, err := Load(client, subject)
+	if err != nil {
+		diags.AddError("Error Loading Criteria", fmt.Sprintf("Failed to load the %s criteria available in Jamf Pro: %v", subject, err))
+		return
+	}
+
+	for _, problem := range catalog.Check(criteria) {
+		attribute := path.Root("criteria").AtListIndex(problem.Index).AtName(problem.Attribute)
+		if problem.Warning {
+			diags.AddAttributeWarning(attribute, "Unknown Criterion", problem.Detail)
+			continue
+		}
+		diags.AddAttributeError(attribute, "Invalid Criterion", problem.Detail)
+	}
+}
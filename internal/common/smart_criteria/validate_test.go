@@ -0,0 +1,155 @@
+package smart_criteria
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	catalog := NewCatalog(SubjectComputer, map[string]DataType{
+		"Battery Cycle Count": DataTypeInteger,
+		"Owner Note":          DataTypeAny,
+	})
+
+	problems := catalog.Check([]Criterion{
+		{Name: "Operating System Version", SearchType: "greater than or equal"},
+		{Name: "Operating Sytem Version", SearchType: "like"},
+		{Name: "Battery Cycle Count", SearchType: "has"},
+		{Name: "Computer Group", SearchType: "member of"},
+		{Name: "Last Check-in", SearchType: "more than x days ago"},
+		{Name: "Owner Note", SearchType: "member of"},
+		{Name: "", SearchType: "is"},
+		{Name: "Something Entirely Different", SearchType: "is"},
+	})
+
+	require.Len(t, problems, 3)
+
+	assert.Equal(t, 1, problems[0].Index)
+	assert.Equal(t, "name", problems[0].Attribute)
+	assert.Contains(t, problems[0].Detail, `did you mean "Operating System Version"`)
+	assert.True(t, problems[0].Warning, "an unknown name may be missing from the bundled criteria")
+
+	assert.Equal(t, 2, problems[1].Index)
+	assert.Equal(t, "search_type", problems[1].Attribute)
+	assert.Contains(t, problems[1].Detail, `integer criterion "Battery Cycle Count"`)
+	assert.False(t, problems[1].Warning)
+
+	assert.Equal(t, 7, problems[2].Index)
+	assert.NotContains(t, problems[2].Detail, "did you mean", "no name is close enough to suggest")
+}
+
+func TestCheckDynamicCriteria(t *testing.T) {
+	computer := NewCatalog(SubjectComputer, nil)
+	assert.Empty(t, computer.Check([]Criterion{{Name: "Patch Reporting: Google Chrome", SearchType: "less than"}}))
+	assert.Len(t, computer.Check([]Criterion{{Name: "Patch Reporting: "}}), 1, "a title is required")
+
+	mobile := NewCatalog(SubjectMobileDevice, nil)
+	assert.Len(t, mobile.Check([]Criterion{{Name: "Patch Reporting: Google Chrome"}}), 1)
+}
+
+func TestCheckSubjects(t *testing.T) {
+	mobile := NewCatalog(SubjectMobileDevice, nil)
+	assert.Empty(t, mobile.Check([]Criterion{{Name: "Mobile Device Group", SearchType: "not member of"}}))
+	assert.Len(t, mobile.Check([]Criterion{{Name: "Computer Group", SearchType: "member of"}}), 1)
+
+	assert.Len(t, NewCatalog(SubjectComputer, nil).Check([]Criterion{{Name: "Barcode"}}), 1, "computers have Bar Code 1 and Bar Code 2")
+
+	user := NewCatalog(SubjectUser, nil)
+	assert.Empty(t, user.Check([]Criterion{{Name: "Email Address", SearchType: "like"}}))
+}
+
+func TestSuggestIgnoresCase(t *testing.T) {
+	catalog := NewCatalog(SubjectComputer, nil)
+	assert.Equal(t, "Serial Number", catalog.suggest("serial number")[0])
+}
+
+func TestExtensionAttributeDataType(t *testing.T) {
+	assert.Equal(t, DataTypeInteger, extensionAttributeDataType("INTEGER"))
+	assert.Equal(t, DataTypeDate, extensionAttributeDataType("DATE"))
+	assert.Equal(t, DataTypeString, extensionAttributeDataType("String"))
+	assert.Equal(t, DataTypeAny, extensionAttributeDataType(""))
+}
+
+// builtinOnlyClient returns a client whose computer catalog holds only the built-in criteria.
+func builtinOnlyClient(t *testing.T) *jamfpro.Client {
+	client := &jamfpro.Client{}
+	registryMu.Lock()
+	registry[catalogKey{client, SubjectComputer}] = NewCatalog(SubjectComputer, nil)
+	registryMu.Unlock()
+	t.Cleanup(func() {
+		registryMu.Lock()
+		delete(registry, catalogKey{client, SubjectComputer})
+		registryMu.Unlock()
+	})
+	return client
+}
+
+func TestValidateResourceDiff(t *testing.T) {
+	client := builtinOnlyClient(t)
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"validate_criteria":      {Type: schema.TypeBool, Optional: true},
+			"allow_unknown_criteria": {Type: schema.TypeBool, Optional: true},
+			"criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"name":        {Type: schema.TypeString, Optional: true},
+					"search_type": {Type: schema.TypeString, Optional: true},
+				}},
+			},
+		},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+			return ValidateResourceDiff(d, meta, SubjectComputer)
+		},
+	}
+	plan := func(allowUnknown bool, name, searchType string) error {
+		config := terraform.NewResourceConfigRaw(map[string]any{
+			"validate_criteria":      true,
+			"allow_unknown_criteria": allowUnknown,
+			"criteria":               []any{map[string]any{"name": name, "search_type": searchType}},
+		})
+		_, err := resource.Diff(context.Background(), nil, config, client)
+		return err
+	}
+
+	err := plan(false, "Operating Sytem Version", "is")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `did you mean "Operating System Version"`)
+
+	assert.NoError(t, plan(true, "Operating Sytem Version", "is"))
+	assert.Error(t, plan(true, "Computer Group", "like"), "search types are checked when unknown names are allowed")
+}
+
+func TestValidatePlanWarnsOnUnknownNames(t *testing.T) {
+	client := builtinOnlyClient(t)
+
+	criterion := func(name, searchType string) attr.Value {
+		return types.ObjectValueMust(
+			map[string]attr.Type{"name": types.StringType, "search_type": types.StringType},
+			map[string]attr.Value{"name": types.StringValue(name), "search_type": types.StringValue(searchType)},
+		)
+	}
+	planned := types.ListValueMust(
+		types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "search_type": types.StringType}},
+		[]attr.Value{criterion("Operating Sytem Version", "is"), criterion("Computer Group", "like")},
+	)
+
+	var diags diag.Diagnostics
+	ValidatePlan(client, SubjectComputer, types.BoolValue(true), planned, &diags)
+
+	require.Len(t, diags, 2)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Equal(t, "Unknown Criterion", diags[0].Summary())
+	assert.Equal(t, diag.SeverityError, diags[1].Severity())
+	assert.Equal(t, "Invalid Criterion", diags[1].Summary())
+}
@@ -0,0 +1,303 @@
+// Package static_group_membership implements the resources which manage a subset of the members of
+// a Jamf Pro static computer or mobile device group.
+//
+// Jamf Pro only accepts the whole member list of a group, so each change reads the group, merges
+// the members the resource owns into its current members and writes the list back. Changes to the
+// same group are serialised, as resources sharing a group are applied in parallel.
+package static_group_membership
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/mutexkv"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NumericID matches a Jamf Pro object ID.
+var NumericID = regexp.MustCompile(`^[0-9]+$`)
+
+// groupLocks serialises membership changes to the same group, as each change rewrites the whole
+// member list.
+var groupLocks = mutexkv.New()
+
+// Group is a static group and the IDs of its members.
+type Group struct {
+	ID      int
+	Name    string
+	IsSmart bool
+	Site    *jamfpro.SharedResourceSite
+	Members []int
+}
+
+// Kind is a type of device whose static group membership is managed, e.g. computers.
+type Kind struct {
+	// Device is the name of the devices in messages, e.g. "mobile device".
+	Device string
+	// IDsKey is the attribute listing members by Jamf Pro ID, e.g. "mobile_device_ids".
+	IDsKey string
+	// Get returns the group with the ID.
+	Get func(client *jamfpro.Client, id string) (*Group, error)
+	// Write replaces the members of the group with the device IDs.
+	Write func(client *jamfpro.Client, group *Group, ids []int) error
+	// Find returns the IDs of the devices with the serial numbers and UDIDs, keyed by serial number
+	// or UDID. It fails when a device is not found.
+	Find func(client *jamfpro.Client, serialNumbers, udids []string) (map[string]int, error)
+}
+
+// Create is responsible for adding the configured devices to a Jamf Pro static group.
+func (k *Kind) Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if diags := k.apply(d, meta); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(d.Get("group_id").(string))
+
+	return k.Read(ctx, d, meta)
+}
+
+// Read is responsible for reading which of the devices owned by this resource are still members
+// of the group. Devices removed from the group outside of Terraform are dropped from state so that
+// they are added again on the next apply.
+func (k *Kind) Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	get := read_cache.Memoized(meta, k.Device+"_group_members", func(id string) (*Group, error) { return k.Get(client, id) })
+	group, err := get(d.Id())
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, true)
+	}
+
+	members := map[string]any{}
+	for identifier, id := range d.Get("member_ids").(map[string]any) {
+		if slices.Contains(group.Members, id.(int)) {
+			members[identifier] = id
+		}
+	}
+
+	var deviceIDs, serialNumbers, udids []any
+	for _, v := range d.Get(k.IDsKey).(*schema.Set).List() {
+		if _, ok := members[strconv.Itoa(v.(int))]; ok {
+			deviceIDs = append(deviceIDs, v)
+		}
+	}
+	for _, v := range d.Get("serial_numbers").(*schema.Set).List() {
+		if _, ok := members[v.(string)]; ok {
+			serialNumbers = append(serialNumbers, v)
+		}
+	}
+	for _, v := range d.Get("udids").(*schema.Set).List() {
+		if _, ok := members[v.(string)]; ok {
+			udids = append(udids, v)
+		}
+	}
+
+	var diags diag.Diagnostics
+	for attribute, value := range map[string]any{
+		"group_id":       strconv.Itoa(group.ID),
+		k.IDsKey:         deviceIDs,
+		"serial_numbers": serialNumbers,
+		"udids":          udids,
+		"member_ids":     members,
+	} {
+		if err := d.Set(attribute, value); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// Update is responsible for adding and removing devices from the group as the configured members
+// change.
+func (k *Kind) Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if diags := k.apply(d, meta); diags.HasError() {
+		return diags
+	}
+
+	return k.Read(ctx, d, meta)
+}
+
+// Delete is responsible for removing the devices owned by this resource from the group. Other
+// members are left in place.
+func (k *Kind) Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	groupID := d.Id()
+
+	groupLocks.Lock(k.lockKey(groupID))
+	defer groupLocks.Unlock(k.lockKey(groupID))
+
+	group, err := k.Get(client, groupID)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro static %s group '%s': %v", k.Device, groupID, err))
+	}
+
+	owned := resolvedIDs(d.Get("member_ids").(map[string]any))
+	err = k.Write(client, group, mergeMembers(group.Members, owned, nil))
+	read_cache.Invalidate(meta, groupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// apply resolves the configured members and writes them to the group, removing devices this
+// resource previously added which are no longer configured. The resolved members are recorded in
+// member_ids.
+func (k *Kind) apply(d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	groupID := d.Get("group_id").(string)
+
+	resolved, err := k.resolveMembers(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupLocks.Lock(k.lockKey(groupID))
+	defer groupLocks.Unlock(k.lockKey(groupID))
+
+	group, err := k.Get(client, groupID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro static %s group '%s': %v", k.Device, groupID, err))
+	}
+	if group.IsSmart {
+		return diag.Errorf("Jamf Pro %s group '%s' (ID: %s) is a smart group, membership can only be managed for static groups", k.Device, group.Name, groupID)
+	}
+
+	previous, _ := d.GetChange("member_ids")
+	members := map[string]any{}
+	for identifier, id := range resolved {
+		members[identifier] = id
+	}
+
+	merged := mergeMembers(group.Members, resolvedIDs(previous.(map[string]any)), resolvedIDs(members))
+	err = k.Write(client, group, merged)
+	read_cache.Invalidate(meta, groupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("member_ids", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resolveMembers returns the Jamf Pro ID of every configured member, keyed by the identifier it
+// was configured with.
+func (k *Kind) resolveMembers(client *jamfpro.Client, d *schema.ResourceData) (map[string]int, error) {
+	resolved := map[string]int{}
+	for _, v := range d.Get(k.IDsKey).(*schema.Set).List() {
+		resolved[strconv.Itoa(v.(int))] = v.(int)
+	}
+
+	var serialNumbers, udids []string
+	for _, v := range d.Get("serial_numbers").(*schema.Set).List() {
+		serialNumbers = append(serialNumbers, v.(string))
+	}
+	for _, v := range d.Get("udids").(*schema.Set).List() {
+		udids = append(udids, v.(string))
+	}
+	if len(serialNumbers)+len(udids) == 0 {
+		return resolved, nil
+	}
+
+	found, err := k.Find(client, serialNumbers, udids)
+	if err != nil {
+		return nil, err
+	}
+	for identifier, id := range found {
+		resolved[identifier] = id
+	}
+
+	return resolved, nil
+}
+
+// ImportState imports the membership of a group using an ID of the form
+// <group_id>:<device_id>[,<device_id>...]. Only the listed devices are owned by the imported
+// resource.
+func (k *Kind) ImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	idName := strings.ReplaceAll(k.Device, " ", "_") + "_id"
+
+	groupID, devices, ok := strings.Cut(d.Id(), ":")
+	if !ok || !NumericID.MatchString(groupID) || devices == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <group_id>:<%s>[,<%s>...]", d.Id(), idName, idName)
+	}
+
+	var deviceIDs []any
+	members := map[string]any{}
+	for _, v := range strings.Split(devices, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("unexpected %s ID %q in import ID %q", k.Device, v, d.Id())
+		}
+		deviceIDs = append(deviceIDs, id)
+		members[strconv.Itoa(id)] = id
+	}
+
+	d.SetId(groupID)
+	if err := d.Set("group_id", groupID); err != nil {
+		return nil, err
+	}
+	if err := d.Set(k.IDsKey, deviceIDs); err != nil {
+		return nil, err
+	}
+	if err := d.Set("member_ids", members); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func (k *Kind) lockKey(groupID string) string {
+	return k.Device + "/" + groupID
+}
+
+// resolvedIDs returns the distinct device IDs of a member_ids map, sorted.
+func resolvedIDs(members map[string]any) []int {
+	var ids []int
+	for _, v := range members {
+		if id := v.(int); !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// mergeMembers returns the current group members without remove, followed by the IDs in add which
+// are not already members. The order of existing members is kept so that the update only changes
+// what this resource owns.
+func mergeMembers(current, remove, add []int) []int {
+	merged := []int{}
+	for _, id := range current {
+		if slices.Contains(remove, id) && !slices.Contains(add, id) {
+			continue
+		}
+		if !slices.Contains(merged, id) {
+			merged = append(merged, id)
+		}
+	}
+	for _, id := range add {
+		if !slices.Contains(merged, id) {
+			merged = append(merged, id)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,192 @@
+package static_group_membership
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []int
+		remove  []int
+		add     []int
+		want    []int
+	}{
+		{name: "Add to empty group", current: nil, add: []int{3, 1}, want: []int{3, 1}},
+		{name: "Keep other members", current: []int{7, 2}, add: []int{1}, want: []int{7, 2, 1}},
+		{name: "Existing member is not duplicated", current: []int{1, 2}, add: []int{2}, want: []int{1, 2}},
+		{name: "Remove previously owned member", current: []int{7, 1, 2}, remove: []int{1, 2}, add: []int{2}, want: []int{7, 2}},
+		{name: "Remove all owned members", current: []int{1, 2}, remove: []int{1, 2}, want: []int{}},
+		{name: "Member removed outside of Terraform", current: []int{7}, remove: []int{1}, add: []int{1}, want: []int{7, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mergeMembers(tt.current, tt.remove, tt.add))
+		})
+	}
+}
+
+func TestResolvedIDs(t *testing.T) {
+	members := map[string]any{"C02ABC": 4, "4": 4, "1": 1}
+	assert.Equal(t, []int{1, 4}, resolvedIDs(members))
+}
+
+// fakeGroups is a Kind whose groups are held in memory.
+type fakeGroups struct {
+	groups  map[string]*Group
+	devices map[string]int
+	writes  int
+}
+
+func (f *fakeGroups) kind() *Kind {
+	return &Kind{
+		Device: "widget",
+		IDsKey: "widget_ids",
+		Get: func(_ *jamfpro.Client, id string) (*Group, error) {
+			group, ok := f.groups[id]
+			if !ok {
+				return nil, fmt.Errorf("StatusCode=404 group %s not found", id)
+			}
+			copied := *group
+			return &copied, nil
+		},
+		Write: func(_ *jamfpro.Client, group *Group, ids []int) error {
+			f.writes++
+			f.groups[fmt.Sprint(group.ID)].Members = ids
+			return nil
+		},
+		Find: func(_ *jamfpro.Client, serialNumbers, udids []string) (map[string]int, error) {
+			found := map[string]int{}
+			for _, identifier := range append(serialNumbers, udids...) {
+				id, ok := f.devices[identifier]
+				if !ok {
+					return nil, fmt.Errorf("failed to find widget '%s'", identifier)
+				}
+				found[identifier] = id
+			}
+			return found, nil
+		},
+	}
+}
+
+func testSchema() map[string]*schema.Schema {
+	set := func(elem schema.ValueType) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: elem}}
+	}
+	return map[string]*schema.Schema{
+		"group_id":       {Type: schema.TypeString, Required: true},
+		"widget_ids":     set(schema.TypeInt),
+		"serial_numbers": set(schema.TypeString),
+		"udids":          set(schema.TypeString),
+		"member_ids":     {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+	}
+}
+
+func TestCreateKeepsOtherMembers(t *testing.T) {
+	fake := &fakeGroups{
+		groups:  map[string]*Group{"5": {ID: 5, Name: "Lab", Members: []int{7}}},
+		devices: map[string]int{"SERIAL1": 2},
+	}
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]any{
+		"group_id":       "5",
+		"widget_ids":     []any{1},
+		"serial_numbers": []any{"SERIAL1"},
+	})
+
+	diags := fake.kind().Create(context.Background(), d, (*jamfpro.Client)(nil))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, "5", d.Id())
+	assert.ElementsMatch(t, []int{7, 1, 2}, fake.groups["5"].Members)
+	assert.Equal(t, map[string]any{"1": 1, "SERIAL1": 2}, d.Get("member_ids"))
+}
+
+func TestCreateRejectsSmartGroup(t *testing.T) {
+	fake := &fakeGroups{groups: map[string]*Group{"5": {ID: 5, Name: "All", IsSmart: true}}}
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]any{"group_id": "5", "widget_ids": []any{1}})
+
+	diags := fake.kind().Create(context.Background(), d, (*jamfpro.Client)(nil))
+
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "Jamf Pro widget group 'All' (ID: 5) is a smart group")
+	assert.Zero(t, fake.writes)
+}
+
+func TestCreateFailsOnUnknownDevice(t *testing.T) {
+	fake := &fakeGroups{groups: map[string]*Group{"5": {ID: 5, Name: "Lab"}}}
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]any{"group_id": "5", "udids": []any{"missing"}})
+
+	diags := fake.kind().Create(context.Background(), d, (*jamfpro.Client)(nil))
+
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "failed to find widget 'missing'")
+	assert.Zero(t, fake.writes)
+}
+
+func TestReadDropsMembersRemovedOutsideTerraform(t *testing.T) {
+	fake := &fakeGroups{groups: map[string]*Group{"5": {ID: 5, Name: "Lab", Members: []int{7, 2}}}}
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]any{
+		"group_id":       "5",
+		"widget_ids":     []any{1},
+		"serial_numbers": []any{"SERIAL1"},
+	})
+	d.SetId("5")
+	require.NoError(t, d.Set("member_ids", map[string]any{"1": 1, "SERIAL1": 2}))
+
+	diags := fake.kind().Read(context.Background(), d, (*jamfpro.Client)(nil))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Zero(t, d.Get("widget_ids").(*schema.Set).Len(), "widget 1 was removed from the group")
+	assert.Equal(t, []any{"SERIAL1"}, d.Get("serial_numbers").(*schema.Set).List())
+	assert.Equal(t, map[string]any{"SERIAL1": 2}, d.Get("member_ids"))
+}
+
+func TestDeleteRemovesOnlyOwnedMembers(t *testing.T) {
+	fake := &fakeGroups{groups: map[string]*Group{"5": {ID: 5, Name: "Lab", Members: []int{7, 1, 2}}}}
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]any{"group_id": "5", "widget_ids": []any{1, 2}})
+	d.SetId("5")
+	require.NoError(t, d.Set("member_ids", map[string]any{"1": 1, "2": 2}))
+
+	diags := fake.kind().Delete(context.Background(), d, (*jamfpro.Client)(nil))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Empty(t, d.Id())
+	assert.Equal(t, []int{7}, fake.groups["5"].Members)
+}
+
+func TestDeleteOfMissingGroup(t *testing.T) {
+	fake := &fakeGroups{groups: map[string]*Group{}}
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]any{"group_id": "5"})
+	d.SetId("5")
+
+	diags := fake.kind().Delete(context.Background(), d, (*jamfpro.Client)(nil))
+
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, d.Id())
+}
+
+func TestImportState(t *testing.T) {
+	kind := (&fakeGroups{}).kind()
+
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]any{})
+	d.SetId("5:3, 4")
+	imported, err := kind.ImportState(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	assert.Equal(t, "5", d.Get("group_id"))
+	assert.ElementsMatch(t, []any{3, 4}, d.Get("widget_ids").(*schema.Set).List())
+	assert.Equal(t, map[string]any{"3": 3, "4": 4}, d.Get("member_ids"))
+
+	d = schema.TestResourceDataRaw(t, testSchema(), map[string]any{})
+	d.SetId("5")
+	_, err = kind.ImportState(context.Background(), d, nil)
+	assert.ErrorContains(t, err, "expected <group_id>:<widget_id>[,<widget_id>...]")
+}
@@ -0,0 +1,224 @@
+// Package throttle provides a process-wide request limiter for the Jamf Pro API.
+//
+// The SDKv2 and Framework providers are served from the same process through
+// the mux server, and each builds its own *jamfpro.Client during Configure.
+// Limiters are therefore registered per Jamf Pro instance so that both clients
+// draw from a single concurrency and rate budget, rather than each being
+// allowed the full budget against the same tenant.
+//
+// Throttled responses (429, or 503 with Retry-After) pause all admissions for
+// the instance and halve the effective request rate, which then recovers
+// gradually as requests succeed.
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Config defines the throttling behaviour applied to requests against a Jamf Pro instance.
+type Config struct {
+	// MaxConcurrentRequests limits the number of in flight requests. Zero disables the limit.
+	MaxConcurrentRequests int
+	// RequestsPerSecond is the sustained rate of the token bucket. Zero disables the limit.
+	RequestsPerSecond float64
+	// HonorRetryAfter replays throttled requests after the delay requested by the server.
+	HonorRetryAfter bool
+	// MaxThrottleRetries is the number of times a throttled request is replayed before the response is returned.
+	MaxThrottleRetries int
+	// MaxRetryAfter caps the delay taken from a Retry-After header or the calculated backoff.
+	MaxRetryAfter time.Duration
+	// MaxQueueWait bounds the time a request may wait for admission before failing.
+	MaxQueueWait time.Duration
+}
+
+const (
+	// DefaultMaxConcurrentRequests is the provider's default limit on in flight requests.
+	DefaultMaxConcurrentRequests = 5
+	// DefaultRequestsPerSecond is the provider's default sustained request rate.
+	DefaultRequestsPerSecond = 10.0
+	// DefaultMaxThrottleRetries is used when HonorRetryAfter is set without a retry limit.
+	DefaultMaxThrottleRetries = 5
+	// DefaultMaxRetryAfter caps server requested delays when no cap is configured.
+	DefaultMaxRetryAfter = 60 * time.Second
+	// DefaultMaxQueueWait bounds admission waits when no limit is configured.
+	DefaultMaxQueueWait = 5 * time.Minute
+
+	// minimumRateFraction is the lowest fraction of RequestsPerSecond the adaptive rate can fall to.
+	minimumRateFraction = 0.125
+	// rateRecoveryFraction is the fraction of RequestsPerSecond restored after each successful request.
+	rateRecoveryFraction = 0.05
+)
+
+// Limiter gates admission of requests against a single Jamf Pro instance.
+type Limiter struct {
+	config Config
+	slots  chan struct{}
+
+	mu         sync.Mutex
+	rate       float64
+	tokens     float64
+	lastRefill time.Time
+	pauseUntil time.Time
+}
+
+// NewLimiter creates a Limiter for the supplied configuration, applying defaults to unset values.
+func NewLimiter(config Config) *Limiter {
+	if config.MaxThrottleRetries <= 0 {
+		config.MaxThrottleRetries = DefaultMaxThrottleRetries
+	}
+	if config.MaxRetryAfter <= 0 {
+		config.MaxRetryAfter = DefaultMaxRetryAfter
+	}
+	if config.MaxQueueWait <= 0 {
+		config.MaxQueueWait = DefaultMaxQueueWait
+	}
+
+	limiter := &Limiter{
+		config:     config,
+		rate:       config.RequestsPerSecond,
+		tokens:     math.Max(config.RequestsPerSecond, 1),
+		lastRefill: time.Now(),
+	}
+
+	if config.MaxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+
+	return limiter
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[string]*Limiter{}
+)
+
+// SharedLimiter returns the Limiter registered for an instance key, creating it when absent or
+// when the configuration has changed since it was registered.
+func SharedLimiter(key string, config Config) *Limiter {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	candidate := NewLimiter(config)
+	if existing, ok := registry[key]; ok && existing.config == candidate.config {
+		return existing
+	}
+
+	registry[key] = candidate
+	return candidate
+}
+
+// Acquire blocks until the request may be sent, returning a function which releases its
+// concurrency slot. The wait is bounded by MaxQueueWait.
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	ctx, cancel := context.WithTimeout(ctx, l.config.MaxQueueWait)
+	defer cancel()
+
+	if err := l.waitForPause(ctx); err != nil {
+		return nil, err
+	}
+
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = sync.OnceFunc(func() { <-l.slots })
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for a request slot (max_concurrent_requests %d): %w", l.config.MaxConcurrentRequests, ctx.Err())
+		}
+	}
+
+	if err := l.waitForToken(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// waitForPause blocks while a throttling pause is in effect.
+func (l *Limiter) waitForPause(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		wait := time.Until(l.pauseUntil)
+		l.mu.Unlock()
+
+		if wait <= 0 {
+			return nil
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return fmt.Errorf("timed out waiting for Jamf Pro throttling to clear: %w", err)
+		}
+	}
+}
+
+// waitForToken takes a token from the bucket, waiting for a refill if it is empty.
+func (l *Limiter) waitForToken(ctx context.Context) error {
+	if l.config.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = math.Min(l.tokens+now.Sub(l.lastRefill).Seconds()*l.rate, math.Max(l.config.RequestsPerSecond, 1))
+		l.lastRefill = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := sleep(ctx, wait); err != nil {
+			return fmt.Errorf("timed out waiting for request rate limit (requests_per_second %g): %w", l.config.RequestsPerSecond, err)
+		}
+	}
+}
+
+// Throttled records a throttled response, pausing all admissions for delay and halving the
+// effective request rate.
+func (l *Limiter) Throttled(delay time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(delay); until.After(l.pauseUntil) {
+		l.pauseUntil = until
+	}
+
+	if l.config.RequestsPerSecond > 0 {
+		l.rate = math.Max(l.rate/2, l.config.RequestsPerSecond*minimumRateFraction)
+	}
+}
+
+// Succeeded records a successful response, gradually restoring the effective request rate.
+func (l *Limiter) Succeeded() {
+	if l.config.RequestsPerSecond <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = math.Min(l.rate+l.config.RequestsPerSecond*rateRecoveryFraction, l.config.RequestsPerSecond)
+}
+
+// sleep waits for d or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
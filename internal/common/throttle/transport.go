@@ -0,0 +1,163 @@
+package throttle
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Transport is an http.RoundTripper which admits requests through a Limiter and replays
+// requests rejected by Jamf Pro's throttling.
+type Transport struct {
+	Limiter *Limiter
+	Base    http.RoundTripper
+
+	// AttemptTimeout bounds each attempt once it has been admitted, for requests which carry no
+	// deadline of their own. Zero leaves attempts unbounded.
+	AttemptTimeout time.Duration
+}
+
+// NewTransport wraps base with the supplied limiter. A nil base uses http.DefaultTransport.
+func NewTransport(limiter *Limiter, base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Limiter: limiter, Base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+//
+// Requests wait for admission on their own context, so cancelling a request abandons its place in
+// the queue. A deadline on the request bounds the whole round trip, queueing included; requests
+// without one are bounded by AttemptTimeout per attempt, which does not count time spent queued.
+// The http.Client using the transport should therefore leave Timeout unset, as it would otherwise
+// expire for requests queued behind a throttled tenant.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var attemptTimeout time.Duration
+	if _, ok := req.Context().Deadline(); !ok {
+		attemptTimeout = t.AttemptTimeout
+	}
+
+	for attempt := 0; ; attempt++ {
+		release, err := t.Limiter.Acquire(req.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		attemptReq, cancel, err := prepareAttempt(req, attemptTimeout, attempt)
+		if err != nil {
+			release()
+			return nil, err
+		}
+
+		resp, err := t.Base.RoundTrip(attemptReq)
+		release()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		if !isThrottled(resp) {
+			t.Limiter.Succeeded()
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		delay, replay := t.throttleDelay(req, resp, attempt)
+		t.Limiter.Throttled(delay)
+
+		if !replay {
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		cancel()
+	}
+}
+
+// prepareAttempt clones the request for a single attempt, rewinding the body on replays and
+// applying the per-attempt timeout.
+func prepareAttempt(req *http.Request, timeout time.Duration, attempt int) (*http.Request, context.CancelFunc, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), timeout)
+	}
+
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+		attemptReq.Body = body
+	}
+
+	return attemptReq, cancel, nil
+}
+
+// throttleDelay returns how long to back off after a throttled response and whether the request
+// should be replayed. 503 responses are only replayed when the server supplied Retry-After, as
+// without it there is no indication the request was not processed.
+func (t *Transport) throttleDelay(req *http.Request, resp *http.Response, attempt int) (time.Duration, bool) {
+	config := t.Limiter.config
+
+	delay, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+	if !hasRetryAfter {
+		delay = backoff(attempt)
+	}
+	delay = min(delay, config.MaxRetryAfter)
+
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	replay := config.HonorRetryAfter &&
+		attempt < config.MaxThrottleRetries &&
+		replayable &&
+		(resp.StatusCode == http.StatusTooManyRequests || hasRetryAfter)
+
+	return delay, replay
+}
+
+// isThrottled reports whether the response indicates Jamf Pro is shedding load.
+func isThrottled(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// parseRetryAfter parses a Retry-After header given either as delay seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// backoff returns an exponential delay with jitter for throttled responses without Retry-After.
+func backoff(attempt int) time.Duration {
+	base := time.Second << min(attempt, 5)
+	return base + rand.N(base/2+1)
+}
+
+// cancelOnClose releases the attempt context once the response body has been consumed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
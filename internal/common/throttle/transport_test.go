@@ -0,0 +1,172 @@
+package throttle
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransportHonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "payload", string(body))
+
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: NewTransport(NewLimiter(Config{HonorRetryAfter: true}), nil)}
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestTransportReturnsThrottledResponseWhenRetriesDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: NewTransport(NewLimiter(Config{}), nil)}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
+
+func TestTransportLimitsConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		for {
+			observed := peak.Load()
+			if current <= observed || peak.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: NewTransport(NewLimiter(Config{MaxConcurrentRequests: 2}), nil)}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			resp, err := client.Get(server.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		})
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestTransportQueuedRequestHonorsClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := NewLimiter(Config{MaxConcurrentRequests: 1, MaxQueueWait: time.Minute})
+	release, err := limiter.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	client := http.Client{
+		Timeout:   50 * time.Millisecond,
+		Transport: NewTransport(limiter, nil),
+	}
+
+	start := time.Now()
+	_, err = client.Get(server.URL)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second, "queued request outlived the client timeout")
+}
+
+func TestTransportAttemptTimeoutExcludesQueueWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := NewLimiter(Config{MaxConcurrentRequests: 1, MaxQueueWait: time.Minute})
+	transport := NewTransport(limiter, nil)
+	transport.AttemptTimeout = 100 * time.Millisecond
+	client := http.Client{Transport: transport}
+
+	release, err := limiter.Acquire(context.Background())
+	require.NoError(t, err)
+	time.AfterFunc(250*time.Millisecond, release)
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err, "time spent queued counted against the attempt timeout")
+	resp.Body.Close()
+
+	_, err = client.Get(server.URL + "/slow")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLimiterRateAdaptsToThrottling(t *testing.T) {
+	limiter := NewLimiter(Config{RequestsPerSecond: 10})
+
+	limiter.Throttled(0)
+	assert.InDelta(t, 5, limiter.rate, 0.001)
+
+	for range 100 {
+		limiter.Succeeded()
+	}
+	assert.InDelta(t, 10, limiter.rate, 0.001)
+
+	release, err := limiter.Acquire(context.Background())
+	require.NoError(t, err)
+	release()
+}
+
+func TestSharedLimiter(t *testing.T) {
+	config := Config{MaxConcurrentRequests: 4}
+
+	first := SharedLimiter("https://example.jamfcloud.com", config)
+	assert.Same(t, first, SharedLimiter("https://example.jamfcloud.com", config))
+	assert.NotSame(t, first, SharedLimiter("https://example.jamfcloud.com", Config{MaxConcurrentRequests: 2}))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	delay, ok := parseRetryAfter("3")
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	delay, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Zero(t, delay)
+}
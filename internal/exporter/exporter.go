@@ -0,0 +1,196 @@
+// Package exporter writes the objects of a Jamf Pro instance as Terraform configuration for this
+// provider, to promote them from one instance to another.
+//
+// Each object is read by its resource's own read function, so that the configuration matches what
+// the provider keeps in state: plist payloads are written in the form their diff suppression
+// compares, and values the resource defaults are left out. IDs of exported objects referenced by
+// other objects, e.g. a policy's category_id, are written as references to the exported resource,
+// e.g. jamfpro_category.applications.id, and an import block is written for every object so that
+// the configuration can first be adopted by the instance it was exported from.
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Result is the configuration exported from a Jamf Pro instance.
+type Result struct {
+	// Files maps the paths of the files written, relative to the output directory, to their
+	// contents: one .tf file per resource type, imports.tf and versions.tf, and the script
+	// contents and payloads under files/.
+	Files map[string][]byte
+	// Warnings lists the objects which were skipped or read with warnings.
+	Warnings []string
+}
+
+// address is the address of an exported resource, e.g. jamfpro_category.applications.
+type address struct {
+	Type  string
+	Label string
+}
+
+func (a address) String() string {
+	return a.Type + "." + a.Label
+}
+
+// exportedObject is an object of a kind to export, with its resource label.
+type exportedObject struct {
+	object
+	Label string
+}
+
+// Export reads every object of the kinds through the configured provider p and returns their
+// configuration. Only references to objects of the kinds exported are written as references,
+// others are kept as IDs.
+func Export(ctx context.Context, p *schema.Provider, kinds []*Kind) (*Result, error) {
+	meta := p.Meta()
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil, fmt.Errorf("the provider is not configured")
+	}
+
+	result := &Result{Files: map[string][]byte{}}
+	addresses := map[string]map[string]address{}
+	listed := map[*Kind][]exportedObject{}
+
+	for _, kind := range kinds {
+		objects, err := kind.list(client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s objects: %v", kind.ResourceType, err)
+		}
+
+		listed[kind] = labelObjects(objects)
+		if addresses[kind.Group] == nil {
+			addresses[kind.Group] = map[string]address{}
+		}
+		for _, o := range listed[kind] {
+			addresses[kind.Group][o.ID] = address{Type: kind.ResourceType, Label: o.Label}
+		}
+	}
+
+	imports := hclwrite.NewEmptyFile()
+	for _, kind := range kinds {
+		res, ok := p.ResourcesMap[kind.ResourceType]
+		if !ok {
+			return nil, fmt.Errorf("the provider has no %s resource", kind.ResourceType)
+		}
+
+		file := hclwrite.NewEmptyFile()
+		for _, o := range listed[kind] {
+			d, warnings, err := read(ctx, res, o.ID, meta)
+			for _, warning := range warnings {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s %q (ID %s): %s", kind.ResourceType, o.Name, o.ID, warning))
+			}
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("skipped %s %q (ID %s): %v", kind.ResourceType, o.Name, o.ID, err))
+				continue
+			}
+
+			r := &renderer{kind: kind, label: o.Label, addresses: addresses, files: result.Files}
+			if len(file.Body().Blocks()) > 0 {
+				file.Body().AppendNewline()
+			}
+			block := file.Body().AppendNewBlock("resource", []string{kind.ResourceType, o.Label})
+			r.writeBody(block.Body(), res.SchemaMap(), d.Get, "")
+
+			appendImport(imports.Body(), address{Type: kind.ResourceType, Label: o.Label}, d.Id())
+		}
+
+		if len(file.Body().Blocks()) > 0 {
+			result.Files[kind.ResourceType+".tf"] = file.Bytes()
+		}
+	}
+
+	result.Files["imports.tf"] = imports.Bytes()
+	result.Files["versions.tf"] = versions()
+
+	return result, nil
+}
+
+// read reads the object with the ID through the resource's read function. Config-only attributes
+// read as their defaults.
+func read(ctx context.Context, res *schema.Resource, id string, meta any) (*schema.ResourceData, []string, error) {
+	d := res.Data(nil)
+	for key, s := range res.SchemaMap() {
+		if s.Default != nil {
+			if err := d.Set(key, s.Default); err != nil {
+				return nil, nil, fmt.Errorf("failed to set the default of %s: %v", key, err)
+			}
+		}
+	}
+	d.SetId(id)
+
+	var warnings, errs []string
+	for _, diagnostic := range res.ReadContext(ctx, d, meta) {
+		message := diagnostic.Summary
+		if diagnostic.Detail != "" {
+			message += ": " + diagnostic.Detail
+		}
+		if diagnostic.Severity == diag.Error {
+			errs = append(errs, message)
+		} else {
+			warnings = append(warnings, message)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, warnings, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	if d.Id() == "" {
+		return nil, warnings, fmt.Errorf("it no longer exists")
+	}
+	return d, warnings, nil
+}
+
+// appendImport appends an import block adopting the object with the ID as the resource at addr.
+func appendImport(body *hclwrite.Body, addr address, id string) {
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	block := body.AppendNewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", traversal(addr.Type, addr.Label))
+	block.Body().SetAttributeRaw("id", quoted(id))
+}
+
+// versions returns the terraform block requiring this provider.
+func versions() []byte {
+	file := hclwrite.NewEmptyFile()
+	providers := file.Body().AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil)
+	providers.Body().SetAttributeRaw("jamfpro", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{{
+		Name:  hclwrite.TokensForIdentifier("source"),
+		Value: quoted("deploymenttheory/jamfpro"),
+	}}))
+	return file.Bytes()
+}
+
+// labelObjects returns the objects sorted by name and then ID, each with a label unique among them.
+func labelObjects(objects []object) []exportedObject {
+	sorted := make([]object, len(objects))
+	copy(sorted, objects)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return idLess(sorted[i].ID, sorted[j].ID)
+	})
+
+	used := map[string]bool{}
+	out := make([]exportedObject, 0, len(sorted))
+	for _, o := range sorted {
+		label := Label(o.Name, o.ID)
+		for used[label] {
+			label += "_" + o.ID
+		}
+		used[label] = true
+		out = append(out, exportedObject{object: o, Label: label})
+	}
+	return out
+}
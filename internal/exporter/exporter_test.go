@@ -0,0 +1,164 @@
+package exporter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest/fakejamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/provider"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/policy"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/script"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabel(t *testing.T) {
+	assert.Equal(t, "install_google_chrome", Label("Install Google Chrome", "1"))
+	assert.Equal(t, "macos_14_sonoma", Label("  macOS 14 (Sonoma)!", "2"))
+	assert.Equal(t, "_1password", Label("1Password", "3"))
+	assert.Equal(t, "object_4", Label("🙂", "4"))
+}
+
+func TestLabelObjects(t *testing.T) {
+	got := labelObjects([]object{{ID: "12", Name: "Apps"}, {ID: "3", Name: "apps"}, {ID: "7", Name: "Apps"}})
+
+	var labels []string
+	for _, o := range got {
+		labels = append(labels, o.ID+"="+o.Label)
+	}
+	assert.Equal(t, []string{"7=apps", "12=apps_12", "3=apps_3"}, labels)
+}
+
+func TestKindsNamed(t *testing.T) {
+	kinds, err := KindsNamed([]string{"policy", "jamfpro_category"})
+	require.NoError(t, err)
+	require.Len(t, kinds, 2)
+	assert.Equal(t, "jamfpro_category", kinds[0].ResourceType, "kinds are in the order of Kinds")
+	assert.Equal(t, "jamfpro_policy", kinds[1].ResourceType)
+
+	_, err = KindsNamed([]string{"computer"})
+	assert.ErrorContains(t, err, "jamfpro_computer is not a resource type")
+}
+
+// render writes a resource of the kind from the resource data.
+func render(t *testing.T, kind *Kind, res *schema.Resource, d *schema.ResourceData, addresses map[string]map[string]address) (string, map[string][]byte) {
+	t.Helper()
+
+	files := map[string][]byte{}
+	r := &renderer{kind: kind, label: "example", addresses: addresses, files: files}
+	f := hclwrite.NewEmptyFile()
+	r.writeBody(f.Body().AppendNewBlock("resource", []string{kind.ResourceType, "example"}).Body(), res.SchemaMap(), d.Get, "")
+	return string(f.Bytes()), files
+}
+
+func kindOf(t *testing.T, resourceType string) *Kind {
+	t.Helper()
+
+	kinds, err := KindsNamed([]string{resourceType})
+	require.NoError(t, err)
+	return kinds[0]
+}
+
+func TestFrameworkResourceWarnings(t *testing.T) {
+	warnings := FrameworkResourceWarnings(context.Background(), provider.FrameworkProvider("test")())
+
+	assert.Contains(t, warnings, "jamfpro_smart_computer_group_v2 objects are not exported: the exporter only writes SDKv2 resources")
+	assert.Contains(t, warnings, "jamfpro_smart_mobile_device_group_v1 objects are not exported: the exporter only writes SDKv2 resources")
+}
+
+func TestRenderPolicy(t *testing.T) {
+	res := policy.ResourceJamfProPolicies()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		"name":        "Install Chrome",
+		"enabled":     true,
+		"category_id": 5,
+		"site_id":     -1,
+		"scope": []any{map[string]any{
+			"computer_group_ids": []any{7, 99},
+			"exclusions":         []any{map[string]any{"computer_group_ids": []any{8}}},
+		}},
+		"payloads": []any{map[string]any{
+			"packages": []any{map[string]any{
+				"distribution_point": "default",
+				"package":            []any{map[string]any{"id": 21, "action": "Install"}},
+			}},
+			"scripts": []any{map[string]any{"id": "30", "priority": "After", "parameter4": "--force"}},
+		}},
+	})
+	addresses := map[string]map[string]address{
+		"category":       {"5": {Type: "jamfpro_category", Label: "browsers"}},
+		"computer_group": {"7": {Type: "jamfpro_smart_computer_group", Label: "all_macs"}, "8": {Type: "jamfpro_static_computer_group", Label: "lab"}},
+		"package":        {"21": {Type: "jamfpro_package", Label: "chrome"}},
+		"script":         {"30": {Type: "jamfpro_script", Label: "cleanup"}},
+	}
+
+	got, _ := render(t, kindOf(t, "policy"), res, d, addresses)
+
+	assert.True(t, strings.HasPrefix(got, "resource \"jamfpro_policy\" \"example\" {\n  name "), "name is written first:\n%s", got)
+	assert.Contains(t, got, "category_id = jamfpro_category.browsers.id\n")
+	assert.NotContains(t, got, "site_id", "-1 references no site")
+	assert.Contains(t, got, "computer_group_ids = [jamfpro_smart_computer_group.all_macs.id, 99]\n", "unexported IDs are kept")
+	assert.Contains(t, got, "computer_group_ids = [jamfpro_static_computer_group.lab.id]\n")
+	assert.Contains(t, got, "id = jamfpro_package.chrome.id\n")
+	assert.Contains(t, got, "id         = jamfpro_script.cleanup.id\n")
+	assert.Contains(t, got, "parameter4 = \"--force\"\n")
+	assert.NotContains(t, got, "name_references", "computed attributes are left out")
+	assert.NotContains(t, got, "frequency", "attributes holding their default are left out")
+}
+
+func TestRenderScriptContentsToFile(t *testing.T) {
+	res := script.ResourceJamfProScripts()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		"name":            "Cleanup",
+		"priority":        "AFTER",
+		"script_contents": "#!/bin/sh\necho \"${HOME}\"\n",
+	})
+
+	got, files := render(t, kindOf(t, "script"), res, d, nil)
+
+	assert.Contains(t, got, "script_contents = file(\"${path.module}/files/script/example.sh\")\n")
+	assert.Equal(t, "#!/bin/sh\necho \"${HOME}\"\n", string(files["files/script/example.sh"]))
+	assert.NotContains(t, got, "category_id", "category_id holds its default of -1")
+}
+
+func TestExport(t *testing.T) {
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+	t.Setenv("JAMFPRO_INSTANCE_FQDN", server.URL)
+	t.Setenv("JAMFPRO_AUTH_METHOD", "oauth2")
+	t.Setenv("JAMFPRO_AUTH_PROVIDER", "direct")
+	t.Setenv("JAMFPRO_CLIENT_ID", server.ClientID)
+	t.Setenv("JAMFPRO_CLIENT_SECRET", server.ClientSecret)
+
+	ctx := context.Background()
+	p := provider.Provider()
+	require.False(t, p.Configure(ctx, terraform.NewResourceConfigRaw(nil)).HasError())
+	client := p.Meta().(*jamfpro.Client)
+
+	category, err := client.CreateCategory(&jamfpro.ResourceCategory{Name: "Utilities", Priority: 5})
+	require.NoError(t, err)
+	_, err = client.CreateScript(&jamfpro.ResourceScript{
+		Name:           "Cleanup",
+		CategoryId:     category.ID,
+		Priority:       "AFTER",
+		ScriptContents: "#!/bin/sh\nexit 0\n",
+	})
+	require.NoError(t, err)
+
+	result, err := Export(ctx, p, []*Kind{kindOf(t, "category"), kindOf(t, "script")})
+	require.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+
+	assert.Equal(t, "resource \"jamfpro_category\" \"utilities\" {\n  name     = \"Utilities\"\n  priority = 5\n}\n",
+		string(result.Files["jamfpro_category.tf"]))
+	assert.Contains(t, string(result.Files["jamfpro_script.tf"]), "category_id     = jamfpro_category.utilities.id\n")
+	assert.Equal(t, "#!/bin/sh\nexit 0\n", string(result.Files["files/script/cleanup.sh"]))
+	assert.Contains(t, string(result.Files["imports.tf"]), "import {\n  to = jamfpro_category.utilities\n  id = \""+category.ID+"\"\n}\n")
+	assert.Contains(t, string(result.Files["imports.tf"]), "to = jamfpro_script.cleanup\n")
+	assert.Contains(t, string(result.Files["versions.tf"]), "source = \"deploymenttheory/jamfpro\"")
+}
@@ -0,0 +1,242 @@
+package exporter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// renderer writes the configuration of an exported object.
+type renderer struct {
+	kind  *Kind
+	label string
+	// addresses maps the Group of each kind exported, and the IDs of its objects, to the addresses
+	// of their resources.
+	addresses map[string]map[string]address
+	// files collects the files written under files/.
+	files map[string][]byte
+}
+
+// writeBody writes the configurable attributes, and then blocks, of a resource or block to body.
+// get returns their values and path is the path of the block, or "" for the resource. Attributes are
+// written with name first, then in alphabetical order, and left out when they hold their default.
+func (r *renderer) writeBody(body *hclwrite.Body, schemaMap map[string]*schema.Schema, get func(string) any, path string) {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if (keys[i] == "name") != (keys[j] == "name") {
+			return keys[i] == "name"
+		}
+		return keys[i] < keys[j]
+	})
+
+	// Attributes which are not computed are decided first, so that an optional and computed
+	// attribute conflicting with one of them, e.g. filename with package_file_source, is left out.
+	attributes := map[string]hclwrite.Tokens{}
+	for _, computed := range []bool{false, true} {
+		for _, key := range keys {
+			s := schemaMap[key]
+			if isBlock(s) || s.Computed != computed || !configurable(s) {
+				continue
+			}
+			value := get(key)
+			if !include(s, value, attributes) {
+				continue
+			}
+			// -1 references no object, e.g. no category, which is what an unset reference means.
+			if r.kind.references[pathPattern(joinPath(path, key))] != "" && !s.Required && fmt.Sprint(value) == "-1" {
+				continue
+			}
+			attributes[key] = r.tokens(s, value, joinPath(path, key))
+		}
+	}
+	for _, key := range keys {
+		if tokens, ok := attributes[key]; ok {
+			body.SetAttributeRaw(key, tokens)
+		}
+	}
+
+	for _, key := range keys {
+		s := schemaMap[key]
+		if !isBlock(s) || !configurable(s) {
+			continue
+		}
+		elem := s.Elem.(*schema.Resource)
+		for i, element := range elements(get(key)) {
+			values, _ := element.(map[string]any)
+			block := body.AppendNewBlock(key, nil)
+			r.writeBody(block.Body(), elem.SchemaMap(), func(k string) any { return values[k] }, joinPath(path, key, strconv.Itoa(i)))
+		}
+	}
+}
+
+// tokens returns the expression of the value of an attribute at path.
+func (r *renderer) tokens(s *schema.Schema, value any, path string) hclwrite.Tokens {
+	pattern := pathPattern(path)
+	if extension, ok := r.kind.files[pattern]; ok {
+		if contents, ok := value.(string); ok {
+			return r.file(extension, contents)
+		}
+	}
+	group := r.kind.references[pattern]
+
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		items := elements(value)
+		if s.Type == schema.TypeSet {
+			sort.SliceStable(items, func(i, j int) bool { return idLess(fmt.Sprint(items[i]), fmt.Sprint(items[j])) })
+		}
+		out := make([]hclwrite.Tokens, len(items))
+		for i, item := range items {
+			out[i] = r.primitive(item, group)
+		}
+		return hclwrite.TokensForTuple(out)
+	case schema.TypeMap:
+		values, _ := value.(map[string]any)
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		out := make([]hclwrite.ObjectAttrTokens, len(keys))
+		for i, key := range keys {
+			name := quoted(key)
+			if hclsyntax.ValidIdentifier(key) {
+				name = hclwrite.TokensForIdentifier(key)
+			}
+			out[i] = hclwrite.ObjectAttrTokens{Name: name, Value: r.primitive(values[key], "")}
+		}
+		return hclwrite.TokensForObject(out)
+	}
+	return r.primitive(value, group)
+}
+
+// primitive returns the expression of a primitive value, or, when it is the ID of an exported
+// object of the group, the reference to that object's id.
+func (r *renderer) primitive(value any, group string) hclwrite.Tokens {
+	if group != "" {
+		if addr, ok := r.addresses[group][fmt.Sprint(value)]; ok {
+			return hclwrite.TokensForTraversal(traversal(addr.Type, addr.Label, "id"))
+		}
+	}
+
+	switch v := value.(type) {
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	}
+	return quoted(fmt.Sprint(value))
+}
+
+// file adds the contents of an attribute as a file named for the resource, and returns the
+// expression reading it, e.g. file("${path.module}/files/script/cleanup.sh").
+func (r *renderer) file(extension, contents string) hclwrite.Tokens {
+	name := fmt.Sprintf("files/%s/%s.%s", strings.TrimPrefix(r.kind.ResourceType, "jamfpro_"), r.label, extension)
+	r.files[name] = []byte(contents)
+
+	f, diags := hclwrite.ParseConfig([]byte(fmt.Sprintf("value = file(%q)\n", "${path.module}/"+name)), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		panic(fmt.Sprintf("failed to parse the file expression of %s: %v", name, diags))
+	}
+	return f.Body().GetAttribute("value").Expr().BuildTokens(nil)
+}
+
+// include reports whether an attribute with the value is written. attributes holds the attributes
+// already written to the same block.
+func include(s *schema.Schema, value any, attributes map[string]hclwrite.Tokens) bool {
+	switch {
+	case s.Required:
+		return value != nil
+	case value == nil:
+		return false
+	case s.Default != nil:
+		return fmt.Sprint(value) != fmt.Sprint(s.Default)
+	}
+
+	if s.Computed {
+		for _, conflict := range s.ConflictsWith {
+			if _, ok := attributes[conflict[strings.LastIndex(conflict, ".")+1:]]; ok {
+				return false
+			}
+		}
+	}
+	return !isZero(value)
+}
+
+// configurable reports whether an attribute or block is set in configuration.
+func configurable(s *schema.Schema) bool {
+	return (s.Required || s.Optional) && s.Deprecated == ""
+}
+
+// isBlock reports whether an attribute is a list or set of nested blocks.
+func isBlock(s *schema.Schema) bool {
+	_, ok := s.Elem.(*schema.Resource)
+	return ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet)
+}
+
+func isZero(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]any:
+		return len(v) == 0
+	}
+	return len(elements(value)) == 0
+}
+
+// elements returns the elements of a list or set value.
+func elements(value any) []any {
+	switch v := value.(type) {
+	case []any:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+func joinPath(parts ...string) string {
+	return strings.TrimPrefix(strings.Join(parts, "."), ".")
+}
+
+// pathPattern replaces the list and set indices of path with "*", e.g. "scope.0.computer_group_ids"
+// becomes "scope.*.computer_group_ids".
+func pathPattern(path string) string {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		if _, err := strconv.Atoi(part); err == nil {
+			parts[i] = "*"
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func traversal(root string, attributes ...string) hcl.Traversal {
+	out := hcl.Traversal{hcl.TraverseRoot{Name: root}}
+	for _, attribute := range attributes {
+		out = append(out, hcl.TraverseAttr{Name: attribute})
+	}
+	return out
+}
+
+func quoted(s string) hclwrite.Tokens {
+	return hclwrite.TokensForValue(cty.StringVal(s))
+}
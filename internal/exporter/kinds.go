@@ -0,0 +1,328 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Kind is a resource type the exporter writes.
+type Kind struct {
+	// ResourceType is the type of the resource, e.g. "jamfpro_smart_computer_group".
+	ResourceType string
+	// Group is the kind of object other resources reference it as, e.g. "computer_group" for both
+	// smart and static computer groups.
+	Group string
+	// list returns every object of the kind.
+	list func(client *jamfpro.Client) ([]object, error)
+	// references maps the paths of attributes holding IDs of other objects, with "*" for list and
+	// set indices, to the Group of the objects.
+	references map[string]string
+	// files maps the paths of attributes written to files under files/, rather than inline, to the
+	// extension of the files.
+	files map[string]string
+}
+
+// object is an object of a kind, by ID and name.
+type object struct {
+	ID   string
+	Name string
+}
+
+// computerScopeReferences are the references of the scope of computer policies and profiles.
+var computerScopeReferences = map[string]string{
+	"scope.*.computer_group_ids":              "computer_group",
+	"scope.*.exclusions.*.computer_group_ids": "computer_group",
+}
+
+// jamfRemotePolicyName matches the names of the policies Jamf Remote creates, e.g.
+// "2024-03-01 at 9:15 AM | admin | 1 Computer", which are not managed with Terraform.
+var jamfRemotePolicyName = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} at .+ \| .+ \| \d+ Computers?$`)
+
+// Kinds are the resource types the exporter writes, in the order they are written.
+var Kinds = []*Kind{
+	{
+		ResourceType: "jamfpro_category",
+		Group:        "category",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetCategories(nil)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Results))
+			for _, item := range response.Results {
+				out = append(out, object{ID: item.Id, Name: item.Name})
+			}
+			return out, nil
+		},
+	},
+	{
+		ResourceType: "jamfpro_site",
+		Group:        "site",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetSites()
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Site))
+			for _, item := range response.Site {
+				out = append(out, object{ID: strconv.Itoa(item.ID), Name: item.Name})
+			}
+			return out, nil
+		},
+	},
+	{
+		ResourceType: "jamfpro_script",
+		Group:        "script",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetScripts(nil)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Results))
+			for _, item := range response.Results {
+				out = append(out, object{ID: item.ID, Name: item.Name})
+			}
+			return out, nil
+		},
+		references: map[string]string{"category_id": "category"},
+		files:      map[string]string{"script_contents": "sh"},
+	},
+	{
+		// Packages are exported as metadata only. package_file_source is left for the package file
+		// to be uploaded to, or already be present on, the instance the configuration is applied to.
+		ResourceType: "jamfpro_package",
+		Group:        "package",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetPackages("", "")
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Results))
+			for _, item := range response.Results {
+				out = append(out, object{ID: item.ID, Name: item.PackageName})
+			}
+			return out, nil
+		},
+		references: map[string]string{"category_id": "category"},
+	},
+	{
+		ResourceType: "jamfpro_computer_extension_attribute",
+		Group:        "computer_extension_attribute",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetComputerExtensionAttributes(nil)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Results))
+			for _, item := range response.Results {
+				out = append(out, object{ID: item.ID, Name: item.Name})
+			}
+			return out, nil
+		},
+		files: map[string]string{"script_contents": "sh"},
+	},
+	{
+		ResourceType: "jamfpro_mobile_device_extension_attribute",
+		Group:        "mobile_device_extension_attribute",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetMobileDeviceExtensionAttributes(nil)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Results))
+			for _, item := range response.Results {
+				out = append(out, object{ID: item.ID, Name: item.Name})
+			}
+			return out, nil
+		},
+	},
+	{
+		ResourceType: "jamfpro_smart_computer_group",
+		Group:        "computer_group",
+		list:         computerGroups(true),
+		references:   map[string]string{"site_id": "site"},
+	},
+	{
+		ResourceType: "jamfpro_static_computer_group",
+		Group:        "computer_group",
+		list:         computerGroups(false),
+		references:   map[string]string{"site_id": "site"},
+	},
+	{
+		ResourceType: "jamfpro_smart_mobile_device_group",
+		Group:        "mobile_device_group",
+		list:         mobileDeviceGroups(true),
+		references:   map[string]string{"site_id": "site"},
+	},
+	{
+		ResourceType: "jamfpro_static_mobile_device_group",
+		Group:        "mobile_device_group",
+		list:         mobileDeviceGroups(false),
+		references:   map[string]string{"site_id": "site"},
+	},
+	{
+		ResourceType: "jamfpro_policy",
+		Group:        "policy",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetPolicies()
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Policy))
+			for _, item := range response.Policy {
+				if jamfRemotePolicyName.MatchString(item.Name) {
+					continue
+				}
+				out = append(out, object{ID: strconv.Itoa(item.ID), Name: item.Name})
+			}
+			return out, nil
+		},
+		references: withReferences(computerScopeReferences, map[string]string{
+			"category_id":                               "category",
+			"site_id":                                   "site",
+			"payloads.*.packages.*.package.*.id":        "package",
+			"payloads.*.scripts.*.id":                   "script",
+			"self_service.*.self_service_category.*.id": "category",
+		}),
+	},
+	{
+		ResourceType: "jamfpro_macos_configuration_profile_plist",
+		Group:        "macos_configuration_profile",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetMacOSConfigurationProfiles()
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.Results))
+			for _, item := range response.Results {
+				out = append(out, object{ID: strconv.Itoa(item.ID), Name: item.Name})
+			}
+			return out, nil
+		},
+		references: withReferences(computerScopeReferences, map[string]string{
+			"category_id": "category",
+			"site_id":     "site",
+			"self_service.*.self_service_category.*.id": "category",
+		}),
+		files: map[string]string{"payloads": "mobileconfig"},
+	},
+	{
+		ResourceType: "jamfpro_mobile_device_configuration_profile_plist",
+		Group:        "mobile_device_configuration_profile",
+		list: func(client *jamfpro.Client) ([]object, error) {
+			response, err := client.GetMobileDeviceConfigurationProfiles()
+			if err != nil {
+				return nil, err
+			}
+			out := make([]object, 0, len(response.ConfigurationProfiles))
+			for _, item := range response.ConfigurationProfiles {
+				out = append(out, object{ID: strconv.Itoa(item.ID), Name: item.Name})
+			}
+			return out, nil
+		},
+		references: map[string]string{
+			"category_id":                     "category",
+			"site_id":                         "site",
+			"scope.*.mobile_device_group_ids": "mobile_device_group",
+			"scope.*.exclusions.*.mobile_device_group_ids": "mobile_device_group",
+		},
+		files: map[string]string{"payloads": "mobileconfig"},
+	},
+}
+
+// computerGroups lists the smart, or static, computer groups.
+func computerGroups(smart bool) func(client *jamfpro.Client) ([]object, error) {
+	return func(client *jamfpro.Client) ([]object, error) {
+		response, err := client.GetComputerGroups()
+		if err != nil {
+			return nil, err
+		}
+		var out []object
+		for _, item := range response.Results {
+			if item.IsSmart == smart {
+				out = append(out, object{ID: strconv.Itoa(item.ID), Name: item.Name})
+			}
+		}
+		return out, nil
+	}
+}
+
+// mobileDeviceGroups lists the smart, or static, mobile device groups.
+func mobileDeviceGroups(smart bool) func(client *jamfpro.Client) ([]object, error) {
+	return func(client *jamfpro.Client) ([]object, error) {
+		response, err := client.GetMobileDeviceGroups()
+		if err != nil {
+			return nil, err
+		}
+		var out []object
+		for _, item := range response.MobileDeviceGroup {
+			if item.IsSmart == smart {
+				out = append(out, object{ID: strconv.Itoa(item.ID), Name: item.Name})
+			}
+		}
+		return out, nil
+	}
+}
+
+func withReferences(shared, own map[string]string) map[string]string {
+	out := make(map[string]string, len(shared)+len(own))
+	for path, group := range shared {
+		out[path] = group
+	}
+	for path, group := range own {
+		out[path] = group
+	}
+	return out
+}
+
+// KindsNamed returns the kinds with the resource types, given with or without the "jamfpro_"
+// prefix, e.g. "policy" or "jamfpro_policy", in the order of Kinds. No names returns every kind.
+func KindsNamed(names []string) ([]*Kind, error) {
+	if len(names) == 0 {
+		return Kinds, nil
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if !strings.HasPrefix(name, "jamfpro_") {
+			name = "jamfpro_" + name
+		}
+		wanted[name] = true
+	}
+
+	var out []*Kind
+	for _, kind := range Kinds {
+		if wanted[kind.ResourceType] {
+			out = append(out, kind)
+			delete(wanted, kind.ResourceType)
+		}
+	}
+	for name := range wanted {
+		return nil, fmt.Errorf("%s is not a resource type the exporter writes", name)
+	}
+	return out, nil
+}
+
+// FrameworkResourceWarnings returns a warning for each resource type of the Plugin Framework
+// provider p, e.g. jamfpro_smart_computer_group_v2. Objects are read through their SDKv2
+// resources, so these types are never exported.
+func FrameworkResourceWarnings(ctx context.Context, p fwprovider.Provider) []string {
+	var metadata fwprovider.MetadataResponse
+	p.Metadata(ctx, fwprovider.MetadataRequest{}, &metadata)
+
+	var warnings []string
+	for _, newResource := range p.Resources(ctx) {
+		var resp fwresource.MetadataResponse
+		newResource().Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: metadata.TypeName}, &resp)
+		warnings = append(warnings, fmt.Sprintf("%s objects are not exported: the exporter only writes SDKv2 resources", resp.TypeName))
+	}
+	return warnings
+}
@@ -0,0 +1,47 @@
+package exporter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Label returns the resource label for an object named name, e.g. "install_google_chrome" for
+// "Install Google Chrome". Characters other than lowercase letters, digits and underscores are
+// replaced by underscores. Names without any letter or digit are labelled by ID, e.g. "object_12".
+func Label(name, id string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+
+	label := strings.TrimSuffix(b.String(), "_")
+	switch {
+	case label == "":
+		return "object_" + id
+	case label[0] >= '0' && label[0] <= '9':
+		return "_" + label
+	}
+	return label
+}
+
+// idLess orders IDs numerically, and IDs which are not numbers after those which are.
+func idLess(a, b string) bool {
+	x, errX := strconv.Atoi(a)
+	y, errY := strconv.Atoi(b)
+	switch {
+	case errX == nil && errY == nil:
+		return x < y
+	case errX == nil || errY == nil:
+		return errX == nil
+	}
+	return a < b
+}
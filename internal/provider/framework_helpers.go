@@ -34,3 +34,10 @@ func getInt64WithDefault(configValue types.Int64, defaultValue int64) int64 {
 	}
 	return configValue.ValueInt64()
 }
+
+func getFloat64WithDefault(configValue types.Float64, defaultValue float64) float64 {
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return defaultValue
+	}
+	return configValue.ValueFloat64()
+}
@@ -4,22 +4,28 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // frameworkProviderModel describes the provider data model.
 type frameworkProviderModel struct {
-	InstanceFQDN                      types.String `tfsdk:"jamfpro_instance_fqdn"`
-	AuthMethod                        types.String `tfsdk:"auth_method"`
-	AuthProvider                      types.String `tfsdk:"auth_provider"`
-	ClientID                          types.String `tfsdk:"client_id"`
-	ClientSecret                      types.String `tfsdk:"client_secret"`
-	BasicAuthUsername                 types.String `tfsdk:"basic_auth_username"`
-	BasicAuthPassword                 types.String `tfsdk:"basic_auth_password"`
-	PlatformBaseURL                   types.String `tfsdk:"platform_base_url"`
-	PlatformTenantID                  types.String `tfsdk:"platform_tenant_id"`
-	EnableClientSDKLogs               types.Bool   `tfsdk:"enable_client_sdk_logs"`
-	ClientSDKLogExportPath            types.String `tfsdk:"client_sdk_log_export_path"`
-	HideSensitiveData                 types.Bool   `tfsdk:"hide_sensitive_data"`
-	LoadBalancerLock                  types.Bool   `tfsdk:"jamfpro_load_balancer_lock"`
-	TokenRefreshBufferPeriodSeconds   types.Int64  `tfsdk:"token_refresh_buffer_period_seconds"`
-	MandatoryRequestDelayMilliseconds types.Int64  `tfsdk:"mandatory_request_delay_milliseconds"`
-	CustomCookies                     types.List   `tfsdk:"custom_cookies"`
+	InstanceFQDN                      types.String  `tfsdk:"jamfpro_instance_fqdn"`
+	AuthMethod                        types.String  `tfsdk:"auth_method"`
+	AuthProvider                      types.String  `tfsdk:"auth_provider"`
+	ClientID                          types.String  `tfsdk:"client_id"`
+	ClientSecret                      types.String  `tfsdk:"client_secret"`
+	BasicAuthUsername                 types.String  `tfsdk:"basic_auth_username"`
+	BasicAuthPassword                 types.String  `tfsdk:"basic_auth_password"`
+	PlatformBaseURL                   types.String  `tfsdk:"platform_base_url"`
+	PlatformTenantID                  types.String  `tfsdk:"platform_tenant_id"`
+	EnableClientSDKLogs               types.Bool    `tfsdk:"enable_client_sdk_logs"`
+	ClientSDKLogExportPath            types.String  `tfsdk:"client_sdk_log_export_path"`
+	HideSensitiveData                 types.Bool    `tfsdk:"hide_sensitive_data"`
+	LoadBalancerLock                  types.Bool    `tfsdk:"jamfpro_load_balancer_lock"`
+	TokenRefreshBufferPeriodSeconds   types.Int64   `tfsdk:"token_refresh_buffer_period_seconds"`
+	MandatoryRequestDelayMilliseconds types.Int64   `tfsdk:"mandatory_request_delay_milliseconds"`
+	MaxConcurrentRequests             types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond                 types.Float64 `tfsdk:"requests_per_second"`
+	HonorRetryAfter                   types.Bool    `tfsdk:"honor_retry_after"`
+	MaxThrottleRetries                types.Int64   `tfsdk:"max_throttle_retries"`
+	EnableReadCache                   types.Bool    `tfsdk:"enable_read_cache"`
+	PackageCacheDir                   types.String  `tfsdk:"package_cache_dir"`
+	CustomCookies                     types.List    `tfsdk:"custom_cookies"`
 }
 
 // customCookieModel describes the custom cookie nested model.
@@ -9,6 +9,11 @@ import (
 	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/package_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/throttle"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +24,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &frameworkProvider{}
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
+	_ provider.ProviderWithActions            = &frameworkProvider{}
 )
 
 // frameworkProvider defines the provider implementation for Framework-based resources.
@@ -116,6 +124,39 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "A mandatory delay after each request before returning to reduce high volume of requests in a short time",
 			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests in flight to the Jamf Pro instance at any time, shared across all resources. 0 disables the limit. Defaults to 5.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The sustained request rate allowed against the Jamf Pro instance, enforced with a token bucket. The rate is halved when Jamf Pro throttles requests and recovers as requests succeed. 0 disables the limit. Defaults to 10.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"honor_retry_after": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Replay requests rejected with 429, or 503 with a Retry-After header, after the delay requested by Jamf Pro. Defaults to true.",
+			},
+			"max_throttle_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of times a throttled request is replayed when honor_retry_after is enabled.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"enable_read_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Opt in to a per-run read cache. The first read of a supported resource type (scripts, categories, buildings, departments and packages) fetches the type's full list once, and later reads of that type are served from it. Objects written during the run are always read directly. Policies, configuration profiles and computer and mobile device groups, whose list endpoints return only IDs and names, are read by ID once per run, and the resources sharing one, such as scope targets and group memberships, are served from that read.",
+			},
+			"package_cache_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: "A directory in which package files downloaded from HTTP(S) sources, and the hashes of package files, are cached across runs. Cached downloads are revalidated with the source's ETag or Last-Modified header instead of being downloaded again. Can also be set with the JAMFPRO_PACKAGE_CACHE_DIR environment variable.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"custom_cookies": schema.ListNestedBlock{
@@ -271,6 +312,12 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 	loadBalancerLock := getBoolWithDefault(config.LoadBalancerLock, false)
 	tokenRefreshBuffer := time.Duration(getInt64WithDefault(config.TokenRefreshBufferPeriodSeconds, 300)) * time.Second
 	mandatoryRequestDelay := time.Duration(getInt64WithDefault(config.MandatoryRequestDelayMilliseconds, 100)) * time.Millisecond
+	throttleConfig := throttle.Config{
+		MaxConcurrentRequests: int(getInt64WithDefault(config.MaxConcurrentRequests, throttle.DefaultMaxConcurrentRequests)),
+		RequestsPerSecond:     getFloat64WithDefault(config.RequestsPerSecond, throttle.DefaultRequestsPerSecond),
+		HonorRetryAfter:       getBoolWithDefault(config.HonorRetryAfter, true),
+		MaxThrottleRetries:    int(getInt64WithDefault(config.MaxThrottleRetries, throttle.DefaultMaxThrottleRetries)),
+	}
 
 	// Create logger configuration - matching SDKv2 provider
 	var sugaredLogger *zap.SugaredLogger
@@ -411,10 +458,10 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		CustomCookies:            cookiesList,
 		MandatoryRequestDelay:    mandatoryRequestDelay,
 		RetryEligiableRequests:   false, // Forced because terraform handles concurrency
-		HTTP:                     http.Client{},
+		HTTP:                     throttledHTTPClient(limiterInstanceKey(authProvider, instanceFQDN, platformBaseURL, platformTenantID), throttleConfig),
 	}
 
-	httpClient, err := clientConfig.Build()
+	httpClient, err := buildHTTPClient(clientConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error building HTTP client",
@@ -428,6 +475,20 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		HTTP: httpClient,
 	}
 
+	if getBoolWithDefault(config.EnableReadCache, false) {
+		read_cache.Enable(&jamfProSdk)
+	}
+
+	if dir := getStringValueWithEnvFallback(config.PackageCacheDir, envVarPackageCacheDir); dir != "" {
+		if err := package_cache.Enable(&jamfProSdk, dir); err != nil {
+			resp.Diagnostics.AddError(
+				"Error configuring package cache",
+				fmt.Sprintf("Error: %v", err),
+			)
+			return
+		}
+	}
+
 	warning, err := CheckJamfProVersion(&jamfProSdk)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -446,4 +507,7 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 	// Store client for use by resources and data sources
 	resp.ResourceData = &jamfProSdk
 	resp.DataSourceData = &jamfProSdk
+	resp.EphemeralResourceData = &jamfProSdk
+	resp.ListResourceData = &jamfProSdk
+	resp.ActionData = &jamfProSdk
 }
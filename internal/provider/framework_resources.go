@@ -4,11 +4,27 @@ import (
 	"context"
 
 	jamfProAdcsSettings "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/adcs_settings"
+	jamfProApiIntegration "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/api_integration"
+	jamfProCategory "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/category"
 	jamfProCloudDistributionPoint "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/cloud_distribution_point"
 	jamfProDockItem "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/dock_item"
+	jamfProLocalAdminPassword "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/local_admin_password"
+	jamfProMacOSConfigurationProfilePlist "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/macos_configuration_profile_plist"
+	jamfProMDMCommand "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mdm_command"
+	jamfProMobileDeviceConfigurationProfilePlist "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_configuration_profile_plist"
+	jamfProPackage "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/package"
+	jamfProPolicy "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/policy"
+	jamfProScript "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/script"
 	jamfProServiceDiscoveryEnrollmentWellKnownSettings "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/service_discovery_enrollment_well_known_settings"
+	jamfProSmartComputerGroup "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_computer_group"
 	jamfProSmartComputerGroupV2 "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_computer_group_v2"
+	jamfProSmartMobileDeviceGroup "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_mobile_device_group"
 	jamfProSmartMobileDeviceGroupV1 "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/smart_mobile_device_group_v1"
+	jamfProStaticComputerGroup "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_computer_group"
+	jamfProStaticMobileDeviceGroup "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_mobile_device_group"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -22,3 +38,35 @@ func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		jamfProServiceDiscoveryEnrollmentWellKnownSettings.NewServiceDiscoveryEnrollmentWellKnownSettingsFrameworkResource,
 	}
 }
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		jamfProApiIntegration.NewApiIntegrationCredentialsEphemeralResource,
+		jamfProLocalAdminPassword.NewLocalAdminPasswordEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		jamfProCategory.NewCategoryListResource,
+		jamfProMacOSConfigurationProfilePlist.NewMacOSConfigurationProfilePlistListResource,
+		jamfProMobileDeviceConfigurationProfilePlist.NewMobileDeviceConfigurationProfilePlistListResource,
+		jamfProPackage.NewPackageListResource,
+		jamfProPolicy.NewPolicyListResource,
+		jamfProScript.NewScriptListResource,
+		jamfProSmartComputerGroup.NewSmartComputerGroupListResource,
+		jamfProSmartMobileDeviceGroup.NewSmartMobileDeviceGroupListResource,
+		jamfProStaticComputerGroup.NewStaticComputerGroupListResource,
+		jamfProStaticMobileDeviceGroup.NewStaticMobileDeviceGroupListResource,
+	}
+}
+
+func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		jamfProMDMCommand.NewFlushPolicyLogsAction,
+		jamfProMDMCommand.NewRedeployManagementFrameworkAction,
+		jamfProMDMCommand.NewRenewMDMProfileAction,
+		jamfProMDMCommand.NewSendBlankPushAction,
+		jamfProMDMCommand.NewUpdateInventoryAction,
+	}
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// MuxServer returns a protocol 6 server which serves the SDKv2 and Framework providers together.
+// It is used by main to serve the provider and by acceptance tests to run it in process.
+func MuxServer(ctx context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	// Upgrade SDKv2 provider from protocol 5 to protocol 6
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
+		ctx,
+		Provider().GRPCProvider,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mux both providers together using protocol 6
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer { return upgradedSdkProvider },
+		providerserver.NewProtocol6(FrameworkProvider(version)()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/package_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/throttle"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/access_management_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/account"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/account_driven_user_enrollment_settings"
@@ -31,6 +34,8 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/cloud_idp"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/cloud_ldap"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_extension_attribute"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_extension_attribute_value"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_inventories"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_inventory"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_inventory_collection_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_prestage_enrollment"
@@ -46,6 +51,8 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/group"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/icon"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/impact_alert_notification_settings"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/inventory_preload_csv"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/inventory_preload_record"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/jamf_cloud_distribution_service"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/jamf_connect"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/jamf_protect"
@@ -61,11 +68,18 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_application"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_configuration_profile_plist"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_extension_attribute"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_extension_attribute_value"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_inventory"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_prestage_enrollment"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/network_segment"
 	packages "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/package"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/patch_policy"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/patch_software_title_configuration"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/patch_source"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/policy"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/policy_scope_target"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/printer"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/profile_scope_target"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/reenrollment"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/restricted_software"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/script"
@@ -82,7 +96,9 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/sso_failover"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/sso_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_computer_group"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_computer_group_membership"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_mobile_device_group"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/static_mobile_device_group_membership"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/user_group"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/user_initiated_enrollment_settings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/volume_purchasing_locations"
@@ -106,6 +122,7 @@ const (
 	envVarJamfProAuthProvider         = "JAMFPRO_AUTH_PROVIDER"
 	envVarPlatformBaseURL             = "JAMFPRO_PLATFORM_BASE_URL"
 	envVarPlatformTenantID            = "JAMFPRO_PLATFORM_TENANT_ID"
+	envVarPackageCacheDir             = "JAMFPRO_PACKAGE_CACHE_DIR"
 	jamfLoadBalancerCookieName        = "jpro-ingress"
 )
 
@@ -403,6 +420,45 @@ func Provider() *schema.Provider {
 				Default:     100,
 				Description: "A mandatory delay after each request before returning to reduce high volume of requests in a short time",
 			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      throttle.DefaultMaxConcurrentRequests,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of requests in flight to the Jamf Pro instance at any time, shared across all resources. 0 disables the limit. Defaults to 5.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      throttle.DefaultRequestsPerSecond,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The sustained request rate allowed against the Jamf Pro instance, enforced with a token bucket. The rate is halved when Jamf Pro throttles requests and recovers as requests succeed. 0 disables the limit. Defaults to 10.",
+			},
+			"honor_retry_after": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Replay requests rejected with 429, or 503 with a Retry-After header, after the delay requested by Jamf Pro. Defaults to true.",
+			},
+			"max_throttle_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      throttle.DefaultMaxThrottleRetries,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of times a throttled request is replayed when honor_retry_after is enabled.",
+			},
+			"enable_read_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Opt in to a per-run read cache. The first read of a supported resource type (scripts, categories, buildings, departments and packages) fetches the type's full list once, and later reads of that type are served from it. Objects written during the run are always read directly. Policies, configuration profiles and computer and mobile device groups, whose list endpoints return only IDs and names, are read by ID once per run, and the resources sharing one, such as scope targets and group memberships, are served from that read.",
+			},
+			"package_cache_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarPackageCacheDir, ""),
+				Description: "A directory in which package files downloaded from HTTP(S) sources, and the hashes of package files, are cached across runs. Cached downloads are revalidated with the source's ETag or Last-Modified header instead of being downloaded again. Can also be set with the JAMFPRO_PACKAGE_CACHE_DIR environment variable.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 
@@ -419,6 +475,7 @@ func Provider() *schema.Provider {
 			"jamfpro_cloud_distribution_point":                  cloud_distribution_point.DataSourceJamfProCloudDistributionPoint(),
 			"jamfpro_cloud_idp":                                 cloud_idp.DataSourceJamfProCloudIdp(),
 			"jamfpro_computer_extension_attribute":              computer_extension_attribute.DataSourceJamfProComputerExtensionAttributes(),
+			"jamfpro_computer_inventories":                      computer_inventories.DataSourceJamfProComputerInventories(),
 			"jamfpro_computer_inventory":                        computer_inventory.DataSourceJamfProComputerInventory(),
 			"jamfpro_computer_prestage_enrollment":              computer_prestage_enrollment.DataSourceJamfProComputerPrestageEnrollment(),
 			"jamfpro_department":                                department.DataSourceJamfProDepartments(),
@@ -436,8 +493,12 @@ func Provider() *schema.Provider {
 			"jamfpro_macos_configuration_profile_plist":         macos_configuration_profile_plist.DataSourceJamfProMacOSConfigurationProfilesPlist(),
 			"jamfpro_mobile_device_application":                 mobile_device_application.DataSourceJamfProMobileDeviceApplications(),
 			"jamfpro_mobile_device_configuration_profile_plist": mobile_device_configuration_profile_plist.DataSourceJamfProMobileDeviceConfigurationProfilesPlist(),
+			"jamfpro_mobile_device_inventories":                 mobile_device_inventory.DataSourceJamfProMobileDeviceInventories(),
+			"jamfpro_mobile_device_inventory":                   mobile_device_inventory.DataSourceJamfProMobileDeviceInventory(),
 			"jamfpro_mobile_device_prestage_enrollment":         mobile_device_prestage_enrollment.DataSourceJamfProMobileDevicePrestageEnrollment(),
 			"jamfpro_package":                                   packages.DataSourceJamfProPackages(),
+			"jamfpro_patch_available_titles":                    patch_source.DataSourceJamfProPatchAvailableTitles(),
+			"jamfpro_patch_sources":                             patch_source.DataSourceJamfProPatchSources(),
 			"jamfpro_policy":                                    policy.DataSourceJamfProPolicies(),
 			"jamfpro_printer":                                   printer.DataSourceJamfProPrinters(),
 			"jamfpro_script":                                    script.DataSourceJamfProScripts(),
@@ -473,6 +534,7 @@ func Provider() *schema.Provider {
 			"jamfpro_client_checkin":                              client_checkin.ResourceJamfProClientCheckin(),
 			"jamfpro_cloud_ldap":                                  cloud_ldap.ResourceJamfProCloudLdap(),
 			"jamfpro_computer_extension_attribute":                computer_extension_attribute.ResourceJamfProComputerExtensionAttributes(),
+			"jamfpro_computer_extension_attribute_value":          computer_extension_attribute_value.ResourceJamfProComputerExtensionAttributeValues(),
 			"jamfpro_computer_inventory_collection_settings":      computer_inventory_collection_settings.ResourceJamfProComputerInventoryCollectionSettings(),
 			"jamfpro_computer_prestage_enrollment":                computer_prestage_enrollment.ResourceJamfProComputerPrestageEnrollment(),
 			"jamfpro_department":                                  department.ResourceJamfProDepartments(),
@@ -484,6 +546,8 @@ func Provider() *schema.Provider {
 			"jamfpro_file_share_distribution_point":               file_share_distribution_point.ResourceJamfProFileShareDistributionPoints(),
 			"jamfpro_icon":                                        icon.ResourceJamfProIcons(),
 			"jamfpro_impact_alert_notification_settings":          impact_alert_notification_settings.ResourceImpactAlertNotificationSettings(),
+			"jamfpro_inventory_preload_csv":                       inventory_preload_csv.ResourceJamfProInventoryPreloadCSVs(),
+			"jamfpro_inventory_preload_record":                    inventory_preload_record.ResourceJamfProInventoryPreloadRecords(),
 			"jamfpro_jamf_connect":                                jamf_connect.ResourceJamfConnectConfigProfile(),
 			"jamfpro_jamf_protect":                                jamf_protect.ResourceJamfProtect(),
 			"jamfpro_ldap_server":                                 ldap_server.ResourceJamfProLDAPServers(),
@@ -498,10 +562,15 @@ func Provider() *schema.Provider {
 			"jamfpro_managed_software_update_feature_toggle":      managed_software_update_feature_toggle.ResourceManagedSoftwareUpdateFeatureToggle(),
 			"jamfpro_mobile_device_configuration_profile_plist":   mobile_device_configuration_profile_plist.ResourceJamfProMobileDeviceConfigurationProfilesPlist(),
 			"jamfpro_mobile_device_extension_attribute":           mobile_device_extension_attribute.ResourceJamfProMobileDeviceExtensionAttributes(),
+			"jamfpro_mobile_device_extension_attribute_value":     mobile_device_extension_attribute_value.ResourceJamfProMobileDeviceExtensionAttributeValues(),
 			"jamfpro_mobile_device_prestage_enrollment":           mobile_device_prestage_enrollment.ResourceJamfProMobileDevicePrestageEnrollment(),
 			"jamfpro_package":                                     packages.ResourceJamfProPackages(),
+			"jamfpro_patch_policy":                                patch_policy.ResourceJamfProPatchPolicies(),
+			"jamfpro_patch_software_title_configuration":          patch_software_title_configuration.ResourceJamfProPatchSoftwareTitleConfigurations(),
 			"jamfpro_policy":                                      policy.ResourceJamfProPolicies(),
+			"jamfpro_policy_scope_target":                         policy_scope_target.ResourceJamfProPolicyScopeTarget(),
 			"jamfpro_printer":                                     printer.ResourceJamfProPrinters(),
+			"jamfpro_profile_scope_target":                        profile_scope_target.ResourceJamfProProfileScopeTarget(),
 			"jamfpro_reenrollment":                                reenrollment.ResourceReenrollmentSettings(),
 			"jamfpro_script":                                      script.ResourceJamfProScripts(),
 			"jamfpro_self_service_branding_image":                 self_service_branding_image.ResourceJamfProSelfServiceBrandingImage(),
@@ -517,7 +586,9 @@ func Provider() *schema.Provider {
 			"jamfpro_smart_computer_group":                        smart_computer_group.ResourceJamfProSmartComputerGroups(),
 			"jamfpro_smart_mobile_device_group":                   smart_mobile_device_group.ResourceJamfProSmartMobileGroups(),
 			"jamfpro_static_computer_group":                       static_computer_group.ResourceJamfProStaticComputerGroups(),
+			"jamfpro_static_computer_group_membership":            static_computer_group_membership.ResourceJamfProStaticComputerGroupMembership(),
 			"jamfpro_static_mobile_device_group":                  static_mobile_device_group.ResourceJamfProStaticMobileDeviceGroups(),
+			"jamfpro_static_mobile_device_group_membership":       static_mobile_device_group_membership.ResourceJamfProStaticMobileDeviceGroupMembership(),
 			"jamfpro_restricted_software":                         restricted_software.ResourceJamfProRestrictedSoftwares(),
 			"jamfpro_user_initiated_enrollment_settings":          user_initiated_enrollment_settings.ResourceJamfProUserInitatedEnrollmentSettings(),
 			"jamfpro_user_group":                                  user_group.ResourceJamfProUserGroups(),
@@ -767,10 +838,18 @@ func Provider() *schema.Provider {
 			CustomCookies:            cookiesList,
 			MandatoryRequestDelay:    time.Duration(d.Get("mandatory_request_delay_milliseconds").(int)) * time.Millisecond,
 			RetryEligiableRequests:   false, // Forced because terraform handles concurrency
-			HTTP:                     http.Client{},
+			HTTP: throttledHTTPClient(
+				limiterInstanceKey(authProvider, jamfFQDN, d.Get("platform_base_url").(string), d.Get("platform_tenant_id").(string)),
+				throttle.Config{
+					MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+					RequestsPerSecond:     d.Get("requests_per_second").(float64),
+					HonorRetryAfter:       d.Get("honor_retry_after").(bool),
+					MaxThrottleRetries:    d.Get("max_throttle_retries").(int),
+				},
+			),
 		}
 
-		httpClient, err := config.Build()
+		httpClient, err := buildHTTPClient(config)
 		if err != nil {
 			return nil, append(diags, diag.FromErr(err)...)
 		}
@@ -779,6 +858,16 @@ func Provider() *schema.Provider {
 			HTTP: httpClient,
 		}
 
+		if d.Get("enable_read_cache").(bool) {
+			read_cache.Enable(&jamfProSdk)
+		}
+
+		if dir := d.Get("package_cache_dir").(string); dir != "" {
+			if err := package_cache.Enable(&jamfProSdk, dir); err != nil {
+				return nil, append(diags, diag.FromErr(err)...)
+			}
+		}
+
 		warning, err := CheckJamfProVersion(&jamfProSdk)
 		if err != nil {
 			return nil, append(diags, diag.Diagnostic{
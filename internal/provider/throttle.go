@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/throttle"
+)
+
+// limiterInstanceKey identifies the Jamf Pro instance a client targets, so that the SDKv2 and
+// Framework providers configured against the same instance share a single throttle.Limiter.
+func limiterInstanceKey(authProvider, instanceFQDN, platformBaseURL, platformTenantID string) string {
+	if authProvider == "platform" {
+		return platformBaseURL + "/" + platformTenantID
+	}
+	return instanceFQDN
+}
+
+// throttledHTTPClient builds the http.Client handed to the go-api-http-client, routing every
+// request through the limiter shared for the instance. The client's default timeout is applied
+// by the transport to each attempt once admitted, rather than to the whole round trip.
+func throttledHTTPClient(instanceKey string, config throttle.Config) http.Client {
+	transport := throttle.NewTransport(throttle.SharedLimiter(instanceKey, config), nil)
+	transport.AttemptTimeout = httpclient.DefaultTimeout
+
+	return http.Client{Transport: transport}
+}
+
+// buildHTTPClient builds the go-api-http-client and clears the http.Client timeout Build
+// defaults to, which would otherwise count time spent queued behind the limiter.
+func buildHTTPClient(config httpclient.ClientConfig) (*httpclient.Client, error) {
+	client, err := config.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	client.ModifyHttpTimeout(0)
+	return client, nil
+}
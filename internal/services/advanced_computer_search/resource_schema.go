@@ -16,6 +16,7 @@ func ResourceJamfProAdvancedComputerSearches() *schema.Resource {
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
 		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(70 * time.Second),
 			Read:   schema.DefaultTimeout(70 * time.Second),
@@ -119,7 +120,9 @@ func ResourceJamfProAdvancedComputerSearches() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"site_id":                sharedschemas.GetSharedSchemaSite(),
+			"validate_criteria":      sharedschemas.GetSharedSchemaValidateCriteria(),
+			"allow_unknown_criteria": sharedschemas.GetSharedSchemaAllowUnknownCriteria(),
 		},
 	}
 }
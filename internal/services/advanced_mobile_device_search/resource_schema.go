@@ -4,6 +4,7 @@ package advanced_mobile_device_search
 import (
 	"time"
 
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -15,6 +16,7 @@ func ResourceJamfProAdvancedMobileDeviceSearches() *schema.Resource {
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
 		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(70 * time.Second),
 			Read:   schema.DefaultTimeout(70 * time.Second),
@@ -103,6 +105,8 @@ func ResourceJamfProAdvancedMobileDeviceSearches() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"validate_criteria":      sharedschemas.GetSharedSchemaValidateCriteria(),
+			"allow_unknown_criteria": sharedschemas.GetSharedSchemaAllowUnknownCriteria(),
 		},
 	}
 }
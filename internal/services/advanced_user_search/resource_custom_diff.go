@@ -0,0 +1,13 @@
+package advanced_user_search
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	return smart_criteria.ValidateResourceDiff(diff, meta, smart_criteria.SubjectUser)
+}
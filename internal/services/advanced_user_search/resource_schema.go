@@ -16,6 +16,7 @@ func ResourceJamfProAdvancedUserSearches() *schema.Resource {
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
 		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(70 * time.Second),
 			Read:   schema.DefaultTimeout(70 * time.Second),
@@ -97,7 +98,9 @@ func ResourceJamfProAdvancedUserSearches() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"site_id":                sharedschemas.GetSharedSchemaSite(),
+			"validate_criteria":      sharedschemas.GetSharedSchemaValidateCriteria(),
+			"allow_unknown_criteria": sharedschemas.GetSharedSchemaAllowUnknownCriteria(),
 		},
 	}
 }
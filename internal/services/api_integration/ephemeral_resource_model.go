@@ -0,0 +1,14 @@
+package api_integration
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiIntegrationCredentialsEphemeralModel describes the ephemeral resource data model.
+type apiIntegrationCredentialsEphemeralModel struct {
+	APIIntegrationID types.String   `tfsdk:"api_integration_id"`
+	ClientID         types.String   `tfsdk:"client_id"`
+	ClientSecret     types.String   `tfsdk:"client_secret"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
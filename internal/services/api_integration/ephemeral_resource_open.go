@@ -0,0 +1,57 @@
+package api_integration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const defaultCredentialsOpenTimeout = 30 * time.Second
+
+// Open generates client credentials for the API integration and returns them as ephemeral result data.
+func (e *apiIntegrationCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiIntegrationCredentialsEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openTimeout, diags := data.Timeouts.Open(ctx, defaultCredentialsOpenTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationID := data.APIIntegrationID.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Generating client credentials for Jamf Pro API integration ID: %s", integrationID))
+
+	var credentials *jamfpro.ResourceClientCredentials
+	err := retry.RetryContext(ctx, openTimeout, func() *retry.RetryError {
+		var apiErr error
+		credentials, apiErr = e.client.RefreshClientCredentialsByApiRoleID(integrationID)
+		if apiErr != nil {
+			return crud.ClassifyAPIError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating API Integration Client Credentials",
+			fmt.Sprintf("Failed to generate client credentials for API integration with ID '%s': %v", integrationID, err),
+		)
+		return
+	}
+
+	data.ClientID = types.StringValue(credentials.ClientID)
+	data.ClientSecret = types.StringValue(credentials.ClientSecret)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,84 @@
+package api_integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &apiIntegrationCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiIntegrationCredentialsEphemeralResource{}
+)
+
+// apiIntegrationCredentialsEphemeralResource defines the ephemeral resource implementation.
+type apiIntegrationCredentialsEphemeralResource struct {
+	client *jamfpro.Client
+}
+
+// NewApiIntegrationCredentialsEphemeralResource creates a new instance of the API integration credentials ephemeral resource.
+func NewApiIntegrationCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &apiIntegrationCredentialsEphemeralResource{}
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *apiIntegrationCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_integration_credentials"
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *apiIntegrationCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jamfpro.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *jamfpro.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *apiIntegrationCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates client credentials for a Jamf Pro API integration using the " +
+			"`/api/v1/api-integrations/{id}/client-credentials` endpoint. The credentials are only available " +
+			"during the Terraform run and are never persisted to plan or state, so they can be passed to other " +
+			"providers (e.g. written to a secrets manager) without being stored.\n\n" +
+			"~> **Note:** Jamf Pro issues a new client secret each time this endpoint is called, invalidating " +
+			"the previous secret for the integration. Every plan and apply that opens this ephemeral resource " +
+			"rotates the secret, so consumers must be updated in the same run.",
+		Attributes: map[string]schema.Attribute{
+			"api_integration_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The Jamf Pro ID of the API integration to generate client credentials for.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The client ID of the API integration.",
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The newly generated client secret of the API integration.",
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,11 +29,28 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		d,
 		meta,
 		cleanup,
-		meta.(*jamfpro.Client).GetBuildingByID,
+		read_cache.Getter(
+			meta,
+			"building",
+			listBuildings(meta.(*jamfpro.Client)),
+			func(r *jamfpro.ResourceBuilding) string { return r.ID },
+			meta.(*jamfpro.Client).GetBuildingByID,
+		),
 		updateState,
 	)
 }
 
+// listBuildings returns every building for the read cache prefetch.
+func listBuildings(client *jamfpro.Client) func() ([]jamfpro.ResourceBuilding, error) {
+	return func() ([]jamfpro.ResourceBuilding, error) {
+		response, err := client.GetBuildings(nil)
+		if err != nil {
+			return nil, err
+		}
+		return response.Results, nil
+	}
+}
+
 // readWithCleanup reads a resources and states with cleanup
 func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	return read(ctx, d, meta, true)
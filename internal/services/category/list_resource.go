@@ -0,0 +1,26 @@
+package category
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewCategoryListResource creates the jamfpro_category list resource used by `terraform query`.
+func NewCategoryListResource() list.ListResource {
+	return sdkv2_list.New("_category", ResourceJamfProCategories, listCategoryItems)
+}
+
+// listCategoryItems returns every category as list resource items.
+func listCategoryItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	categories, err := listCategories(client)()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(categories))
+	for _, category := range categories {
+		items = append(items, sdkv2_list.Item{ID: category.Id, Name: category.Name})
+	}
+	return items, nil
+}
@@ -0,0 +1,54 @@
+package category_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCategory_basic(t *testing.T) {
+	name := acctest.RandomName("category")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CheckDestroy("jamfpro_category", func(client *jamfpro.Client, id string) error {
+			_, err := client.GetCategoryByID(id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_category.test", "name", name),
+					resource.TestCheckResourceAttr("jamfpro_category.test", "priority", "5"),
+					resource.TestCheckResourceAttrSet("jamfpro_category.test", "id"),
+				),
+			},
+			{
+				Config: testAccCategoryConfig(name+"-updated", 9),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_category.test", "name", name+"-updated"),
+					resource.TestCheckResourceAttr("jamfpro_category.test", "priority", "9"),
+				),
+			},
+			{
+				ResourceName:      "jamfpro_category.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCategoryConfig(name string, priority int) string {
+	return fmt.Sprintf(`
+resource "jamfpro_category" "test" {
+  name     = %[1]q
+  priority = %[2]d
+}
+`, name, priority)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -46,11 +47,28 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		d,
 		meta,
 		cleanup,
-		meta.(*jamfpro.Client).GetCategoryByID,
+		read_cache.Getter(
+			meta,
+			"category",
+			listCategories(meta.(*jamfpro.Client)),
+			func(r *jamfpro.ResourceCategory) string { return r.Id },
+			meta.(*jamfpro.Client).GetCategoryByID,
+		),
 		updateState,
 	)
 }
 
+// listCategories returns every category for the read cache prefetch.
+func listCategories(client *jamfpro.Client) func() ([]jamfpro.ResourceCategory, error) {
+	return func() ([]jamfpro.ResourceCategory, error) {
+		response, err := client.GetCategories(nil)
+		if err != nil {
+			return nil, err
+		}
+		return response.Results, nil
+	}
+}
+
 // readWithCleanup reads the resource with cleanup enabled
 func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	return read(ctx, d, meta, true)
@@ -4,6 +4,7 @@ package category
 import (
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,8 +22,9 @@ func ResourceJamfProCategories() *schema.Resource {
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
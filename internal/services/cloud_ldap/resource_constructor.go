@@ -22,6 +22,13 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceCloudLdap, error) {
 		FileBytes: d.Get("keystore_file_bytes").(string),
 		FileName:  d.Get("keystore_file_name").(string),
 	}
+	// An imported configuration has no keystore secrets in state, so the keystore Jamf Pro
+	// holds is sent back as read, without a file, to keep it.
+	if keystore.FileBytes == "" && d.Id() != "" {
+		keystore.Type = d.Get("keystore_type").(string)
+		keystore.ExpirationDate = d.Get("keystore_expiration_date").(string)
+		keystore.Subject = d.Get("keystore_subject").(string)
+	}
 
 	server := jamfpro.CloudLdapServer{
 		Enabled:                                  d.Get("server_enabled").(bool),
@@ -0,0 +1,84 @@
+package cloud_ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig(keystoreFileName string) map[string]any {
+	config := map[string]any{
+		"provider_name":       "GOOGLE",
+		"display_name":        "Google LDAP",
+		"server_enabled":      true,
+		"keystore_password":   "secret",
+		"keystore_file_bytes": "a2V5c3RvcmU=",
+		"keystore_file_name":  keystoreFileName,
+		"connection_type":     "LDAPS",
+		"server_url":          "ldap.google.com",
+		"domain_name":         "example.com",
+		"port":                636,
+	}
+	for _, attribute := range []string{
+		"user_mappings_object_class_limitation", "user_mappings_object_classes", "user_mappings_search_base",
+		"user_mappings_search_scope", "user_mappings_id", "user_mappings_username", "user_mappings_real_name",
+		"user_mappings_email_address", "user_mappings_uuid", "group_mappings_object_class_limitation",
+		"group_mappings_object_classes", "group_mappings_search_base", "group_mappings_search_scope",
+		"group_mappings_id", "group_mappings_name", "group_mappings_uuid", "group_membership_mapping",
+	} {
+		config[attribute] = "value"
+	}
+	return config
+}
+
+// importedState returns the state of the configuration after import and refresh, which holds
+// everything the API returns and no keystore secrets.
+func importedState(t *testing.T, r *schema.Resource) *terraform.InstanceState {
+	d := schema.TestResourceDataRaw(t, r.Schema, testConfig("keystore.p12"))
+	d.SetId("1001")
+	require.NoError(t, d.Set("keystore_password", ""))
+	require.NoError(t, d.Set("keystore_file_bytes", ""))
+	require.NoError(t, d.Set("keystore_type", "PKCS12"))
+	return d.State()
+}
+
+func TestImportedKeystoreHasNoDiff(t *testing.T) {
+	r := ResourceJamfProCloudLdap()
+
+	diff, err := r.Diff(context.Background(), importedState(t, r), terraform.NewResourceConfigRaw(testConfig("keystore.p12")), nil)
+	require.NoError(t, err)
+
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "keystore_password")
+		assert.NotContains(t, diff.Attributes, "keystore_file_bytes")
+	}
+}
+
+func TestImportedKeystoreReplacedWithNewFile(t *testing.T) {
+	r := ResourceJamfProCloudLdap()
+
+	diff, err := r.Diff(context.Background(), importedState(t, r), terraform.NewResourceConfigRaw(testConfig("rotated.p12")), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+
+	assert.Contains(t, diff.Attributes, "keystore_password")
+	assert.Contains(t, diff.Attributes, "keystore_file_bytes")
+}
+
+func TestConstructKeepsImportedKeystore(t *testing.T) {
+	r := ResourceJamfProCloudLdap()
+	d := r.Data(importedState(t, r))
+
+	resource, err := construct(d)
+	require.NoError(t, err)
+
+	keystore := resource.Server.Keystore
+	assert.Empty(t, keystore.FileBytes)
+	assert.Empty(t, keystore.Password)
+	assert.Equal(t, "keystore.p12", keystore.FileName)
+	assert.Equal(t, "PKCS12", keystore.Type)
+}
@@ -19,6 +19,9 @@ func ResourceJamfProCloudLdap() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"provider_name": {
 				Type:         schema.TypeString,
@@ -37,15 +40,18 @@ func ResourceJamfProCloudLdap() *schema.Resource {
 				Description: "Whether the cloud LDAP server is enabled",
 			},
 			"keystore_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				Description:      "Password of the keystore file. The API never returns it, so an imported configuration keeps an empty password in state and ignores changes to it until the keystore file name changes.",
+				DiffSuppressFunc: suppressImportedKeystore,
 			},
 			"keystore_file_bytes": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Base64 encoded keystore file",
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				Description:      "Base64 encoded keystore file. The API never returns it, so an imported configuration keeps an empty value in state and ignores changes to it until the keystore file name changes.",
+				DiffSuppressFunc: suppressImportedKeystore,
 			},
 			"keystore_file_name": {
 				Type:        schema.TypeString,
@@ -233,3 +239,9 @@ func ResourceJamfProCloudLdap() *schema.Resource {
 		},
 	}
 }
+
+// suppressImportedKeystore ignores the keystore secrets of an imported configuration, which has
+// none in state as the API never returns them, unless a new keystore file is configured.
+func suppressImportedKeystore(k, old, new string, d *schema.ResourceData) bool {
+	return old == "" && d.Id() != "" && !d.HasChange("keystore_file_name")
+}
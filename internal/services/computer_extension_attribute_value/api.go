@@ -0,0 +1,141 @@
+package computer_extension_attribute_value
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	eavalue "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/extension_attribute_value"
+)
+
+// The SDK updates computer inventory by sending the whole inventory record, so extension attribute
+// values are set through the Jamf Pro API inventory detail endpoint with the types below, which
+// only carry the extension attribute. Computers are looked up by serial number through the
+// Classic API.
+const (
+	uriComputersInventory       = "/api/v1/computers-inventory"
+	uriComputersInventoryDetail = "/api/v1/computers-inventory-detail"
+	uriComputersBySerialNumber  = "/JSSResource/computers/serialnumber"
+)
+
+// extensionAttributeSections are the inventory sections extension attributes are listed in,
+// depending on where they are displayed.
+var extensionAttributeSections = []string{
+	"GENERAL", "HARDWARE", "OPERATING_SYSTEM", "USER_AND_LOCATION", "PURCHASING", "EXTENSION_ATTRIBUTES",
+}
+
+// requestInventoryUpdate is the body of a computer inventory update setting extension attributes.
+type requestInventoryUpdate struct {
+	ExtensionAttributes []requestExtensionAttribute `json:"extensionAttributes"`
+}
+
+type requestExtensionAttribute struct {
+	DefinitionID string   `json:"definitionId"`
+	Values       []string `json:"values"`
+}
+
+// responseClassicComputerGeneral is the general subset of a Classic API computer.
+type responseClassicComputerGeneral struct {
+	General struct {
+		ID int `xml:"id"`
+	} `xml:"general"`
+}
+
+// extensionAttributeValue is the value of an extension attribute on a computer.
+type extensionAttributeValue struct {
+	ComputerID             string
+	SerialNumber           string
+	ExtensionAttributeName string
+	Value                  string
+}
+
+// getComputerIDBySerialNumber returns the ID of the computer with the serial number.
+func getComputerIDBySerialNumber(client *jamfpro.Client, serialNumber string) (string, error) {
+	var out responseClassicComputerGeneral
+	resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/%s/subset/General", uriComputersBySerialNumber, url.PathEscape(serialNumber)), nil, &out)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find computer with serial number %q: %v", serialNumber, err)
+	}
+
+	return strconv.Itoa(out.General.ID), nil
+}
+
+// getExtensionAttributeValue returns the value of the extension attribute on the computer. An
+// extension attribute the computer has no value for has an empty value.
+func getExtensionAttributeValue(client *jamfpro.Client, computerID, extensionAttributeID string) (*extensionAttributeValue, error) {
+	params := url.Values{}
+	for _, section := range extensionAttributeSections {
+		params.Add("section", section)
+	}
+
+	var inventory jamfpro.ResourceComputerInventory
+	resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/%s?%s", uriComputersInventory, url.PathEscape(computerID), params.Encode()), nil, &inventory)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read inventory of computer %s: %v", computerID, err)
+	}
+
+	out := &extensionAttributeValue{ComputerID: computerID, SerialNumber: inventory.Hardware.SerialNumber}
+	for _, attributes := range [][]jamfpro.ComputerInventorySubsetExtensionAttribute{
+		inventory.General.ExtensionAttributes,
+		inventory.Hardware.ExtensionAttributes,
+		inventory.OperatingSystem.ExtensionAttributes,
+		inventory.UserAndLocation.ExtensionAttributes,
+		inventory.Purchasing.ExtensionAttributes,
+		inventory.ExtensionAttributes,
+	} {
+		for _, attribute := range attributes {
+			if attribute.DefinitionId == extensionAttributeID {
+				out.ExtensionAttributeName = attribute.Name
+				out.Value = strings.Join(attribute.Values, ", ")
+				return out, nil
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// setExtensionAttributeValue sets the value of the extension attribute on the computer. An empty
+// value clears it.
+func setExtensionAttributeValue(client *jamfpro.Client, computerID, extensionAttributeID, value string) error {
+	values := []string{}
+	if value != "" {
+		values = append(values, value)
+	}
+	body := requestInventoryUpdate{ExtensionAttributes: []requestExtensionAttribute{
+		{DefinitionID: extensionAttributeID, Values: values},
+	}}
+
+	resp, err := client.HTTP.DoRequest("PATCH", fmt.Sprintf("%s/%s", uriComputersInventoryDetail, url.PathEscape(computerID)), body, nil)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set extension attribute %s of computer %s: %v", extensionAttributeID, computerID, err)
+	}
+
+	return nil
+}
+
+// getDefinition returns the definition of the computer extension attribute.
+func getDefinition(client *jamfpro.Client, extensionAttributeID string) (eavalue.Definition, error) {
+	attribute, err := client.GetComputerExtensionAttributeByID(extensionAttributeID)
+	if err != nil {
+		return eavalue.Definition{}, fmt.Errorf("failed to read computer extension attribute %s: %v", extensionAttributeID, err)
+	}
+
+	return eavalue.Definition{
+		Name:             attribute.Name,
+		InputType:        attribute.InputType,
+		DataType:         attribute.DataType,
+		PopupMenuChoices: attribute.PopupMenuChoices,
+	}, nil
+}
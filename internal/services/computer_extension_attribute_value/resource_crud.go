@@ -0,0 +1,137 @@
+package computer_extension_attribute_value
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	eavalue "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/extension_attribute_value"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for setting the value of the extension attribute on the computer, looking
+// the computer up by serial number when no ID is given.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	computerID := d.Get("computer_id").(string)
+	if computerID == "" {
+		id, err := getComputerIDBySerialNumber(client, d.Get("serial_number").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		computerID = id
+	}
+
+	extensionAttributeID := d.Get("extension_attribute_id").(string)
+	if err := setValidExtensionAttributeValue(client, computerID, extensionAttributeID, d.Get("value").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(eavalue.FormatID(computerID, extensionAttributeID))
+
+	return read(ctx, d, meta)
+}
+
+// read is responsible for reading the value of the extension attribute on the computer. A computer
+// removed from Jamf Pro removes the value from state.
+func read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	computerID, extensionAttributeID, _, err := eavalue.ParseID(d.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	value, err := getExtensionAttributeValue(meta.(*jamfpro.Client), computerID, extensionAttributeID)
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, true)
+	}
+
+	// Serial numbers are looked up ignoring case, so the configured casing is kept rather than
+	// replacing the value with the casing of the inventory.
+	serialNumber := value.SerialNumber
+	if configured := d.Get("serial_number").(string); strings.EqualFold(configured, serialNumber) {
+		serialNumber = configured
+	}
+
+	for key, val := range map[string]any{
+		"computer_id":              value.ComputerID,
+		"serial_number":            serialNumber,
+		"extension_attribute_id":   extensionAttributeID,
+		"extension_attribute_name": value.ExtensionAttributeName,
+		"value":                    value.Value,
+	} {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// update is responsible for setting a changed value of the extension attribute on the computer.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	computerID, extensionAttributeID, _, err := eavalue.ParseID(d.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setValidExtensionAttributeValue(meta.(*jamfpro.Client), computerID, extensionAttributeID, d.Get("value").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return read(ctx, d, meta)
+}
+
+// delete is responsible for clearing the value of the extension attribute on the computer.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	computerID, extensionAttributeID, _, err := eavalue.ParseID(d.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setExtensionAttributeValue(meta.(*jamfpro.Client), computerID, extensionAttributeID, "")
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// setValidExtensionAttributeValue checks the value against the definition of the extension
+// attribute, which the plan could not when the extension attribute was not yet known, and sets it.
+func setValidExtensionAttributeValue(client *jamfpro.Client, computerID, extensionAttributeID, value string) error {
+	definition, err := getDefinition(client, extensionAttributeID)
+	if err != nil {
+		return err
+	}
+	if err := eavalue.Validate(definition, value); err != nil {
+		return err
+	}
+
+	return setExtensionAttributeValue(client, computerID, extensionAttributeID, value)
+}
+
+// importState imports a value by its ID, in the form <computer_id>:<extension_attribute_id>, or
+// by the serial number of the computer, in the form <serial_number>:<extension_attribute_id>.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	computerID, extensionAttributeID, isSerialNumber, err := eavalue.ParseID(d.Id(), true)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected import ID: %v", err)
+	}
+
+	if isSerialNumber {
+		computerID, err = getComputerIDBySerialNumber(meta.(*jamfpro.Client), computerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(eavalue.FormatID(computerID, extensionAttributeID))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,120 @@
+package computer_inventories
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead lists the computers matching the filter, fetching every page of results, and
+// sets a slimmed down view of each in state.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	query := queryParams(d).Encode()
+
+	var resp *jamfpro.ResponseComputerInventoryList
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		// The SDK advances the page parameter as it fetches each page, so every attempt starts
+		// from a fresh query on the first page.
+		var apiErr error
+		resp, apiErr = client.GetComputersInventory(queryParams(d))
+		if apiErr != nil {
+			return crud.ClassifyAPIError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list Jamf Pro Computer Inventories with query '%s': %v", query, err))
+	}
+
+	computers := make([]any, 0, len(resp.Results))
+	for _, computer := range resp.Results {
+		computers = append(computers, flattenComputer(computer))
+	}
+
+	if err := d.Set("computers", computers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(query))))
+
+	return nil
+}
+
+// queryParams builds the inventory query from the data source arguments. The page parameters are
+// set so that every page is fetched, starting from the first.
+func queryParams(d *schema.ResourceData) url.Values {
+	params := url.Values{}
+
+	if filter := d.Get("filter").(string); filter != "" {
+		params.Set("filter", filter)
+	}
+
+	sections := d.Get("sections").([]any)
+	if len(sections) == 0 {
+		params.Add("section", "GENERAL")
+	}
+	for _, section := range sections {
+		params.Add("section", section.(string))
+	}
+
+	for _, sort := range d.Get("sort").([]any) {
+		params.Add("sort", sort.(string))
+	}
+
+	params.Set("page", "0")
+	params.Set("page-size", fmt.Sprint(d.Get("page_size").(int)))
+
+	return params
+}
+
+// flattenComputer returns the state of a computer. Sections which were not requested are empty in
+// the response, so their attributes are left empty.
+func flattenComputer(computer jamfpro.ResourceComputerInventory) map[string]any {
+	applications := make([]any, 0, len(computer.Applications))
+	for _, app := range computer.Applications {
+		applications = append(applications, map[string]any{
+			"name":      app.Name,
+			"version":   app.Version,
+			"bundle_id": app.BundleId,
+		})
+	}
+
+	groupIDs := make([]string, 0, len(computer.GroupMemberships))
+	for _, group := range computer.GroupMemberships {
+		groupIDs = append(groupIDs, group.GroupId)
+	}
+
+	return map[string]any{
+		"id":                computer.ID,
+		"udid":              computer.UDID,
+		"name":              computer.General.Name,
+		"asset_tag":         computer.General.AssetTag,
+		"managed":           computer.General.RemoteManagement.Managed,
+		"supervised":        computer.General.Supervised,
+		"site_id":           computer.General.Site.ID,
+		"last_ip_address":   computer.General.LastIpAddress,
+		"last_contact_time": computer.General.LastContactTime,
+		"report_date":       computer.General.ReportDate,
+		"serial_number":     computer.Hardware.SerialNumber,
+		"model":             computer.Hardware.Model,
+		"model_identifier":  computer.Hardware.ModelIdentifier,
+		"os_name":           computer.OperatingSystem.Name,
+		"os_version":        computer.OperatingSystem.Version,
+		"os_build":          computer.OperatingSystem.Build,
+		"username":          computer.UserAndLocation.Username,
+		"email":             computer.UserAndLocation.Email,
+		"building_id":       computer.UserAndLocation.BuildingId,
+		"department_id":     computer.UserAndLocation.DepartmentId,
+		"applications":      applications,
+		"group_ids":         groupIDs,
+	}
+}
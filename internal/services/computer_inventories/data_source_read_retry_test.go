@@ -0,0 +1,100 @@
+package computer_inventories_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest/fakejamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/computer_inventories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inventoryServer starts a fake Jamf Pro server whose computer inventory holds the named computers,
+// one per page, and returns a client configured against it. fail is called before each page is
+// served and may write an error response instead.
+func inventoryServer(t *testing.T, names []string, fail func(w http.ResponseWriter, page int) bool) *jamfpro.Client {
+	t.Helper()
+
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+	server.HandleFunc("GET /api/v1/computers-inventory", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if fail(w, page) {
+			return
+		}
+
+		results := []any{}
+		if page < len(names) {
+			results = append(results, map[string]any{"id": strconv.Itoa(page + 1), "general": map[string]any{"name": names[page]}})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"totalCount": len(names), "results": results})
+	})
+
+	t.Setenv("JAMFPRO_INSTANCE_FQDN", server.URL)
+	t.Setenv("JAMFPRO_AUTH_METHOD", "oauth2")
+	t.Setenv("JAMFPRO_AUTH_PROVIDER", "direct")
+	t.Setenv("JAMFPRO_CLIENT_ID", server.ClientID)
+	t.Setenv("JAMFPRO_CLIENT_SECRET", server.ClientSecret)
+
+	client, err := acctest.Client()
+	require.NoError(t, err)
+	return client
+}
+
+func TestDataSourceReadRetriesFromFirstPage(t *testing.T) {
+	var pages []int
+	failed := false
+	client := inventoryServer(t, []string{"lab-01", "lab-02", "lab-03"}, func(w http.ResponseWriter, page int) bool {
+		pages = append(pages, page)
+		if page == 2 && !failed {
+			failed = true
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"httpStatus": 409, "errors": []}`))
+			return true
+		}
+		return false
+	})
+
+	resource := computer_inventories.DataSourceJamfProComputerInventories()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"page_size": 1})
+
+	diags := resource.ReadContext(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []int{0, 1, 2, 0, 1, 2}, pages, "the retry starts from the first page")
+	var names []string
+	for _, computer := range d.Get("computers").([]any) {
+		names = append(names, computer.(map[string]any)["name"].(string))
+	}
+	assert.Equal(t, []string{"lab-01", "lab-02", "lab-03"}, names)
+	assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte("page=0&page-size=1&section=GENERAL"))), d.Id(),
+		"the ID is the hash of the query as configured")
+}
+
+func TestDataSourceReadDoesNotRetryClientErrors(t *testing.T) {
+	requests := 0
+	client := inventoryServer(t, nil, func(w http.ResponseWriter, page int) bool {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"httpStatus": 400, "errors": [{"code": "INVALID_RSQL_FILTER", "description": "Invalid RSQL filter"}]}`))
+		return true
+	})
+
+	resource := computer_inventories.DataSourceJamfProComputerInventories()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"filter": "general.name=="})
+
+	diags := resource.ReadContext(context.Background(), d, client)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, 1, requests, "an invalid filter is not retried")
+}
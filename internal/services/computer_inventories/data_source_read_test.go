@@ -0,0 +1,65 @@
+package computer_inventories
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryParams(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceJamfProComputerInventories().Schema, map[string]any{
+		"filter":    `operatingSystem.version=lt="15"`,
+		"sections":  []any{"GENERAL", "HARDWARE"},
+		"sort":      []any{"general.name:asc", "id:desc"},
+		"page_size": 500,
+	})
+
+	params := queryParams(d)
+
+	assert.Equal(t, `operatingSystem.version=lt="15"`, params.Get("filter"))
+	assert.Equal(t, []string{"GENERAL", "HARDWARE"}, params["section"])
+	assert.Equal(t, []string{"general.name:asc", "id:desc"}, params["sort"])
+	assert.Equal(t, "0", params.Get("page"))
+	assert.Equal(t, "500", params.Get("page-size"))
+}
+
+func TestQueryParamsDefaults(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceJamfProComputerInventories().Schema, map[string]any{})
+
+	params := queryParams(d)
+
+	assert.False(t, params.Has("filter"))
+	assert.Equal(t, []string{"GENERAL"}, params["section"])
+	assert.False(t, params.Has("sort"))
+	assert.Equal(t, "100", params.Get("page-size"))
+}
+
+func TestFlattenComputer(t *testing.T) {
+	computer := jamfpro.ResourceComputerInventory{
+		ID:   "7",
+		UDID: "8D1D4AE1-4C4B-5A1C-9D2E-0F3A6B7C8D9E",
+		General: jamfpro.ComputerInventorySubsetGeneral{
+			Name: "lab-01",
+			Site: jamfpro.SharedResourceSiteProAPI{ID: "-1"},
+		},
+		OperatingSystem: jamfpro.ComputerInventorySubsetOperatingSystem{Version: "14.6.1"},
+		Applications: []jamfpro.ComputerInventorySubsetApplication{
+			{Name: "Safari.app", Version: "17.6", BundleId: "com.apple.Safari", Path: "/Applications/Safari.app"},
+		},
+		GroupMemberships: []jamfpro.ComputerInventorySubsetGroupMembership{{GroupId: "3", GroupName: "All Managed"}},
+	}
+
+	flat := flattenComputer(computer)
+
+	assert.Equal(t, "lab-01", flat["name"])
+	assert.Equal(t, "-1", flat["site_id"])
+	assert.Equal(t, "14.6.1", flat["os_version"])
+	assert.Equal(t, "", flat["serial_number"], "sections not requested are left empty")
+	assert.Equal(t, []any{map[string]any{"name": "Safari.app", "version": "17.6", "bundle_id": "com.apple.Safari"}}, flat["applications"])
+	assert.Equal(t, []string{"3"}, flat["group_ids"])
+
+	d := schema.TestResourceDataRaw(t, DataSourceJamfProComputerInventories().Schema, map[string]any{})
+	assert.NoError(t, d.Set("computers", []any{flat}))
+}
@@ -0,0 +1,198 @@
+package computer_inventories
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// inventorySections lists the computer inventory sections which can be requested.
+var inventorySections = []string{
+	"GENERAL", "DISK_ENCRYPTION", "PURCHASING", "APPLICATIONS", "STORAGE",
+	"USER_AND_LOCATION", "CONFIGURATION_PROFILES", "PRINTERS", "SERVICES",
+	"HARDWARE", "LOCAL_USER_ACCOUNTS", "CERTIFICATES", "ATTACHMENTS",
+	"PLUGINS", "PACKAGE_RECEIPTS", "FONTS", "SECURITY", "OPERATING_SYSTEM",
+	"LICENSED_SOFTWARE", "IBEACONS", "SOFTWARE_UPDATES", "EXTENSION_ATTRIBUTES",
+	"CONTENT_CACHING", "GROUP_MEMBERSHIPS",
+}
+
+// DataSourceJamfProComputerInventories provides a filtered list of computers from the Jamf Pro
+// computer inventory.
+func DataSourceJamfProComputerInventories() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Description: "Lists computers from the Jamf Pro computer inventory matching an RSQL filter, following every page " +
+			"of results. Only the requested sections are fetched; attributes of other sections are left empty.",
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "RSQL filter applied to the inventory, e.g. " +
+					"`general.name==\"Lab*\" and operatingSystem.version=lt=\"15\"`. All computers are listed when unset.",
+			},
+			"sections": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inventorySections, false),
+				},
+				Description: "Inventory sections to fetch, e.g. `GENERAL`, `HARDWARE`, `OPERATING_SYSTEM`, " +
+					"`USER_AND_LOCATION`, `APPLICATIONS` or `GROUP_MEMBERSHIPS`. Defaults to `GENERAL`.",
+			},
+			"sort": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sort criteria in the form `<property>:<asc|desc>`, e.g. `general.name:asc`. Jamf Pro's default order is used when unset.",
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 2000),
+				Description:  "The number of computers fetched per request. Every page is fetched regardless.",
+			},
+			"computers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The computers matching the filter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Jamf Pro ID of the computer.",
+						},
+						"udid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UDID of the computer.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the computer. Requires the `GENERAL` section.",
+						},
+						"asset_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The asset tag of the computer. Requires the `GENERAL` section.",
+						},
+						"managed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the computer is managed. Requires the `GENERAL` section.",
+						},
+						"supervised": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the computer is supervised. Requires the `GENERAL` section.",
+						},
+						"site_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the site of the computer. Requires the `GENERAL` section.",
+						},
+						"last_ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The last IP address of the computer. Requires the `GENERAL` section.",
+						},
+						"last_contact_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the computer last checked in. Requires the `GENERAL` section.",
+						},
+						"report_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the computer last submitted inventory. Requires the `GENERAL` section.",
+						},
+						"serial_number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The serial number of the computer. Requires the `HARDWARE` section.",
+						},
+						"model": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The model of the computer. Requires the `HARDWARE` section.",
+						},
+						"model_identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The model identifier of the computer, e.g. `Mac14,2`. Requires the `HARDWARE` section.",
+						},
+						"os_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the operating system. Requires the `OPERATING_SYSTEM` section.",
+						},
+						"os_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the operating system. Requires the `OPERATING_SYSTEM` section.",
+						},
+						"os_build": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The build of the operating system. Requires the `OPERATING_SYSTEM` section.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The username of the assigned user. Requires the `USER_AND_LOCATION` section.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the assigned user. Requires the `USER_AND_LOCATION` section.",
+						},
+						"building_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the building of the computer. Requires the `USER_AND_LOCATION` section.",
+						},
+						"department_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the department of the computer. Requires the `USER_AND_LOCATION` section.",
+						},
+						"applications": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The applications installed on the computer. Requires the `APPLICATIONS` section.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"bundle_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"group_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the computer groups the computer belongs to. Requires the `GROUP_MEMBERSHIPS` section.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,11 +30,28 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		d,
 		meta,
 		cleanup,
-		meta.(*jamfpro.Client).GetDepartmentByID,
+		read_cache.Getter(
+			meta,
+			"department",
+			listDepartments(meta.(*jamfpro.Client)),
+			func(r *jamfpro.ResourceDepartment) string { return r.ID },
+			meta.(*jamfpro.Client).GetDepartmentByID,
+		),
 		updateState,
 	)
 }
 
+// listDepartments returns every department for the read cache prefetch.
+func listDepartments(client *jamfpro.Client) func() ([]jamfpro.ResourceDepartment, error) {
+	return func() ([]jamfpro.ResourceDepartment, error) {
+		response, err := client.GetDepartments(nil)
+		if err != nil {
+			return nil, err
+		}
+		return response.Results, nil
+	}
+}
+
 // readWithCleanup reads the resource with cleanup enabled
 func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	return read(ctx, d, meta, true)
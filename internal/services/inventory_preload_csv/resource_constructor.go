@@ -0,0 +1,29 @@
+package inventory_preload_csv
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+)
+
+// construct parses the CSV of the file or content.
+func construct(file, content string) (*inventory_preload.Sheet, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV file %s: %v", file, err)
+		}
+		content = string(data)
+	}
+
+	sheet, err := inventory_preload.ParseCSV(content)
+	if err != nil {
+		if file != "" {
+			return nil, fmt.Errorf("invalid CSV file %s: %v", file, err)
+		}
+		return nil, fmt.Errorf("invalid csv_content: %v", err)
+	}
+
+	return sheet, nil
+}
@@ -0,0 +1,140 @@
+package inventory_preload_csv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating the Jamf Pro Inventory Preload Records of a CSV.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.SetId(id.UniqueId())
+	return apply(ctx, d, meta)
+}
+
+// read is responsible for reading the values the Jamf Pro Inventory Preload Records hold for a
+// CSV. A CSV file that can no longer be read leaves the state as it is, so that the resource can
+// still be destroyed.
+func read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sheet, err := construct(d.Get("csv_file").(string), d.Get("csv_content").(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Inventory preload records not refreshed",
+			Detail:   err.Error(),
+		}}
+	}
+
+	records, err := inventory_preload.List(meta.(*jamfpro.Client), "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return updateState(d, sheet, records, managedSerialNumbers(d))
+}
+
+// update is responsible for updating the Jamf Pro Inventory Preload Records of a CSV.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return apply(ctx, d, meta)
+}
+
+// delete is responsible for deleting the Jamf Pro Inventory Preload Records of a CSV.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	remaining := map[string]any{}
+	var errs []error
+	for serialNumber, recordID := range d.Get("record_ids").(map[string]any) {
+		if err := inventory_preload.Delete(client, recordID.(string)); err != nil && !strings.Contains(err.Error(), "404") {
+			remaining[serialNumber] = recordID
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		if err := d.Set("record_ids", remaining); err != nil {
+			errs = append(errs, err)
+		}
+		return diag.FromErr(errors.Join(errs...))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// apply creates, updates and deletes the records differing from the CSV. The IDs of the records
+// changed before a failure are kept in the state, so that a failed apply can be resumed.
+func apply(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	sheet, err := construct(d.Get("csv_file").(string), d.Get("csv_content").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	records, err := inventory_preload.List(client, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	managed := managedSerialNumbers(d)
+	changes := sheet.Diff(records, managed)
+
+	var errs []error
+	for i := range changes.Delete {
+		if err := inventory_preload.Delete(client, changes.Delete[i].ID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i := range changes.Update {
+		if err := inventory_preload.Update(client, changes.Update[i].ID, &changes.Update[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i := range changes.Create {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		recordID, err := inventory_preload.Create(client, &changes.Create[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		changes.Create[i].ID = recordID
+		records = append(records, changes.Create[i])
+	}
+
+	if len(errs) > 0 {
+		// The records are listed again so that the state holds what Jamf Pro holds after the
+		// failure.
+		if current, err := inventory_preload.List(client, ""); err == nil {
+			records = current
+		}
+		diags := updateState(d, sheet, records, managed)
+		return append(diags, diag.FromErr(fmt.Errorf("failed to apply the inventory preload CSV: %v", errors.Join(errs...)))...)
+	}
+
+	return read(ctx, d, meta)
+}
+
+// managedSerialNumbers returns the serial numbers of the records in the state.
+func managedSerialNumbers(d *schema.ResourceData) []string {
+	previous, _ := d.GetChange("record_ids")
+
+	var serialNumbers []string
+	for serialNumber := range previous.(map[string]any) {
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+	sort.Strings(serialNumbers)
+
+	return serialNumbers
+}
@@ -0,0 +1,43 @@
+package inventory_preload_csv
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customDiff parses the CSV, so that invalid CSVs fail the plan, and plans an update when the
+// values of the CSV differ from those of the records, or a managed record is no longer in the CSV.
+func customDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if !diff.NewValueKnown("csv_file") || !diff.NewValueKnown("csv_content") {
+		if err := diff.SetNewComputed("rows_sha256"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("record_ids")
+	}
+
+	sheet, err := construct(diff.Get("csv_file").(string), diff.Get("csv_content").(string))
+	if err != nil {
+		return err
+	}
+
+	inSheet := map[string]bool{}
+	for _, row := range sheet.Rows {
+		inSheet[strings.ToUpper(row.SerialNumber)] = true
+	}
+	removed := false
+	for serialNumber := range diff.Get("record_ids").(map[string]any) {
+		removed = removed || !inSheet[strings.ToUpper(serialNumber)]
+	}
+
+	digest := sheet.Digest()
+	if diff.Id() != "" && digest == diff.Get("rows_sha256").(string) && !removed {
+		return nil
+	}
+
+	if err := diff.SetNew("rows_sha256", digest); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("record_ids")
+}
@@ -0,0 +1,69 @@
+package inventory_preload_csv
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProInventoryPreloadCSVs defines the schema and CRUD operations for managing Jamf Pro
+// inventory preload records from a CSV in Terraform.
+func ResourceJamfProInventoryPreloadCSVs() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: customDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Description: "Manages the inventory preload records of the rows of a CSV in the format of Jamf Pro's inventory " +
+			"preload CSV template. Each row is the record of its serial number: rows without a record are created, and " +
+			"records differing from their row are updated, so that only the rows that changed are sent to Jamf Pro. " +
+			"Existing records of serial numbers in the CSV are adopted. Records of rows removed from the CSV are " +
+			"deleted, as are all the records of the CSV when the resource is destroyed.\n\n" +
+			"Headers are matched to the columns of the template ignoring case, spaces and underscores, and any other " +
+			"header is the name of an extension attribute. Only the fields the CSV has a column for are managed; other " +
+			"fields of existing records are left as they are.\n\n" +
+			"The resource has no import: Jamf Pro holds records, not the CSV they came from, and creating the resource " +
+			"already adopts the existing records of the serial numbers of the CSV.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource, generated on create.",
+			},
+			"csv_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"csv_file", "csv_content"},
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The path of the CSV file.",
+			},
+			"csv_content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"csv_file", "csv_content"},
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The content of the CSV, e.g. from `file()` or a heredoc.",
+			},
+			"rows_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The SHA-256 hash of the values of the CSV. Jamf Pro records that no longer hold the values " +
+					"of their row change the hash, so that they are updated on the next apply.",
+			},
+			"record_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Jamf Pro IDs of the records of the CSV, by serial number.",
+			},
+		},
+	}
+}
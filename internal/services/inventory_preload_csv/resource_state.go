@@ -0,0 +1,45 @@
+package inventory_preload_csv
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the values the records hold for the CSV, and the
+// IDs of the records of its serial numbers and of the managed serial numbers.
+func updateState(d *schema.ResourceData, sheet *inventory_preload.Sheet, records []inventory_preload.Record, managed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bySerial := map[string]string{}
+	for _, record := range records {
+		bySerial[strings.ToUpper(record.SerialNumber)] = record.ID
+	}
+
+	recordIDs := map[string]any{}
+	serialNumbers := slices.Clone(managed)
+	for _, row := range sheet.Rows {
+		serialNumbers = append(serialNumbers, row.SerialNumber)
+	}
+	for _, serialNumber := range serialNumbers {
+		if id, ok := bySerial[strings.ToUpper(serialNumber)]; ok {
+			recordIDs[serialNumber] = id
+		}
+	}
+
+	resourceData := map[string]any{
+		"rows_sha256": sheet.ProjectDigest(records),
+		"record_ids":  recordIDs,
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,38 @@
+package inventory_preload_record
+
+import (
+	"sort"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds an inventory preload record from the provided schema data.
+func construct(d *schema.ResourceData) (*inventory_preload.Record, error) {
+	resource := &inventory_preload.Record{}
+	for _, field := range inventory_preload.Fields {
+		*field.Value(resource) = d.Get(field.Attribute).(string)
+	}
+
+	previous, current := d.GetChange("extension_attributes")
+	resource.ExtensionAttributes = constructExtensionAttributes(previous.(map[string]any), current.(map[string]any))
+
+	return resource, nil
+}
+
+// constructExtensionAttributes sets the value of each extension attribute in the current map, and
+// clears those only in the previous map.
+func constructExtensionAttributes(previous, current map[string]any) []inventory_preload.ExtensionAttribute {
+	out := []inventory_preload.ExtensionAttribute{}
+	for name, value := range current {
+		out = append(out, inventory_preload.ExtensionAttribute{Name: name, Value: value.(string)})
+	}
+	for name := range previous {
+		if _, ok := current[name]; !ok {
+			out = append(out, inventory_preload.ExtensionAttribute{Name: name, Value: ""})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,38 @@
+package inventory_preload_record
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstruct(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProInventoryPreloadRecords().Schema, map[string]any{
+		"serial_number":        "C02ABC123",
+		"device_type":          "Computer",
+		"username":             "jane",
+		"bar_code_1":           "0012345",
+		"extension_attributes": map[string]any{"Cost Center": "R&D"},
+	})
+
+	record, err := construct(d)
+	require.NoError(t, err)
+
+	assert.Equal(t, "C02ABC123", record.SerialNumber)
+	assert.Equal(t, "jane", record.Username)
+	assert.Equal(t, "0012345", record.BarCode1)
+	assert.Equal(t, []inventory_preload.ExtensionAttribute{{Name: "Cost Center", Value: "R&D"}}, record.ExtensionAttributes)
+}
+
+func TestConstructExtensionAttributes(t *testing.T) {
+	assert.Equal(t, []inventory_preload.ExtensionAttribute{
+		{Name: "Asset", Value: ""},
+		{Name: "Cost Center", Value: "Sales"},
+	}, constructExtensionAttributes(
+		map[string]any{"Asset": "A-1", "Cost Center": "R&D"},
+		map[string]any{"Cost Center": "Sales"},
+	), "removed extension attributes are cleared")
+}
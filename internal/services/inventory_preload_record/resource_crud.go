@@ -0,0 +1,118 @@
+package inventory_preload_record
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// responseRecordID is the response to creating an inventory preload record.
+type responseRecordID struct {
+	ID string
+}
+
+// create is responsible for creating a new Jamf Pro Inventory Preload Record in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		createRecord(meta.(*jamfpro.Client)),
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Inventory Preload Record from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		func(id string) (*inventory_preload.Record, error) { return inventory_preload.Get(client, id) },
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Inventory Preload Record on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		updateRecord(meta.(*jamfpro.Client)),
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Inventory Preload Record.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		func(id string) error { return inventory_preload.Delete(client, id) },
+	)
+}
+
+// createRecord returns a function creating an inventory preload record.
+func createRecord(client *jamfpro.Client) func(*inventory_preload.Record) (*responseRecordID, error) {
+	return func(record *inventory_preload.Record) (*responseRecordID, error) {
+		id, err := inventory_preload.Create(client, record)
+		if err != nil {
+			return nil, err
+		}
+		return &responseRecordID{ID: id}, nil
+	}
+}
+
+// updateRecord returns a function updating an inventory preload record.
+func updateRecord(client *jamfpro.Client) func(string, *inventory_preload.Record) (*responseRecordID, error) {
+	return func(id string, record *inventory_preload.Record) (*responseRecordID, error) {
+		if err := inventory_preload.Update(client, id, record); err != nil {
+			return nil, err
+		}
+		return &responseRecordID{ID: id}, nil
+	}
+}
+
+var numericID = regexp.MustCompile(`^\d+$`)
+
+// importState imports a record by its ID, or by the serial number of its device.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	imported, err := crud.ImportStatePassthroughWithIdentity()(ctx, d, meta)
+	if err != nil || numericID.MatchString(d.Id()) {
+		return imported, err
+	}
+
+	record, err := inventory_preload.GetBySerialNumber(meta.(*jamfpro.Client), d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, fmt.Errorf("no inventory preload record has serial number %q", d.Id())
+	}
+
+	d.SetId(record.ID)
+	return []*schema.ResourceData{d}, nil
+}
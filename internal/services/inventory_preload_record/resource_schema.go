@@ -0,0 +1,100 @@
+package inventory_preload_record
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// fieldDescriptions describes the attributes of the optional fields of a record.
+var fieldDescriptions = map[string]string{
+	"username":            "The username of the user of the device.",
+	"full_name":           "The full name of the user of the device.",
+	"email_address":       "The email address of the user of the device.",
+	"phone_number":        "The phone number of the user of the device.",
+	"position":            "The position of the user of the device.",
+	"department":          "The name of the department of the device.",
+	"building":            "The name of the building of the device.",
+	"room":                "The room of the device.",
+	"po_number":           "The purchase order number of the device.",
+	"po_date":             "The purchase order date of the device, e.g. `2024-03-01`.",
+	"warranty_expiration": "The date the warranty of the device expires, e.g. `2027-03-01`.",
+	"apple_care_id":       "The AppleCare ID of the device.",
+	"life_expectancy":     "The life expectancy of the device, in years.",
+	"purchase_price":      "The purchase price of the device.",
+	"purchasing_contact":  "The purchasing contact of the device.",
+	"purchasing_account":  "The purchasing account of the device.",
+	"lease_expiration":    "The date the lease of the device expires, e.g. `2027-03-01`.",
+	"bar_code_1":          "The first bar code of the device.",
+	"bar_code_2":          "The second bar code of the device.",
+	"asset_tag":           "The asset tag of the device.",
+	"vendor":              "The vendor of the device.",
+}
+
+// ResourceJamfProInventoryPreloadRecords defines the schema and CRUD operations for managing Jamf
+// Pro inventory preload records in Terraform.
+func ResourceJamfProInventoryPreloadRecords() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Jamf Pro unique identifier (ID) of the inventory preload record.",
+		},
+		"serial_number": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The serial number of the device the record applies to. Each serial number can only have one record.",
+		},
+		"device_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(inventory_preload.DeviceTypes, false),
+			Description:  "The type of the device: `Computer`, `Mobile Device` or `Unknown`.",
+		},
+		"extension_attributes": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Elem:             &schema.Schema{Type: schema.TypeString},
+			ValidateDiagFunc: validation.MapValueLenBetween(1, 255),
+			Description: "The values of extension attributes of the device, by the name of the extension attribute. " +
+				"Extension attributes removed from the map are cleared.",
+		},
+	}
+
+	for _, field := range inventory_preload.Fields {
+		if _, ok := resourceSchema[field.Attribute]; ok {
+			continue
+		}
+		resourceSchema[field.Attribute] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fieldDescriptions[field.Attribute],
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Identity: crud.IDIdentity(),
+		Description: "Manages an inventory preload record: the user, location, purchasing and extension attribute " +
+			"details Jamf Pro applies to a computer or mobile device with the serial number of the record when it " +
+			"enrolls or next submits inventory. Records are imported by ID or by serial number.",
+		Schema: resourceSchema,
+	}
+}
@@ -0,0 +1,35 @@
+package inventory_preload_record
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/inventory_preload"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest inventory preload record information
+// from the Jamf Pro API. Extension attributes without a value are left out, as Jamf Pro returns
+// every extension attribute of the record's device type.
+func updateState(d *schema.ResourceData, resp *inventory_preload.Record) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]any{}
+	for _, field := range inventory_preload.Fields {
+		resourceData[field.Attribute] = *field.Value(resp)
+	}
+
+	extensionAttributes := map[string]any{}
+	for _, attribute := range resp.ExtensionAttributes {
+		if attribute.Value != "" {
+			extensionAttributes[attribute.Name] = attribute.Value
+		}
+	}
+	resourceData["extension_attributes"] = extensionAttributes
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	ResourceIDSingleton = "jamfpro_jamf_protect_singleton"
+)
+
 // create initializes the Jamf Protect integration by creating a new resource.
 func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
@@ -33,7 +37,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		return diag.FromErr(fmt.Errorf("failed to create Jamf Protect integration: %v", err))
 	}
 
-	d.SetId("jamfpro_jamf_protect_singleton")
+	d.SetId(ResourceIDSingleton)
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
@@ -43,7 +47,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	d.SetId("jamfpro_jamf_protect_singleton")
+	d.SetId(ResourceIDSingleton)
 
 	var response *jamfpro.ResponseJamfProtectSettings
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
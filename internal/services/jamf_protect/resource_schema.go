@@ -3,6 +3,7 @@ package jamf_protect
 import (
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,6 +20,9 @@ func ResourceJamfProtect() *schema.Resource {
 			Update: schema.DefaultTimeout(1 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: crud.ImportStateSingleton(ResourceIDSingleton),
+		},
 		Schema: map[string]*schema.Schema{
 			"protect_url": {
 				Type:        schema.TypeString,
@@ -39,7 +43,11 @@ func ResourceJamfProtect() *schema.Resource {
 				Required:    true,
 				Sensitive:   true,
 				ForceNew:    true,
-				Description: "The password for Jamf Protect authentication",
+				Description: "The password for Jamf Protect authentication. The API never returns the password, so an imported integration keeps an empty password in state and ignores changes to it; use `terraform apply -replace` to register a new password.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Imported integrations have no password in state, as the API never returns it.
+					return old == "" && d.Id() != ""
+				},
 			},
 			"auto_install": {
 				Type:        schema.TypeBool,
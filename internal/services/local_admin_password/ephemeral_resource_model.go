@@ -0,0 +1,30 @@
+package local_admin_password
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// localAdminPasswordEphemeralModel describes the ephemeral resource data model.
+type localAdminPasswordEphemeralModel struct {
+	ComputerID   types.String                     `tfsdk:"computer_id"`
+	SerialNumber types.String                     `tfsdk:"serial_number"`
+	Username     types.String                     `tfsdk:"username"`
+	ManagementID types.String                     `tfsdk:"management_id"`
+	Password     types.String                     `tfsdk:"password"`
+	History      []localAdminPasswordHistoryModel `tfsdk:"history"`
+	Timeouts     timeouts.Value                   `tfsdk:"timeouts"`
+}
+
+// localAdminPasswordHistoryModel describes a password in the account's view history.
+type localAdminPasswordHistoryModel struct {
+	DateLastSeen   types.String                   `tfsdk:"date_last_seen"`
+	ExpirationTime types.String                   `tfsdk:"expiration_time"`
+	Audits         []localAdminPasswordAuditModel `tfsdk:"audits"`
+}
+
+// localAdminPasswordAuditModel describes a single view of a password.
+type localAdminPasswordAuditModel struct {
+	ViewedBy types.String `tfsdk:"viewed_by"`
+	DateSeen types.String `tfsdk:"date_seen"`
+}
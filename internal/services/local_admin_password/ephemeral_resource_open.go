@@ -0,0 +1,137 @@
+package local_admin_password
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const defaultPasswordOpenTimeout = 30 * time.Second
+
+// Open resolves the computer's management ID and returns the current LAPS password and its
+// view history as ephemeral result data.
+func (e *localAdminPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data localAdminPasswordEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openTimeout, diags := data.Timeouts.Open(ctx, defaultPasswordOpenTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, openTimeout)
+	defer cancel()
+
+	var computer *jamfpro.ResourceComputerInventory
+	var ident string
+
+	err := withinTimeout(ctx, func() (err error) {
+		if !data.ComputerID.IsNull() {
+			ident = data.ComputerID.ValueString()
+			computer, err = e.client.GetComputerInventoryByID(ident)
+		} else {
+			ident = data.SerialNumber.ValueString()
+			computer, err = e.client.GetComputerInventoryBySerialNumber(ident)
+		}
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Computer Inventory",
+			fmt.Sprintf("Failed to fetch computer inventory for '%s': %v", ident, err),
+		)
+		return
+	}
+
+	managementID := computer.General.ManagementId
+	if managementID == "" {
+		resp.Diagnostics.AddError(
+			"Computer Has No Management ID",
+			fmt.Sprintf("Computer '%s' (ID: %s) has no client management ID. LAPS passwords are only available for computers enrolled with an MDM profile.", ident, computer.ID),
+		)
+		return
+	}
+
+	username := data.Username.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Retrieving LAPS password for account '%s' on computer ID: %s", username, computer.ID))
+
+	var current *jamfpro.ResponseLocalAdminCurrentPassword
+	err = withinTimeout(ctx, func() (err error) {
+		current, err = e.client.GetCurrentLocalAdminPasswordForSpecifiedUsernameByClientManagementID(managementID, username)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Retrieving Local Admin Password",
+			fmt.Sprintf("Failed to retrieve the LAPS password for account '%s' on computer ID '%s': %v", username, computer.ID, err),
+		)
+		return
+	}
+
+	// History is fetched after the password so that it includes the view made by this run.
+	var history *jamfpro.ResponseLocalAdminPasswordHistory
+	err = withinTimeout(ctx, func() (err error) {
+		history, err = e.client.GetLocalAdminPasswordViewedHistoryByClientManagementID(managementID, username)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Retrieving Local Admin Password History",
+			fmt.Sprintf("Failed to retrieve the LAPS password history for account '%s' on computer ID '%s': %v", username, computer.ID, err),
+		)
+		return
+	}
+
+	data.ComputerID = types.StringValue(computer.ID)
+	data.ManagementID = types.StringValue(managementID)
+	data.Password = types.StringValue(current.Password)
+	data.History = flattenHistory(history.Results)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// withinTimeout makes the API call, retrying transient errors, until the deadline of ctx, which
+// bounds all the calls of Open together.
+func withinTimeout(ctx context.Context, call func() error) error {
+	deadline, _ := ctx.Deadline()
+	return retry.RetryContext(ctx, time.Until(deadline), func() *retry.RetryError {
+		if err := call(); err != nil {
+			return crud.ClassifyAPIError(err)
+		}
+		return nil
+	})
+}
+
+// flattenHistory converts the password view history into the ephemeral model, omitting the
+// previous passwords themselves.
+func flattenHistory(items []jamfpro.LocalAdminPasswordHistoryItem) []localAdminPasswordHistoryModel {
+	history := make([]localAdminPasswordHistoryModel, 0, len(items))
+	for _, item := range items {
+		audits := make([]localAdminPasswordAuditModel, 0, len(item.Audits))
+		for _, audit := range item.Audits {
+			audits = append(audits, localAdminPasswordAuditModel{
+				ViewedBy: types.StringValue(audit.ViewedBy),
+				DateSeen: types.StringValue(audit.DateSeen),
+			})
+		}
+
+		history = append(history, localAdminPasswordHistoryModel{
+			DateLastSeen:   types.StringValue(item.DateLastSeen),
+			ExpirationTime: types.StringValue(item.ExpirationTime),
+			Audits:         audits,
+		})
+	}
+	return history
+}
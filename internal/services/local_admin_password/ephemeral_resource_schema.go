@@ -0,0 +1,143 @@
+package local_admin_password
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource                     = &localAdminPasswordEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &localAdminPasswordEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &localAdminPasswordEphemeralResource{}
+)
+
+// localAdminPasswordEphemeralResource defines the ephemeral resource implementation.
+type localAdminPasswordEphemeralResource struct {
+	client *jamfpro.Client
+}
+
+// NewLocalAdminPasswordEphemeralResource creates a new instance of the local admin password ephemeral resource.
+func NewLocalAdminPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &localAdminPasswordEphemeralResource{}
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *localAdminPasswordEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_local_admin_password"
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *localAdminPasswordEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jamfpro.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *jamfpro.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// ConfigValidators requires exactly one way of identifying the computer.
+func (e *localAdminPasswordEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("computer_id"),
+			path.MatchRoot("serial_number"),
+		),
+	}
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *localAdminPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the current LAPS password of a local admin account managed by Jamf Pro, using the " +
+			"`/api/v2/local-admin-password/{clientManagementId}/account/{username}/password` endpoint, along with the " +
+			"password's view history. The computer is identified by its inventory ID or serial number. The password is " +
+			"only available during the Terraform run and is never persisted to plan or state.\n\n" +
+			"~> **Note:** Jamf Pro records every retrieval of the password as a view in the account's audit history, " +
+			"and may rotate the password after it has been viewed depending on the `jamfpro_local_admin_password_settings` " +
+			"configuration. Every plan and apply that opens this ephemeral resource counts as a view.",
+		Attributes: map[string]schema.Attribute{
+			"computer_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The Jamf Pro inventory ID of the computer. Exactly one of `computer_id` or `serial_number` must be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The serial number of the computer. Exactly one of `computer_id` or `serial_number` must be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of the LAPS managed local admin account.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"management_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The client management ID of the computer used to look up the account.",
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The current LAPS password of the account.",
+			},
+			"history": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The passwords the account has had, with the views recorded against each. Previous passwords are not returned.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date_last_seen": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date the password was last viewed.",
+						},
+						"expiration_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date the password expires or expired.",
+						},
+						"audits": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The views recorded against the password.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"viewed_by": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The Jamf Pro user or API client which viewed the password.",
+									},
+									"date_seen": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The date the password was viewed.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
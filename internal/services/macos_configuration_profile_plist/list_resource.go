@@ -0,0 +1,28 @@
+package macos_configuration_profile_plist
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewMacOSConfigurationProfilePlistListResource creates the jamfpro_macos_configuration_profile_plist list resource used by `terraform query`.
+func NewMacOSConfigurationProfilePlistListResource() list.ListResource {
+	return sdkv2_list.New("_macos_configuration_profile_plist", ResourceJamfProMacOSConfigurationProfilesPlist, listProfileItems)
+}
+
+// listProfileItems returns every macOS configuration profile as list resource items.
+func listProfileItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	response, err := client.GetMacOSConfigurationProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(response.Results))
+	for _, profile := range response.Results {
+		items = append(items, sdkv2_list.Item{ID: strconv.Itoa(profile.ID), Name: profile.Name})
+	}
+	return items, nil
+}
@@ -0,0 +1,185 @@
+package macos_configuration_profile_plist_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMacOSConfigurationProfilePlist_basic(t *testing.T) {
+	name := acctest.RandomName("profile")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CheckDestroy("jamfpro_macos_configuration_profile_plist", func(client *jamfpro.Client, id string) error {
+			_, err := client.GetMacOSConfigurationProfileByID(id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacOSConfigurationProfilePlistConfig(name, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_macos_configuration_profile_plist.test", "name", name),
+					resource.TestCheckResourceAttr("jamfpro_macos_configuration_profile_plist.test", "level", "System"),
+					resource.TestCheckResourceAttr("jamfpro_macos_configuration_profile_plist.test", "scope.0.all_computers", "true"),
+					resource.TestCheckResourceAttrSet("jamfpro_macos_configuration_profile_plist.test", "payloads"),
+				),
+			},
+			{
+				Config: testAccMacOSConfigurationProfilePlistConfig(name, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_macos_configuration_profile_plist.test", "name", name),
+					resource.TestCheckResourceAttr("jamfpro_macos_configuration_profile_plist.test", "payload_diff_summary.#", "1"),
+					resource.TestCheckResourceAttr("jamfpro_macos_configuration_profile_plist.test", "payload_diff_summary.0", "PayloadContent[0].idleTime: 300 -> 600"),
+				),
+			},
+			{
+				ResourceName:      "jamfpro_macos_configuration_profile_plist.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// redeploy_on_update is write-only, while payload_validate, payload_source and
+				// payload_diff_summary only exist in Terraform.
+				ImportStateVerifyIgnore: []string{"redeploy_on_update", "payload_validate", "payload_source", "payload_diff_summary", "ignore_external_scope"},
+			},
+		},
+	})
+}
+
+func testAccMacOSConfigurationProfilePlistConfig(name string, idleTime int) string {
+	return fmt.Sprintf(`
+resource "jamfpro_macos_configuration_profile_plist" "test" {
+  name                = %[1]q
+  description         = "Acceptance test profile"
+  level               = "System"
+  distribution_method = "Install Automatically"
+  redeploy_on_update  = "Newly Assigned"
+  user_removable      = false
+  payload_validate    = false
+
+  payloads = <<-EOT
+    <?xml version="1.0" encoding="UTF-8"?>
+    <!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+    <plist version="1.0">
+    <dict>
+      <key>PayloadContent</key>
+      <array>
+        <dict>
+          <key>idleTime</key>
+          <integer>%[2]d</integer>
+          <key>PayloadDisplayName</key>
+          <string>Screensaver</string>
+          <key>PayloadIdentifier</key>
+          <string>com.apple.screensaver.3A1B0E2C-5D7F-4E63-9A54-1C2B3D4E5F60</string>
+          <key>PayloadType</key>
+          <string>com.apple.screensaver</string>
+          <key>PayloadUUID</key>
+          <string>3A1B0E2C-5D7F-4E63-9A54-1C2B3D4E5F60</string>
+          <key>PayloadVersion</key>
+          <integer>1</integer>
+        </dict>
+      </array>
+      <key>PayloadDisplayName</key>
+      <string>%[1]s</string>
+      <key>PayloadIdentifier</key>
+      <string>7C4D2E1F-0A9B-4C8D-8E7F-6A5B4C3D2E1F</string>
+      <key>PayloadType</key>
+      <string>Configuration</string>
+      <key>PayloadUUID</key>
+      <string>7C4D2E1F-0A9B-4C8D-8E7F-6A5B4C3D2E1F</string>
+      <key>PayloadVersion</key>
+      <integer>1</integer>
+    </dict>
+    </plist>
+  EOT
+
+  scope {
+    all_computers = true
+    all_jss_users = false
+  }
+}
+`, name, idleTime)
+}
+
+func TestAccMacOSConfigurationProfilePlist_external(t *testing.T) {
+	name := acctest.RandomName("profile")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CheckDestroy("jamfpro_macos_configuration_profile_plist", func(client *jamfpro.Client, id string) error {
+			_, err := client.GetMacOSConfigurationProfileByID(id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacOSConfigurationProfilePlistExternalConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_macos_configuration_profile_plist.test", "payload_source", "external"),
+					resource.TestMatchResourceAttr("jamfpro_macos_configuration_profile_plist.test", "payloads",
+						regexp.MustCompile(`<key>PayloadIdentifier</key>\s*<string>5E1C7A2B-9D3F-4A60-8B71-C2D3E4F50617</string>`)),
+					resource.TestMatchResourceAttr("jamfpro_macos_configuration_profile_plist.test", "payloads",
+						regexp.MustCompile(`<key>PayloadScope</key>\s*<string>System</string>`)),
+				),
+			},
+		},
+	})
+}
+
+// testAccMacOSConfigurationProfilePlistExternalConfig uses a base64 encoded profile in the shape
+// exported by iMazing, with a reverse-DNS root identifier and no PayloadScope or nested UUID.
+func testAccMacOSConfigurationProfilePlistExternalConfig(name string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_macos_configuration_profile_plist" "test" {
+  name                = %[1]q
+  level               = "System"
+  distribution_method = "Install Automatically"
+  redeploy_on_update  = "Newly Assigned"
+  payload_source      = "external"
+
+  payloads = base64encode(<<-EOT
+    <?xml version="1.0" encoding="UTF-8"?>
+    <!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+    <plist version="1.0">
+    <dict>
+      <key>PayloadContent</key>
+      <array>
+        <dict>
+          <key>idleTime</key>
+          <integer>300</integer>
+          <key>PayloadDisplayName</key>
+          <string>Screensaver</string>
+          <key>PayloadType</key>
+          <string>com.apple.screensaver</string>
+          <key>PayloadVersion</key>
+          <integer>1</integer>
+        </dict>
+      </array>
+      <key>PayloadDisplayName</key>
+      <string>%[1]s</string>
+      <key>PayloadIdentifier</key>
+      <string>com.example.screensaver</string>
+      <key>PayloadOrganization</key>
+      <string>Example</string>
+      <key>PayloadType</key>
+      <string>Configuration</string>
+      <key>PayloadUUID</key>
+      <string>5E1C7A2B-9D3F-4A60-8B71-C2D3E4F50617</string>
+      <key>PayloadVersion</key>
+      <integer>1</integer>
+    </dict>
+    </plist>
+  EOT
+  )
+
+  scope {
+    all_computers = true
+    all_jss_users = false
+  }
+}
+`, name)
+}
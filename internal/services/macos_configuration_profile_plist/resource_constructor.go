@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"html"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
 	helpers "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -69,6 +71,9 @@ func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData, mode
 
 	if _, ok := d.GetOk("scope"); ok {
 		resource.Scope = constructMacOSConfigurationProfileSubsetScope(d)
+		if err := appendComputerGroupsByName(d, &resource.Scope); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := d.GetOk("self_service"); ok {
@@ -81,7 +86,12 @@ func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData, mode
 	}
 
 	if mode != "update" {
-		raw := []byte(d.Get("payloads").(string))
+		payload, err := payloadForResourceData(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to normalize external payload: %v", err)
+		}
+
+		raw := []byte(payload)
 		if compacted, err := helpers.CompactStructuralWhitespace(raw); err == nil {
 			raw = compacted
 		} else {
@@ -108,7 +118,10 @@ func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData, mode
 		}
 
 		// Decode payloads field from Terraform state ready for injection
-		newPayload := d.Get("payloads").(string)
+		newPayload, err := payloadForResourceData(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to normalize external payload: %v", err)
+		}
 		if err := plist.NewDecoder(strings.NewReader(newPayload)).Decode(&newPlist); err != nil {
 			return nil, fmt.Errorf("failed to decode new plist payload from terraform state for update operation: %v", err)
 		}
@@ -153,6 +166,12 @@ func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData, mode
 		}
 	}
 
+	if existingProfile != nil && d.Get("ignore_external_scope").(bool) {
+		if err := mergeExternalScope(d, resource, existingProfile); err != nil {
+			return nil, err
+		}
+	}
+
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro macOS Configuration Profile '%s' to XML: %v", resource.General.Name, err)
@@ -241,6 +260,32 @@ func constructMacOSConfigurationProfileSubsetScope(d *schema.ResourceData) jamfp
 }
 
 // constructLimitations builds the limitations object using MapSetToStructs
+// appendComputerGroupsByName adds the computer groups the scope and its exclusions name, by the IDs
+// the names resolved to when planning.
+func appendComputerGroupsByName(d *schema.ResourceData, scope *jamfpro.MacOSConfigurationProfileSubsetScope) error {
+	targets := []struct {
+		path   string
+		groups *[]jamfpro.MacOSConfigurationProfileSubsetScopeEntity
+	}{
+		{"scope.0.computer_group_names", &scope.ComputerGroups},
+		{"scope.0.exclusions.0.computer_group_names", &scope.Exclusions.ComputerGroups},
+	}
+
+	for _, target := range targets {
+		ids, err := sharedschemas.NameReferenceIDs(d, target.path, name_references.ComputerGroup)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if !slices.ContainsFunc(*target.groups, func(group jamfpro.MacOSConfigurationProfileSubsetScopeEntity) bool { return group.ID == id }) {
+				*target.groups = append(*target.groups, jamfpro.MacOSConfigurationProfileSubsetScopeEntity{ID: id})
+			}
+		}
+	}
+
+	return nil
+}
+
 func constructLimitations(d *schema.ResourceData) jamfpro.MacOSConfigurationProfileSubsetLimitations {
 	limitations := jamfpro.MacOSConfigurationProfileSubsetLimitations{}
 
@@ -3,7 +3,9 @@ package macos_configuration_profile_plist
 import (
 	"context"
 	"fmt"
+	"log"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,6 +13,10 @@ import (
 
 // mainCustomDiffFunc orchestrates all custom diff validations for macOS config profiles.
 func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) error {
+	if err := validateExternalPayload(ctx, diff, i); err != nil {
+		return err
+	}
+
 	if diff.Get("payload_validate").(bool) {
 		if err := validatePayloadIdentifers(ctx, diff, i); err != nil {
 			return err
@@ -25,6 +31,10 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 		}
 	}
 
+	if err := setPayloadDiffSummary(ctx, diff, i); err != nil {
+		return err
+	}
+
 	if err := validateDistributionMethod(ctx, diff, i); err != nil {
 		return err
 	}
@@ -45,6 +55,27 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 		return fmt.Errorf("validating scope directory service user/group names: %w", err)
 	}
 
+	if err := sharedschemas.ResolveCategoryAndSiteNames(diff, i); err != nil {
+		return err
+	}
+
+	if err := sharedschemas.ResolveNameReferences(diff, i, sharedschemas.ScopeGroupNamePaths("computer_group", name_references.ComputerGroup)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateExternalPayload checks that a payload authored outside of Jamf Pro can be normalized.
+func validateExternalPayload(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Get("payload_source").(string) != payloadSourceExternal || !diff.NewValueKnown("payloads") {
+		return nil
+	}
+
+	if _, err := payloadForDiff(diff); err != nil {
+		return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': error normalizing external payload: %v", diff.Get("name").(string), err)
+	}
+
 	return nil
 }
 
@@ -53,10 +84,51 @@ func normalizePayloadState(_ context.Context, diff *schema.ResourceDiff, _ any)
 	return nil
 }
 
+// setPayloadDiffSummary records the key-level changes between the stated and planned payloads in
+// payload_diff_summary so that the plan shows what changed inside the profile. When the payload
+// has not changed the previous summary is kept, as SDKv2 would otherwise plan an unset computed
+// list as unknown.
+func setPayloadDiffSummary(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Id() == "" {
+		return diff.SetNew("payload_diff_summary", []string{})
+	}
+
+	if !diff.NewValueKnown("payloads") {
+		return diff.SetNewComputed("payload_diff_summary")
+	}
+
+	previous, _ := diff.GetChange("payload_diff_summary")
+
+	// HasChange does not apply diff suppression, so equivalent or undecodable payloads are
+	// caught by the empty diff below.
+	if !diff.HasChange("payloads") {
+		return diff.SetNew("payload_diff_summary", previous)
+	}
+
+	oldPayload, _ := diff.GetChange("payloads")
+	newPayload, err := payloadForDiff(diff)
+	var changes []string
+	if err == nil {
+		changes, err = plist.DiffPayloads(oldPayload.(string), newPayload, payloadDiffExcludedFields)
+	}
+	if err != nil {
+		log.Printf("[WARN] setPayloadDiffSummary: could not summarise payload changes: %v", err)
+	}
+
+	if len(changes) == 0 {
+		return diff.SetNew("payload_diff_summary", previous)
+	}
+
+	return diff.SetNew("payload_diff_summary", changes)
+}
+
 // validatePayloadIdentifers performs the payload validation that was previously in the ValidateFunc.
 func validatePayloadIdentifers(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 	resourceName := diff.Get("name").(string)
-	payload := diff.Get("payloads").(string)
+	payload, err := payloadForDiff(diff)
+	if err != nil {
+		return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': error normalizing external payload: %v", resourceName, err)
+	}
 
 	profile, err := plist.UnmarshalPayload(payload)
 	if err != nil {
@@ -101,7 +173,10 @@ func validateDistributionMethod(_ context.Context, diff *schema.ResourceDiff, _
 func validatePlistPayloadScope(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 	resourceName := diff.Get("name").(string)
 	level := diff.Get("level").(string)
-	payloads := diff.Get("payloads").(string)
+	payloads, err := payloadForDiff(diff)
+	if err != nil {
+		return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': error normalizing external payload: %v", resourceName, err)
+	}
 
 	plistData, err := plist.DecodePlist([]byte(payloads))
 	if err != nil {
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,8 +27,9 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Schema: map[string]*schema.Schema{
 
 			"id": {
@@ -53,8 +56,10 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 				Computed:    true,
 				Description: "The universally unique identifier for the profile.",
 			},
-			"site_id":     sharedschemas.GetSharedSchemaSite(),
-			"category_id": sharedschemas.GetSharedSchemaCategory(),
+			"site_id":       sharedschemas.GetSharedSchemaSiteWithName(),
+			"site_name":     sharedschemas.GetSharedSchemaSiteName(),
+			"category_id":   sharedschemas.GetSharedSchemaCategoryWithName(),
+			"category_name": sharedschemas.GetSharedSchemaCategoryName(),
 			"distribution_method": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -112,11 +117,31 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 					"This normalization approach ensures that functionally identical profiles are " +
 					"recognized as equivalent despite superficial formatting differences. " +
 					"\n" +
-					"NOTE - This provider only supports plists generated from Jamf Pro. It does not support " +
-					"importing plists from other sources. If you need to import a plist from an external source," +
-					"(e.g. iMazing, Apple Configurator, etc.) " +
-					"you must first import it into Jamf Pro, then export it from Jamf Pro to generate a compatible plist. " +
-					"This provider cannot diff suppress plists generated from external sources.",
+					"NOTE - By default the payload must be a plist generated by Jamf Pro. Profiles authored elsewhere " +
+					"(e.g. iMazing, Apple Configurator, ProfileCreator) can be used directly by setting `payload_source` " +
+					"to `external`.",
+			},
+			"payload_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      payloadSourceJamfPro,
+				ValidateFunc: validation.StringInSlice([]string{payloadSourceJamfPro, payloadSourceExternal}, false),
+				Description: "Where the `payloads` plist was authored. `jamf_pro` (default) expects a plist exported from Jamf Pro. " +
+					"`external` accepts profiles from other tools such as iMazing, Apple Configurator or ProfileCreator and " +
+					"normalizes them at plan time into the form Jamf Pro stores: signed (CMS) profiles and binary plists, " +
+					"supplied base64 encoded e.g. with `filebase64()`, are unwrapped; the root PayloadIdentifier is set to " +
+					"the root PayloadUUID; payloads missing a PayloadUUID or PayloadIdentifier are given stable, " +
+					"content-derived values; a missing root PayloadDisplayName or PayloadScope is taken from `name` and " +
+					"`level`; and dates are converted to UTC.",
+			},
+			"payload_diff_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Key-level summary of the most recent change to `payloads`, computed at plan time with one " +
+					"entry per changed key path, e.g. `PayloadContent[0].AllowUserOverrides: true -> false`. Payloads " +
+					"are compared after the same normalization used for diff suppression, so formatting-only changes " +
+					"and Jamf Pro-managed identifiers are not listed.",
 			},
 			"payload_validate": {
 				Type:     schema.TypeBool,
@@ -169,8 +194,10 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 				MaxItems:    1,
 				Description: "The scope of the configuration profile.",
 				Required:    true,
-				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+				Elem:        getScopeSchema(),
 			},
+			"ignore_external_scope": sharedschemas.GetSharedSchemaIgnoreExternalScope(),
+			"name_references":       sharedschemas.GetSharedSchemaNameReferences(),
 			"self_service": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -265,3 +292,9 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 		},
 	}
 }
+
+// getScopeSchema returns the shared computer scope schema, with computer groups also accepted by
+// name.
+func getScopeSchema() *schema.Resource {
+	return sharedschemas.WithScopeGroupNames(sharedschemas.GetSharedmacOSComputerSchemaScope(), "computer_group", name_references.ComputerGroup)
+}
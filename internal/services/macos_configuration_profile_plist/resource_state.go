@@ -5,7 +5,9 @@ import (
 	"reflect"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,8 +48,12 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMacOSConfiguratio
 
 	if scopeData, err := setScope(resp); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
-	} else if err := d.Set("scope", []any{scopeData}); err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		sharedschemas.FlattenScopeGroupNames(d, scopeData, "computer_group", name_references.ComputerGroup)
+		sharedschemas.FilterExternalScope(d, scopeData)
+		if err := d.Set("scope", []any{scopeData}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
 	}
 
 	defaultSelfService := jamfpro.MacOSConfigurationProfileSubsetSelfService{}
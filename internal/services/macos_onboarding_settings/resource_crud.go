@@ -12,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	ResourceIDSingleton = "jamfpro_macos_onboarding_settings_singleton"
+)
+
 // create creates a new macOS onboarding settings resource in Jamf Pro.
 func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
@@ -34,7 +38,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		return diag.FromErr(fmt.Errorf("failed to create macOS onboarding settings: %w", err))
 	}
 
-	d.SetId("jamfpro_macos_onboarding_settings_singleton")
+	d.SetId(ResourceIDSingleton)
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
@@ -44,7 +48,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	d.SetId("jamfpro_macos_onboarding_settings_singleton")
+	d.SetId(ResourceIDSingleton)
 
 	var response *jamfpro.ResponseOnboardingSettings
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
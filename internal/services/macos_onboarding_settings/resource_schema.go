@@ -4,6 +4,7 @@ package macos_onboarding_settings
 import (
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -29,6 +30,9 @@ func ResourceJamfProMacOSOnboardingSettings() *schema.Resource {
 			Update: schema.DefaultTimeout(1 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: crud.ImportStateSingleton(ResourceIDSingleton),
+		},
 		Schema: map[string]*schema.Schema{
 			"enabled": {
 				Type:        schema.TypeBool,
@@ -0,0 +1,52 @@
+package managed_software_update
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importState imports a managed software update plan by its UUID. Read leaves 'group' and
+// 'device' untouched, so the target is populated here: the plan's device by default, or the
+// group it was created for when imported as <plan_uuid>:<COMPUTER_GROUP|MOBILE_DEVICE_GROUP>:<group_id>.
+// Jamf Pro does not record the group on the plan, so it can only be supplied in the import ID.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*jamfpro.Client)
+
+	parts := strings.Split(d.Id(), ":")
+	switch len(parts) {
+	case 1:
+		plan, err := client.GetManagedSoftwareUpdatePlanByUUID(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read managed software update plan '%s' for import: %v", parts[0], err)
+		}
+
+		device := []any{map[string]any{
+			"device_id":   plan.Device.DeviceId,
+			"object_type": plan.Device.ObjectType,
+		}}
+		if err := d.Set("device", device); err != nil {
+			return nil, fmt.Errorf("failed to set device: %v", err)
+		}
+	case 3:
+		if parts[1] != "COMPUTER_GROUP" && parts[1] != "MOBILE_DEVICE_GROUP" {
+			return nil, fmt.Errorf("unexpected group object type %q in import ID, expected COMPUTER_GROUP or MOBILE_DEVICE_GROUP", parts[1])
+		}
+
+		group := []any{map[string]any{
+			"group_id":    parts[2],
+			"object_type": parts[1],
+		}}
+		if err := d.Set("group", group); err != nil {
+			return nil, fmt.Errorf("failed to set group: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected import ID %q, expected <plan_uuid> or <plan_uuid>:<COMPUTER_GROUP|MOBILE_DEVICE_GROUP>:<group_id>", d.Id())
+	}
+
+	d.SetId(parts[0])
+	return []*schema.ResourceData{d}, nil
+}
@@ -21,6 +21,9 @@ func ResourceJamfProManagedSoftwareUpdate() *schema.Resource {
 			Update: schema.DefaultTimeout(70 * time.Second),
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
 		Schema: map[string]*schema.Schema{
 			"plan_uuid": {
 				Type:        schema.TypeString,
@@ -0,0 +1,231 @@
+// Package mdm_command provides Terraform actions which send day-2 management commands, such as a
+// blank push or an inventory update, to computers and mobile devices.
+package mdm_command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mdmCommandAction holds the provider configured client shared by the actions in this package.
+type mdmCommandAction struct {
+	client *jamfpro.Client
+}
+
+// Configure adds the provider configured client to the action.
+func (a *mdmCommandAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jamfpro.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *jamfpro.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// computerTargetAttributes returns the attributes selecting the computers an action targets.
+func computerTargetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"computer_ids": schema.SetAttribute{
+			ElementType:         types.Int64Type,
+			Optional:            true,
+			MarkdownDescription: "The Jamf Pro IDs of the computers to target.",
+			Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+		},
+		"computer_group_id": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "The ID of a smart or static computer group whose members are targeted.",
+		},
+	}
+}
+
+// mobileDeviceTargetAttributes returns the attributes selecting the mobile devices an action targets.
+func mobileDeviceTargetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"mobile_device_ids": schema.SetAttribute{
+			ElementType:         types.Int64Type,
+			Optional:            true,
+			MarkdownDescription: "The Jamf Pro IDs of the mobile devices to target.",
+			Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+		},
+		"mobile_device_group_id": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "The ID of a smart or static mobile device group whose members are targeted.",
+		},
+	}
+}
+
+// mergeAttributes returns the union of the attribute maps.
+func mergeAttributes(maps ...map[string]schema.Attribute) map[string]schema.Attribute {
+	out := map[string]schema.Attribute{}
+	for _, m := range maps {
+		for k, v := range m {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// resolveComputers returns the sorted, de-duplicated IDs of the configured computers and of the
+// members of the configured computer group.
+func resolveComputers(ctx context.Context, client *jamfpro.Client, ids types.Set, groupID types.Int64) ([]int, diag.Diagnostics) {
+	out, diags := setIDs(ctx, ids)
+	if diags.HasError() || groupID.IsNull() {
+		return normaliseIDs(out), diags
+	}
+
+	group, err := client.GetComputerGroupByID(strconv.FormatInt(groupID.ValueInt64(), 10))
+	if err != nil {
+		diags.AddError(
+			"Error Reading Computer Group",
+			fmt.Sprintf("Failed to read the members of computer group '%d': %v", groupID.ValueInt64(), err),
+		)
+		return nil, diags
+	}
+	if group.Computers != nil {
+		for _, computer := range *group.Computers {
+			out = append(out, computer.ID)
+		}
+	}
+
+	return normaliseIDs(out), diags
+}
+
+// resolveMobileDevices returns the sorted, de-duplicated IDs of the configured mobile devices and
+// of the members of the configured mobile device group.
+func resolveMobileDevices(ctx context.Context, client *jamfpro.Client, ids types.Set, groupID types.Int64) ([]int, diag.Diagnostics) {
+	out, diags := setIDs(ctx, ids)
+	if diags.HasError() || groupID.IsNull() {
+		return normaliseIDs(out), diags
+	}
+
+	group, err := client.GetMobileDeviceGroupByID(strconv.FormatInt(groupID.ValueInt64(), 10))
+	if err != nil {
+		diags.AddError(
+			"Error Reading Mobile Device Group",
+			fmt.Sprintf("Failed to read the members of mobile device group '%d': %v", groupID.ValueInt64(), err),
+		)
+		return nil, diags
+	}
+	if group.MobileDevices != nil {
+		for _, device := range *group.MobileDevices {
+			out = append(out, device.ID)
+		}
+	}
+
+	return normaliseIDs(out), diags
+}
+
+// setIDs reads a set of Int64 IDs, which may be null.
+func setIDs(ctx context.Context, ids types.Set) ([]int, diag.Diagnostics) {
+	if ids.IsNull() || ids.IsUnknown() {
+		return nil, nil
+	}
+
+	var values []int64
+	diags := ids.ElementsAs(ctx, &values, false)
+
+	out := make([]int, 0, len(values))
+	for _, v := range values {
+		out = append(out, int(v))
+	}
+	return out, diags
+}
+
+// normaliseIDs sorts the IDs and removes duplicates.
+func normaliseIDs(ids []int) []int {
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// joinIDs returns the IDs as a comma separated list.
+func joinIDs(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ",")
+}
+
+// computerInventoryByID returns the general inventory of the computers, keyed by ID, fetched in
+// batches with an RSQL filter.
+func computerInventoryByID(client *jamfpro.Client, ids []int) (map[int]jamfpro.ResourceComputerInventory, error) {
+	out := make(map[int]jamfpro.ResourceComputerInventory, len(ids))
+
+	for batch := range slices.Chunk(ids, commandBatchSize) {
+		params := inventoryQuery(batch)
+		resp, err := client.GetComputersInventory(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the inventory of computers %s: %v", joinIDs(batch), err)
+		}
+		for _, computer := range resp.Results {
+			id, err := strconv.Atoi(computer.ID)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected computer ID %q in inventory", computer.ID)
+			}
+			out[id] = computer
+		}
+	}
+
+	for _, id := range ids {
+		if _, ok := out[id]; !ok {
+			return nil, fmt.Errorf("computer %d was not found in the inventory", id)
+		}
+	}
+
+	return out, nil
+}
+
+// noTargetsWarning reports that an action had nothing to target, e.g. because its group is empty.
+func noTargetsWarning(diags *diag.Diagnostics, actionName string) {
+	diags.AddWarning(
+		"No Devices Targeted",
+		fmt.Sprintf("The %s action resolved to no devices, so no command was sent.", actionName),
+	)
+}
+
+// mobileDeviceUDIDs returns the UDIDs of the mobile devices, in the order of ids.
+func mobileDeviceUDIDs(client *jamfpro.Client, ids []int) ([]string, error) {
+	devices, err := client.GetMobileDevices()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mobile devices: %v", err)
+	}
+
+	udids := make(map[int]string, len(devices.MobileDevices))
+	for _, device := range devices.MobileDevices {
+		udids[device.ID] = device.UDID
+	}
+
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		udid, ok := udids[id]
+		if !ok {
+			return nil, fmt.Errorf("mobile device %d was not found", id)
+		}
+		out = append(out, udid)
+	}
+	return out, nil
+}
+
+// joinQuoted returns the values as a list of Markdown code spans.
+func joinQuoted(values []string) string {
+	return "`" + strings.Join(values, "`, `") + "`"
+}
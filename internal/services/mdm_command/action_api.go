@@ -0,0 +1,106 @@
+package mdm_command
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// The SDK has no support for the endpoints below, so they are called through its HTTP client.
+const (
+	uriRedeployManagementFramework = "/api/v1/jamf-management-framework/redeploy"
+	uriMobileDeviceCommands        = "/JSSResource/mobiledevicecommands/command"
+	uriLogFlush                    = "/JSSResource/logflush"
+)
+
+// commandBatchSize is the number of devices looked up or sent a command per request, which keeps
+// the ID lists in request URLs within length limits.
+const commandBatchSize = 100
+
+// responseRedeployManagementFramework is the response to a management framework redeploy.
+type responseRedeployManagementFramework struct {
+	DeviceID  string `json:"deviceId"`
+	CommandID string `json:"commandUuid"`
+}
+
+// requestLogFlush is the body of a Classic API log flush.
+type requestLogFlush struct {
+	XMLName        xml.Name                `xml:"logflush"`
+	LogType        string                  `xml:"log_type"`
+	LogID          int                     `xml:"log_id"`
+	Interval       string                  `xml:"interval"`
+	Computers      *logFlushComputers      `xml:"computers,omitempty"`
+	ComputerGroups *logFlushComputerGroups `xml:"computer_groups,omitempty"`
+}
+
+type logFlushComputers struct {
+	Computer []logFlushID `xml:"computer"`
+}
+
+type logFlushComputerGroups struct {
+	ComputerGroup []logFlushID `xml:"computer_group"`
+}
+
+type logFlushID struct {
+	ID int `xml:"id"`
+}
+
+// redeployManagementFramework queues a redeploy of the Jamf management framework to a computer.
+func redeployManagementFramework(client *jamfpro.Client, computerID int) (*responseRedeployManagementFramework, error) {
+	endpoint := fmt.Sprintf("%s/%d", uriRedeployManagementFramework, computerID)
+
+	var out responseRedeployManagementFramework
+	resp, err := client.HTTP.DoRequest("POST", endpoint, nil, &out)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeploy the management framework to computer %d: %v", computerID, err)
+	}
+
+	return &out, nil
+}
+
+// sendMobileDeviceCommand sends a Classic API command, such as BlankPush or UpdateInventory, to
+// the mobile devices.
+func sendMobileDeviceCommand(client *jamfpro.Client, command string, ids []int) error {
+	endpoint := fmt.Sprintf("%s/%s/id/%s", uriMobileDeviceCommands, command, joinIDs(ids))
+
+	resp, err := client.HTTP.DoRequest("POST", endpoint, nil, nil)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send %s to mobile devices %s: %v", command, joinIDs(ids), err)
+	}
+
+	return nil
+}
+
+// flushPolicyLogs flushes the logs of a policy for the computers and computer groups, or for
+// every computer when neither is given. The Classic API only accepts a log flush as a DELETE, with
+// the computers and groups in the body. The Jamf Pro API log flushing tasks flush logs by type
+// and age only, so cannot target a policy or computers.
+func flushPolicyLogs(client *jamfpro.Client, req *requestLogFlush) error {
+	resp, err := client.HTTP.DoRequest("DELETE", uriLogFlush, req, nil)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to flush the logs of policy %d: %v", req.LogID, err)
+	}
+
+	return nil
+}
+
+// inventoryQuery returns the query reading the general inventory section of the computers.
+func inventoryQuery(ids []int) url.Values {
+	params := url.Values{}
+	params.Set("filter", fmt.Sprintf("id=in=(%s)", joinIDs(ids)))
+	params.Add("section", "GENERAL")
+	params.Set("page", "0")
+	params.Set("page-size", fmt.Sprint(commandBatchSize))
+	return params
+}
@@ -0,0 +1,48 @@
+package mdm_command_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest/fakejamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mdm_command"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushPolicyLogsRequest(t *testing.T) {
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+
+	var method, path, body string
+	server.HandleFunc("/JSSResource/logflush", func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Setenv("JAMFPRO_INSTANCE_FQDN", server.URL)
+	t.Setenv("JAMFPRO_AUTH_METHOD", "oauth2")
+	t.Setenv("JAMFPRO_AUTH_PROVIDER", "direct")
+	t.Setenv("JAMFPRO_CLIENT_ID", server.ClientID)
+	t.Setenv("JAMFPRO_CLIENT_SECRET", server.ClientSecret)
+	client, err := acctest.Client()
+	require.NoError(t, err)
+
+	flush := mdm_command.BuildLogFlush(mdm_command.FlushPolicyLogsModel{
+		PolicyID:        types.Int64Value(12),
+		Interval:        types.StringNull(),
+		ComputerGroupID: types.Int64Null(),
+	}, []int{1, 2})
+	require.NoError(t, mdm_command.FlushPolicyLogs(client, flush))
+
+	assert.Equal(t, http.MethodDelete, method, "the Classic API only accepts a log flush as a DELETE")
+	assert.Equal(t, "/JSSResource/logflush", path)
+	assert.Contains(t, body, "<log_id>12</log_id>")
+	assert.Contains(t, body, "<computers><computer><id>1</id></computer><computer><id>2</id></computer></computers>")
+}
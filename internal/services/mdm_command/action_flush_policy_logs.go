@@ -0,0 +1,143 @@
+package mdm_command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action                     = &flushPolicyLogsAction{}
+	_ action.ActionWithConfigure        = &flushPolicyLogsAction{}
+	_ action.ActionWithConfigValidators = &flushPolicyLogsAction{}
+)
+
+// defaultFlushInterval flushes every log, so that the policy runs again on its next trigger.
+const defaultFlushInterval = "Zero Days"
+
+// flushIntervals are the log ages accepted by the Classic API log flush.
+var flushIntervals = []string{
+	"Zero Days",
+	"One Day",
+	"One Week",
+	"One Month",
+	"Three Months",
+	"Six Months",
+	"One Year",
+}
+
+// flushPolicyLogsModel describes the action data model.
+type flushPolicyLogsModel struct {
+	PolicyID        types.Int64  `tfsdk:"policy_id"`
+	Interval        types.String `tfsdk:"interval"`
+	ComputerIDs     types.Set    `tfsdk:"computer_ids"`
+	ComputerGroupID types.Int64  `tfsdk:"computer_group_id"`
+}
+
+// flushPolicyLogsAction defines the action implementation.
+type flushPolicyLogsAction struct {
+	mdmCommandAction
+}
+
+// NewFlushPolicyLogsAction creates a new instance of the flush policy logs action.
+func NewFlushPolicyLogsAction() action.Action {
+	return &flushPolicyLogsAction{}
+}
+
+// Metadata returns the action type name.
+func (a *flushPolicyLogsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flush_policy_logs"
+}
+
+// ConfigValidators requires at least one way of selecting computers.
+func (a *flushPolicyLogsAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("computer_ids"),
+			path.MatchRoot("computer_group_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the action.
+func (a *flushPolicyLogsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := computerTargetAttributes()
+	attributes["policy_id"] = schema.Int64Attribute{
+		Required:            true,
+		MarkdownDescription: "The ID of the policy whose logs are flushed.",
+		Validators:          []validator.Int64{int64validator.AtLeast(1)},
+	}
+	attributes["interval"] = schema.StringAttribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf("Only logs older than this are flushed. One of %s. Defaults to `%s`, which "+
+			"flushes every log.", joinQuoted(flushIntervals), defaultFlushInterval),
+		Validators: []validator.String{stringvalidator.OneOf(flushIntervals...)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Flushes the logs of a policy for computers, using the Classic API `DELETE /JSSResource/logflush` " +
+			"endpoint. A policy with a `Once per computer` frequency runs again on the computers whose logs were " +
+			"flushed, e.g. after its script or packages were changed.",
+		Attributes: attributes,
+	}
+}
+
+// Invoke flushes the policy logs of the targeted computers and computer group.
+func (a *flushPolicyLogsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data flushPolicyLogsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computerIDs, diags := setIDs(ctx, data.ComputerIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flush := buildLogFlush(data, normaliseIDs(computerIDs))
+	if err := flushPolicyLogs(a.client, flush); err != nil {
+		resp.Diagnostics.AddError("Error Flushing Policy Logs", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Flushed logs of policy %d older than %s", flush.LogID, flush.Interval),
+	})
+}
+
+// buildLogFlush returns the log flush request of the action. The computer group is passed to Jamf
+// Pro as is, which flushes the logs of its members.
+func buildLogFlush(data flushPolicyLogsModel, computerIDs []int) *requestLogFlush {
+	flush := &requestLogFlush{
+		LogType:  "policy",
+		LogID:    int(data.PolicyID.ValueInt64()),
+		Interval: defaultFlushInterval,
+	}
+	if !data.Interval.IsNull() {
+		flush.Interval = data.Interval.ValueString()
+	}
+	if len(computerIDs) > 0 {
+		flush.Computers = &logFlushComputers{}
+		for _, id := range computerIDs {
+			flush.Computers.Computer = append(flush.Computers.Computer, logFlushID{ID: id})
+		}
+	}
+	if !data.ComputerGroupID.IsNull() {
+		flush.ComputerGroups = &logFlushComputerGroups{
+			ComputerGroup: []logFlushID{{ID: int(data.ComputerGroupID.ValueInt64())}},
+		}
+	}
+	return flush
+}
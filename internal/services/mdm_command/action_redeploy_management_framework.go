@@ -0,0 +1,92 @@
+package mdm_command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action                     = &redeployManagementFrameworkAction{}
+	_ action.ActionWithConfigure        = &redeployManagementFrameworkAction{}
+	_ action.ActionWithConfigValidators = &redeployManagementFrameworkAction{}
+)
+
+// redeployManagementFrameworkModel describes the action data model.
+type redeployManagementFrameworkModel struct {
+	ComputerIDs     types.Set   `tfsdk:"computer_ids"`
+	ComputerGroupID types.Int64 `tfsdk:"computer_group_id"`
+}
+
+// redeployManagementFrameworkAction defines the action implementation.
+type redeployManagementFrameworkAction struct {
+	mdmCommandAction
+}
+
+// NewRedeployManagementFrameworkAction creates a new instance of the redeploy management framework action.
+func NewRedeployManagementFrameworkAction() action.Action {
+	return &redeployManagementFrameworkAction{}
+}
+
+// Metadata returns the action type name.
+func (a *redeployManagementFrameworkAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redeploy_management_framework"
+}
+
+// ConfigValidators requires at least one way of selecting computers.
+func (a *redeployManagementFrameworkAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("computer_ids"),
+			path.MatchRoot("computer_group_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the action.
+func (a *redeployManagementFrameworkAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Redeploys the Jamf management framework to computers, using the " +
+			"`/api/v1/jamf-management-framework/redeploy/{id}` endpoint. This repairs the Jamf binary and its " +
+			"launch daemons, e.g. after a policy change which relies on them.",
+		Attributes: computerTargetAttributes(),
+	}
+}
+
+// Invoke redeploys the management framework to each targeted computer.
+func (a *redeployManagementFrameworkAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data redeployManagementFrameworkModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := resolveComputers(ctx, a.client, data.ComputerIDs, data.ComputerGroupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(ids) == 0 {
+		noTargetsWarning(&resp.Diagnostics, "redeploy_management_framework")
+		return
+	}
+
+	for i, id := range ids {
+		out, err := redeployManagementFramework(a.client, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Redeploying Management Framework", err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Queued management framework redeploy to computer %d (%d of %d), command %s", id, i+1, len(ids), out.CommandID),
+		})
+	}
+}
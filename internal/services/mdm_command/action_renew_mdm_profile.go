@@ -0,0 +1,123 @@
+package mdm_command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action                     = &renewMDMProfileAction{}
+	_ action.ActionWithConfigure        = &renewMDMProfileAction{}
+	_ action.ActionWithConfigValidators = &renewMDMProfileAction{}
+)
+
+// renewMDMProfileModel describes the action data model.
+type renewMDMProfileModel struct {
+	ComputerIDs         types.Set   `tfsdk:"computer_ids"`
+	ComputerGroupID     types.Int64 `tfsdk:"computer_group_id"`
+	MobileDeviceIDs     types.Set   `tfsdk:"mobile_device_ids"`
+	MobileDeviceGroupID types.Int64 `tfsdk:"mobile_device_group_id"`
+}
+
+// renewMDMProfileAction defines the action implementation.
+type renewMDMProfileAction struct {
+	mdmCommandAction
+}
+
+// NewRenewMDMProfileAction creates a new instance of the renew MDM profile action.
+func NewRenewMDMProfileAction() action.Action {
+	return &renewMDMProfileAction{}
+}
+
+// Metadata returns the action type name.
+func (a *renewMDMProfileAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_renew_mdm_profile"
+}
+
+// ConfigValidators requires at least one way of selecting devices.
+func (a *renewMDMProfileAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("computer_ids"),
+			path.MatchRoot("computer_group_id"),
+			path.MatchRoot("mobile_device_ids"),
+			path.MatchRoot("mobile_device_group_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the action.
+func (a *renewMDMProfileAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renews the MDM profile of computers and mobile devices, using the " +
+			"`/api/v1/mdm/renew-profile` endpoint, e.g. after the Jamf Pro built-in CA or push certificate was renewed. " +
+			"Devices are identified to Jamf Pro by their UDID, which is looked up from their ID.",
+		Attributes: mergeAttributes(computerTargetAttributes(), mobileDeviceTargetAttributes()),
+	}
+}
+
+// Invoke renews the MDM profile of the targeted computers and mobile devices.
+func (a *renewMDMProfileAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data renewMDMProfileModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computerIDs, diags := resolveComputers(ctx, a.client, data.ComputerIDs, data.ComputerGroupID)
+	resp.Diagnostics.Append(diags...)
+	mobileDeviceIDs, diags := resolveMobileDevices(ctx, a.client, data.MobileDeviceIDs, data.MobileDeviceGroupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(computerIDs) == 0 && len(mobileDeviceIDs) == 0 {
+		noTargetsWarning(&resp.Diagnostics, "renew_mdm_profile")
+		return
+	}
+
+	var udids []string
+	if len(computerIDs) > 0 {
+		inventory, err := computerInventoryByID(a.client, computerIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Computer Inventory", err.Error())
+			return
+		}
+		for _, id := range computerIDs {
+			udids = append(udids, inventory[id].UDID)
+		}
+	}
+	if len(mobileDeviceIDs) > 0 {
+		mobileUDIDs, err := mobileDeviceUDIDs(a.client, mobileDeviceIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Mobile Devices", err.Error())
+			return
+		}
+		udids = append(udids, mobileUDIDs...)
+	}
+
+	out, err := a.client.SendMDMCommandForMDMProfileRenewal(&jamfpro.ResourceMDMProfileRenewal{UDIDs: udids})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Renewing MDM Profile", err.Error())
+		return
+	}
+	if notProcessed := out.UDIDsNotProcessed.UDIDs; len(notProcessed) > 0 {
+		resp.Diagnostics.AddWarning(
+			"MDM Profile Not Renewed On All Devices",
+			fmt.Sprintf("Jamf Pro did not process the MDM profile renewal of the devices with UDIDs: %v", notProcessed),
+		)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Renewed MDM profile of %d device(s)", len(udids)-len(out.UDIDsNotProcessed.UDIDs)),
+	})
+}
@@ -0,0 +1,128 @@
+package mdm_command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action                     = &sendBlankPushAction{}
+	_ action.ActionWithConfigure        = &sendBlankPushAction{}
+	_ action.ActionWithConfigValidators = &sendBlankPushAction{}
+)
+
+// sendBlankPushModel describes the action data model.
+type sendBlankPushModel struct {
+	ComputerIDs         types.Set   `tfsdk:"computer_ids"`
+	ComputerGroupID     types.Int64 `tfsdk:"computer_group_id"`
+	MobileDeviceIDs     types.Set   `tfsdk:"mobile_device_ids"`
+	MobileDeviceGroupID types.Int64 `tfsdk:"mobile_device_group_id"`
+}
+
+// sendBlankPushAction defines the action implementation.
+type sendBlankPushAction struct {
+	mdmCommandAction
+}
+
+// NewSendBlankPushAction creates a new instance of the send blank push action.
+func NewSendBlankPushAction() action.Action {
+	return &sendBlankPushAction{}
+}
+
+// Metadata returns the action type name.
+func (a *sendBlankPushAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_send_blank_push"
+}
+
+// ConfigValidators requires at least one way of selecting devices.
+func (a *sendBlankPushAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("computer_ids"),
+			path.MatchRoot("computer_group_id"),
+			path.MatchRoot("mobile_device_ids"),
+			path.MatchRoot("mobile_device_group_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the action.
+func (a *sendBlankPushAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a blank push to computers and mobile devices, prompting them to check in with Jamf Pro " +
+			"and pick up pending MDM commands, such as the install of a changed configuration profile. Computers are " +
+			"sent the push with the `/api/v2/mdm/blank-push` endpoint and mobile devices with the Classic API " +
+			"`BlankPush` command.",
+		Attributes: mergeAttributes(computerTargetAttributes(), mobileDeviceTargetAttributes()),
+	}
+}
+
+// Invoke sends a blank push to each targeted computer and mobile device.
+func (a *sendBlankPushAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data sendBlankPushModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computerIDs, diags := resolveComputers(ctx, a.client, data.ComputerIDs, data.ComputerGroupID)
+	resp.Diagnostics.Append(diags...)
+	mobileDeviceIDs, diags := resolveMobileDevices(ctx, a.client, data.MobileDeviceIDs, data.MobileDeviceGroupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(computerIDs) == 0 && len(mobileDeviceIDs) == 0 {
+		noTargetsWarning(&resp.Diagnostics, "send_blank_push")
+		return
+	}
+
+	if len(computerIDs) > 0 {
+		inventory, err := computerInventoryByID(a.client, computerIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Computer Inventory", err.Error())
+			return
+		}
+
+		managementIDs := make([]string, 0, len(computerIDs))
+		for _, id := range computerIDs {
+			managementIDs = append(managementIDs, inventory[id].General.ManagementId)
+		}
+
+		out, err := a.client.SendMDMCommandForBlankPush(managementIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Sending Blank Push", fmt.Sprintf("Failed to send a blank push to computers %s: %v", joinIDs(computerIDs), err))
+			return
+		}
+		if len(out.ErrorUUIDs) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Blank Push Not Sent To All Computers",
+				fmt.Sprintf("Jamf Pro could not send a blank push to the computers with management IDs: %v", out.ErrorUUIDs),
+			)
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Sent blank push to %d computer(s)", len(computerIDs)-len(out.ErrorUUIDs)),
+		})
+	}
+
+	for batch := range slices.Chunk(mobileDeviceIDs, commandBatchSize) {
+		if err := sendMobileDeviceCommand(a.client, "BlankPush", batch); err != nil {
+			resp.Diagnostics.AddError("Error Sending Blank Push", err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Sent blank push to %d mobile device(s)", len(batch)),
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package mdm_command
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionSchemas(t *testing.T) {
+	ctx := context.Background()
+	actions := []func() action.Action{
+		NewFlushPolicyLogsAction,
+		NewRedeployManagementFrameworkAction,
+		NewRenewMDMProfileAction,
+		NewSendBlankPushAction,
+		NewUpdateInventoryAction,
+	}
+
+	for _, newAction := range actions {
+		var resp action.SchemaResponse
+		newAction().Schema(ctx, action.SchemaRequest{}, &resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		diags := resp.Schema.ValidateImplementation(ctx)
+		assert.False(t, diags.HasError(), diags)
+	}
+}
+
+func TestSetIDs(t *testing.T) {
+	ctx := context.Background()
+	ids := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(1)})
+
+	out, diags := setIDs(ctx, ids)
+	require.False(t, diags.HasError(), diags)
+	assert.ElementsMatch(t, []int{1, 3}, out)
+
+	out, diags = setIDs(ctx, types.SetNull(types.Int64Type))
+	require.False(t, diags.HasError(), diags)
+	assert.Empty(t, out)
+}
+
+func TestNormaliseIDs(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 5}, normaliseIDs([]int{5, 1, 2, 5, 1}))
+	assert.Equal(t, "1,2,5", joinIDs([]int{1, 2, 5}))
+}
+
+func TestInventoryQuery(t *testing.T) {
+	params := inventoryQuery([]int{4, 7})
+
+	assert.Equal(t, "id=in=(4,7)", params.Get("filter"))
+	assert.Equal(t, []string{"GENERAL"}, params["section"])
+}
+
+func TestBuildLogFlush(t *testing.T) {
+	data := flushPolicyLogsModel{
+		PolicyID:        types.Int64Value(12),
+		Interval:        types.StringNull(),
+		ComputerGroupID: types.Int64Value(3),
+	}
+
+	body, err := xml.Marshal(buildLogFlush(data, []int{1, 2}))
+	require.NoError(t, err)
+	assert.Equal(t,
+		"<logflush><log_type>policy</log_type><log_id>12</log_id><interval>Zero Days</interval>"+
+			"<computers><computer><id>1</id></computer><computer><id>2</id></computer></computers>"+
+			"<computer_groups><computer_group><id>3</id></computer_group></computer_groups></logflush>",
+		string(body),
+	)
+
+	data.Interval = types.StringValue("One Week")
+	data.ComputerGroupID = types.Int64Null()
+	body, err = xml.Marshal(buildLogFlush(data, []int{1}))
+	require.NoError(t, err)
+	assert.Equal(t,
+		"<logflush><log_type>policy</log_type><log_id>12</log_id><interval>One Week</interval>"+
+			"<computers><computer><id>1</id></computer></computers></logflush>",
+		string(body),
+	)
+}
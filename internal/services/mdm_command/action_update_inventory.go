@@ -0,0 +1,93 @@
+package mdm_command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action                     = &updateInventoryAction{}
+	_ action.ActionWithConfigure        = &updateInventoryAction{}
+	_ action.ActionWithConfigValidators = &updateInventoryAction{}
+)
+
+// updateInventoryModel describes the action data model.
+type updateInventoryModel struct {
+	MobileDeviceIDs     types.Set   `tfsdk:"mobile_device_ids"`
+	MobileDeviceGroupID types.Int64 `tfsdk:"mobile_device_group_id"`
+}
+
+// updateInventoryAction defines the action implementation.
+type updateInventoryAction struct {
+	mdmCommandAction
+}
+
+// NewUpdateInventoryAction creates a new instance of the update inventory action.
+func NewUpdateInventoryAction() action.Action {
+	return &updateInventoryAction{}
+}
+
+// Metadata returns the action type name.
+func (a *updateInventoryAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_update_inventory"
+}
+
+// ConfigValidators requires at least one way of selecting mobile devices.
+func (a *updateInventoryAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("mobile_device_ids"),
+			path.MatchRoot("mobile_device_group_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the action.
+func (a *updateInventoryAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends the `UpdateInventory` MDM command to mobile devices, using the Classic API " +
+			"`/JSSResource/mobiledevicecommands` endpoint, so that their inventory reflects a change made by the apply.\n\n" +
+			"~> **Note:** Jamf Pro has no inventory update command for computers. Computers submit inventory when " +
+			"running a policy with `maintenance.recon` enabled, e.g. one using the recurring check-in trigger.",
+		Attributes: mobileDeviceTargetAttributes(),
+	}
+}
+
+// Invoke sends the inventory update command to the targeted mobile devices.
+func (a *updateInventoryAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data updateInventoryModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := resolveMobileDevices(ctx, a.client, data.MobileDeviceIDs, data.MobileDeviceGroupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(ids) == 0 {
+		noTargetsWarning(&resp.Diagnostics, "update_inventory")
+		return
+	}
+
+	for batch := range slices.Chunk(ids, commandBatchSize) {
+		if err := sendMobileDeviceCommand(a.client, "UpdateInventory", batch); err != nil {
+			resp.Diagnostics.AddError("Error Sending Inventory Update", err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Sent inventory update to %d mobile device(s)", len(batch)),
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package mdm_command
+
+// Exported for the tests of the mdm_command_test package, which configure a client against the
+// fake Jamf Pro server through the provider.
+var (
+	FlushPolicyLogs = flushPolicyLogs
+	BuildLogFlush   = buildLogFlush
+)
+
+type FlushPolicyLogsModel = flushPolicyLogsModel
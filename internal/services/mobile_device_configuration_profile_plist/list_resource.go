@@ -0,0 +1,28 @@
+package mobile_device_configuration_profile_plist
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewMobileDeviceConfigurationProfilePlistListResource creates the jamfpro_mobile_device_configuration_profile_plist list resource used by `terraform query`.
+func NewMobileDeviceConfigurationProfilePlistListResource() list.ListResource {
+	return sdkv2_list.New("_mobile_device_configuration_profile_plist", ResourceJamfProMobileDeviceConfigurationProfilesPlist, listProfileItems)
+}
+
+// listProfileItems returns every mobile device configuration profile as list resource items.
+func listProfileItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	response, err := client.GetMobileDeviceConfigurationProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(response.ConfigurationProfiles))
+	for _, profile := range response.ConfigurationProfiles {
+		items = append(items, sdkv2_list.Item{ID: strconv.Itoa(profile.ID), Name: profile.Name})
+	}
+	return items, nil
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"html"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
 	helpers "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -58,13 +60,21 @@ func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceDat
 	if _, ok := d.GetOk("scope"); ok {
 		// Pass the ResourceData object directly instead of extracting the scope data
 		resource.Scope = constructMobileDeviceConfigurationProfileSubsetScope(d)
+		if err := appendMobileDeviceGroupsByName(d, &resource.Scope); err != nil {
+			return nil, err
+		}
 	} else {
 		log.Printf("[DEBUG] constructJamfProMobileDeviceConfigurationProfilePlist: No scope block found or it's empty.")
 	}
 
 	// Handle Payloads based on mode
 	if mode != "update" {
-		raw := []byte(d.Get("payloads").(string))
+		payload, err := payloadForResourceData(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to normalize external payload: %v", err)
+		}
+
+		raw := []byte(payload)
 		if compacted, err := helpers.CompactStructuralWhitespace(raw); err == nil {
 			raw = compacted
 		} else {
@@ -89,7 +99,10 @@ func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceDat
 			return nil, fmt.Errorf("failed to decode existing plist payload from Jamf Pro for update (ID: %s): %v\nPayload attempted:\n%s", resourceID, err, existingPayload)
 		}
 
-		newPayload := d.Get("payloads").(string)
+		newPayload, err := payloadForResourceData(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to normalize external payload: %v", err)
+		}
 		if err := plist.NewDecoder(strings.NewReader(newPayload)).Decode(&newPlist); err != nil {
 			return nil, fmt.Errorf("failed to decode new plist payload from Terraform state for update: %v", err)
 		}
@@ -132,6 +145,12 @@ func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceDat
 		}
 	}
 
+	if existingProfile != nil && d.Get("ignore_external_scope").(bool) {
+		if err := mergeExternalScope(d, resource, existingProfile); err != nil {
+			return nil, err
+		}
+	}
+
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Configuration Profile '%s' to XML: %v", resource.General.Name, err)
@@ -219,6 +238,32 @@ func constructMobileDeviceConfigurationProfileSubsetScope(d *schema.ResourceData
 }
 
 // Refactor constructLimitations to use MapSetToStructs
+// appendMobileDeviceGroupsByName adds the mobile device groups the scope and its exclusions name,
+// by the IDs the names resolved to when planning.
+func appendMobileDeviceGroupsByName(d *schema.ResourceData, scope *jamfpro.MobileDeviceConfigurationProfileSubsetScope) error {
+	targets := []struct {
+		path   string
+		groups *[]jamfpro.MobileDeviceConfigurationProfileSubsetScopeEntity
+	}{
+		{"scope.0.mobile_device_group_names", &scope.MobileDeviceGroups},
+		{"scope.0.exclusions.0.mobile_device_group_names", &scope.Exclusions.MobileDeviceGroups},
+	}
+
+	for _, target := range targets {
+		ids, err := sharedschemas.NameReferenceIDs(d, target.path, name_references.MobileDeviceGroup)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if !slices.ContainsFunc(*target.groups, func(group jamfpro.MobileDeviceConfigurationProfileSubsetScopeEntity) bool { return group.ID == id }) {
+				*target.groups = append(*target.groups, jamfpro.MobileDeviceConfigurationProfileSubsetScopeEntity{ID: id})
+			}
+		}
+	}
+
+	return nil
+}
+
 func constructLimitations(d *schema.ResourceData) jamfpro.MobileDeviceConfigurationProfileSubsetLimitation {
 	limitations := jamfpro.MobileDeviceConfigurationProfileSubsetLimitation{}
 
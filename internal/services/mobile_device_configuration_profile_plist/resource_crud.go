@@ -7,6 +7,8 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/scope_locks"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -60,7 +62,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 	var response *jamfpro.ResourceMobileDeviceConfigurationProfile
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
-		response, apiErr = client.GetMobileDeviceConfigurationProfileByID(resourceID)
+		response, apiErr = read_cache.Memoized(meta, "mobile_device_configuration_profile", client.GetMobileDeviceConfigurationProfileByID)(resourceID)
 		if apiErr != nil {
 			return retry.RetryableError(apiErr)
 		}
@@ -71,7 +73,12 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		return append(diags, errors.HandleResourceNotFoundError(err, d, cleanup)...)
 	}
 
-	return append(diags, updateState(d, response)...)
+	diags = append(diags, updateState(d, response)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, crud.SetIDIdentity(d)...)
 }
 
 // readWithCleanup reads the resource with cleanup enabled
@@ -90,6 +97,9 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
+	// Held across the read and write of the profile, so scope targets added meanwhile are kept.
+	defer scope_locks.MobileDeviceConfigurationProfile(resourceID)()
+
 	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(d, "update", meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Mobile Device Configuration Profile for update: %v", err))
@@ -102,6 +112,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		}
 		return nil
 	})
+	read_cache.Invalidate(meta, resourceID)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Mobile Device Configuration Profile '%s' (ID: %s) after retries: %v", resource.General.Name, resourceID, err))
@@ -0,0 +1,29 @@
+package mobile_device_configuration_profile_plist
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mergeExternalScope adds the scope entries of the existing profile which were added outside of
+// this resource, e.g. by jamfpro_profile_scope_target, to the profile about to be updated, so the
+// update keeps them. Used when ignore_external_scope is set.
+func mergeExternalScope(d *schema.ResourceData, resource, existing *jamfpro.ResourceMobileDeviceConfigurationProfile) error {
+	priorData, err := sharedschemas.PriorScopeData(d, getScopeSchema())
+	if err != nil {
+		return err
+	}
+
+	var previous jamfpro.MobileDeviceConfigurationProfileSubsetScope
+	if len(priorData.Get("scope").([]any)) > 0 {
+		previous = constructMobileDeviceConfigurationProfileSubsetScope(priorData)
+		if err := appendMobileDeviceGroupsByName(priorData, &previous); err != nil {
+			return err
+		}
+	}
+
+	sharedschemas.MergeExternalScope(&resource.Scope, &existing.Scope, &previous)
+
+	return nil
+}
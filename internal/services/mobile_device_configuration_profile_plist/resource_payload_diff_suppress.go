@@ -3,16 +3,29 @@ package mobile_device_configuration_profile_plist
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/crypto"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// payloadDiffExcludedFields are the Jamf Pro-managed plist keys ignored when comparing payloads.
+var payloadDiffExcludedFields = []string{"PayloadUUID", "PayloadIdentifier", "PayloadOrganization", "PayloadDisplayName"}
+
 // DiffSuppressPayloads is a custom diff suppression function for the payloads attribute.
 func DiffSuppressPayloads(k, old, new string, d *schema.ResourceData) bool {
 	fmt.Printf("[DIFFSUPPRESS] Checking diff for key: %s\n", k)
 
+	if source, _ := d.Get("payload_source").(string); source == payloadSourceExternal {
+		normalized, err := normalizedPayload(new, source, d.Get("name").(string), d.Get("level").(string))
+		if err != nil {
+			log.Printf("[DEBUG] Error normalizing external payload for %s: %v", k, err)
+			return false
+		}
+		new = normalized
+	}
+
 	processedOldPayload, err := processPayload(old, "Terraform state payload")
 	if err != nil {
 		fmt.Printf("[DIFFSUPPRESS] Error processing old payload (Terraform state): %v\n", err)
@@ -37,8 +50,7 @@ func DiffSuppressPayloads(k, old, new string, d *schema.ResourceData) bool {
 // processPayload processes the payload by comparing the old and new payloads. It removes specified fields and compares the hashes.
 func processPayload(payload string, source string) (string, error) {
 	fmt.Printf("Processing %s: %s", source, payload)
-	fieldsToRemove := []string{"PayloadUUID", "PayloadIdentifier", "PayloadOrganization", "PayloadDisplayName"}
-	processedPayload, err := plist.ProcessConfigurationProfileForDiffSuppression(payload, fieldsToRemove)
+	processedPayload, err := plist.ProcessConfigurationProfileForDiffSuppression(payload, payloadDiffExcludedFields)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,47 @@
+package mobile_device_configuration_profile_plist
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Values of the payload_source attribute.
+const (
+	payloadSourceJamfPro  = "jamf_pro"
+	payloadSourceExternal = "external"
+)
+
+// normalizedPayload returns the payload as it is sent to Jamf Pro. Payloads authored outside of
+// Jamf Pro are normalized into the form Jamf Pro stores; others are returned unchanged.
+func normalizedPayload(payload, source, name, level string) (string, error) {
+	if source != payloadSourceExternal {
+		return payload, nil
+	}
+
+	return plist.NormalizeExternalPayload(payload, plist.ExternalPayloadDefaults{
+		PayloadDisplayName: name,
+		PayloadScope:       payloadScopeForLevel(level),
+	})
+}
+
+// payloadForDiff returns the planned payload as it will be sent to Jamf Pro.
+func payloadForDiff(diff *schema.ResourceDiff) (string, error) {
+	return normalizedPayload(diff.Get("payloads").(string), diff.Get("payload_source").(string), diff.Get("name").(string), diff.Get("level").(string))
+}
+
+// payloadForResourceData returns the configured payload as it will be sent to Jamf Pro.
+func payloadForResourceData(d *schema.ResourceData) (string, error) {
+	return normalizedPayload(d.Get("payloads").(string), d.Get("payload_source").(string), d.Get("name").(string), d.Get("level").(string))
+}
+
+// payloadScopeForLevel returns the PayloadScope matching a profile level.
+func payloadScopeForLevel(level string) string {
+	switch level {
+	case "Device Level":
+		return "System"
+	case "User Level":
+		return "User"
+	default:
+		return ""
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // resourceJamfProMobileDeviceConfigurationProfilesPlist defines the schema for mobile device configuration profiles in Terraform.
@@ -25,8 +28,9 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -60,8 +64,10 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 					return warns, errs
 				},
 			},
-			"site_id":     sharedschemas.GetSharedSchemaSite(),
-			"category_id": sharedschemas.GetSharedSchemaCategory(),
+			"site_id":       sharedschemas.GetSharedSchemaSiteWithName(),
+			"site_name":     sharedschemas.GetSharedSchemaSiteName(),
+			"category_id":   sharedschemas.GetSharedSchemaCategoryWithName(),
+			"category_name": sharedschemas.GetSharedSchemaCategoryName(),
 			"uuid": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -142,11 +148,31 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 					"This normalization approach ensures that functionally identical profiles are " +
 					"recognized as equivalent despite superficial formatting differences. " +
 					"\n" +
-					"NOTE - This provider only supports plists generated from Jamf Pro. It does not support " +
-					"importing plists from other sources. If you need to import a plist from an external source," +
-					"(e.g. iMazing, Apple Configurator, etc.) " +
-					"you must first import it into Jamf Pro, then export it from Jamf Pro to generate a compatible plist. " +
-					"This provider cannot diff suppress plists generated from external sources.",
+					"NOTE - By default the payload must be a plist generated by Jamf Pro. Profiles authored elsewhere " +
+					"(e.g. iMazing, Apple Configurator, ProfileCreator) can be used directly by setting `payload_source` " +
+					"to `external`.",
+			},
+			"payload_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      payloadSourceJamfPro,
+				ValidateFunc: validation.StringInSlice([]string{payloadSourceJamfPro, payloadSourceExternal}, false),
+				Description: "Where the `payloads` plist was authored. `jamf_pro` (default) expects a plist exported from Jamf Pro. " +
+					"`external` accepts profiles from other tools such as iMazing, Apple Configurator or ProfileCreator and " +
+					"normalizes them at plan time into the form Jamf Pro stores: signed (CMS) profiles and binary plists, " +
+					"supplied base64 encoded e.g. with `filebase64()`, are unwrapped; the root PayloadIdentifier is set to " +
+					"the root PayloadUUID; payloads missing a PayloadUUID or PayloadIdentifier are given stable, " +
+					"content-derived values; a missing root PayloadDisplayName or PayloadScope is taken from `name` and " +
+					"`level`; and dates are converted to UTC.",
+			},
+			"payload_diff_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Key-level summary of the most recent change to `payloads`, computed at plan time with one " +
+					"entry per changed key path, e.g. `PayloadContent[0].AllowUserOverrides: true -> false`. Payloads " +
+					"are compared after the same normalization used for diff suppression, so formatting-only changes " +
+					"and Jamf Pro-managed identifiers are not listed.",
 			},
 			"payload_validate": {
 				Type:     schema.TypeBool,
@@ -169,8 +195,16 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 				MaxItems:    1,
 				Description: "The scope of the configuration profile.",
 				Required:    true,
-				Elem:        sharedschemas.GetSharedMobileDeviceSchemaScope(),
+				Elem:        getScopeSchema(),
 			},
+			"name_references":       sharedschemas.GetSharedSchemaNameReferences(),
+			"ignore_external_scope": sharedschemas.GetSharedSchemaIgnoreExternalScope(),
 		},
 	}
 }
+
+// getScopeSchema returns the shared mobile device scope schema, with mobile device groups also
+// accepted by name.
+func getScopeSchema() *schema.Resource {
+	return sharedschemas.WithScopeGroupNames(sharedschemas.GetSharedMobileDeviceSchemaScope(), "mobile_device_group", name_references.MobileDeviceGroup)
+}
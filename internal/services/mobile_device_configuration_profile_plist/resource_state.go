@@ -3,7 +3,9 @@ package mobile_device_configuration_profile_plist
 
 import (
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/plist"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,8 +45,12 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceConfi
 
 	if scopeData, err := setScope(resp); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
-	} else if err := d.Set("scope", []any{scopeData}); err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		sharedschemas.FlattenScopeGroupNames(d, scopeData, "mobile_device_group", name_references.MobileDeviceGroup)
+		sharedschemas.FilterExternalScope(d, scopeData)
+		if err := d.Set("scope", []any{scopeData}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
 	}
 
 	for k, v := range resourceData {
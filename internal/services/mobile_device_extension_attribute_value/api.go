@@ -0,0 +1,130 @@
+package mobile_device_extension_attribute_value
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	eavalue "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/extension_attribute_value"
+)
+
+// The SDK has no support for the Jamf Pro API mobile device endpoints, so extension attribute
+// values are read and set through them with the types below. Mobile devices are looked up by
+// serial number through the Classic API.
+const (
+	uriMobileDevices               = "/api/v2/mobile-devices"
+	uriMobileDevicesBySerialNumber = "/JSSResource/mobiledevices/serialnumber"
+)
+
+// requestMobileDeviceUpdate is the body of a mobile device update setting extension attributes.
+type requestMobileDeviceUpdate struct {
+	UpdatedExtensionAttributes []mobileDeviceExtensionAttribute `json:"updatedExtensionAttributes"`
+}
+
+// mobileDeviceExtensionAttribute is the value of an extension attribute on a mobile device.
+type mobileDeviceExtensionAttribute struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Type  string   `json:"type"`
+	Value []string `json:"value"`
+}
+
+// responseMobileDeviceDetail is the part of the details of a mobile device holding its extension
+// attributes.
+type responseMobileDeviceDetail struct {
+	ID                  string                           `json:"id"`
+	SerialNumber        string                           `json:"serialNumber"`
+	ExtensionAttributes []mobileDeviceExtensionAttribute `json:"extensionAttributes"`
+}
+
+// responseClassicMobileDeviceGeneral is the general subset of a Classic API mobile device.
+type responseClassicMobileDeviceGeneral struct {
+	General struct {
+		ID int `xml:"id"`
+	} `xml:"general"`
+}
+
+// extensionAttributeValue is the value of an extension attribute on a mobile device.
+type extensionAttributeValue struct {
+	MobileDeviceID         string
+	SerialNumber           string
+	ExtensionAttributeName string
+	Value                  string
+}
+
+// getMobileDeviceIDBySerialNumber returns the ID of the mobile device with the serial number.
+func getMobileDeviceIDBySerialNumber(client *jamfpro.Client, serialNumber string) (string, error) {
+	var out responseClassicMobileDeviceGeneral
+	resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/%s/subset/General", uriMobileDevicesBySerialNumber, url.PathEscape(serialNumber)), nil, &out)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find mobile device with serial number %q: %v", serialNumber, err)
+	}
+
+	return strconv.Itoa(out.General.ID), nil
+}
+
+// getExtensionAttributeValue returns the value of the extension attribute on the mobile device. An
+// extension attribute the mobile device has no value for has an empty value.
+func getExtensionAttributeValue(client *jamfpro.Client, mobileDeviceID, extensionAttributeID string) (*extensionAttributeValue, error) {
+	var device responseMobileDeviceDetail
+	resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/%s/detail", uriMobileDevices, url.PathEscape(mobileDeviceID)), nil, &device)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mobile device %s: %v", mobileDeviceID, err)
+	}
+
+	out := &extensionAttributeValue{MobileDeviceID: mobileDeviceID, SerialNumber: device.SerialNumber}
+	for _, attribute := range device.ExtensionAttributes {
+		if attribute.ID == extensionAttributeID {
+			out.ExtensionAttributeName = attribute.Name
+			out.Value = strings.Join(attribute.Value, ", ")
+			break
+		}
+	}
+
+	return out, nil
+}
+
+// setExtensionAttributeValue sets the value of the extension attribute on the mobile device. An
+// empty value clears it.
+func setExtensionAttributeValue(client *jamfpro.Client, mobileDeviceID, extensionAttributeID string, definition eavalue.Definition, value string) error {
+	values := []string{}
+	if value != "" {
+		values = append(values, value)
+	}
+	body := requestMobileDeviceUpdate{UpdatedExtensionAttributes: []mobileDeviceExtensionAttribute{
+		{ID: extensionAttributeID, Name: definition.Name, Type: definition.DataType, Value: values},
+	}}
+
+	resp, err := client.HTTP.DoRequest("PATCH", fmt.Sprintf("%s/%s", uriMobileDevices, url.PathEscape(mobileDeviceID)), body, nil)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set extension attribute %s of mobile device %s: %v", extensionAttributeID, mobileDeviceID, err)
+	}
+
+	return nil
+}
+
+// getDefinition returns the definition of the mobile device extension attribute.
+func getDefinition(client *jamfpro.Client, extensionAttributeID string) (eavalue.Definition, error) {
+	attribute, err := client.GetMobileDeviceExtensionAttributeByID(extensionAttributeID)
+	if err != nil {
+		return eavalue.Definition{}, fmt.Errorf("failed to read mobile device extension attribute %s: %v", extensionAttributeID, err)
+	}
+
+	return eavalue.Definition{
+		Name:             attribute.Name,
+		InputType:        attribute.InputType,
+		DataType:         attribute.DataType,
+		PopupMenuChoices: attribute.PopupMenuChoices,
+	}, nil
+}
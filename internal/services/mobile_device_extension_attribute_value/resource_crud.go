@@ -0,0 +1,141 @@
+package mobile_device_extension_attribute_value
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	eavalue "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/extension_attribute_value"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for setting the value of the extension attribute on the mobile device,
+// looking the mobile device up by serial number when no ID is given.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	mobileDeviceID := d.Get("mobile_device_id").(string)
+	if mobileDeviceID == "" {
+		id, err := getMobileDeviceIDBySerialNumber(client, d.Get("serial_number").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mobileDeviceID = id
+	}
+
+	extensionAttributeID := d.Get("extension_attribute_id").(string)
+	if err := setValidExtensionAttributeValue(client, mobileDeviceID, extensionAttributeID, d.Get("value").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(eavalue.FormatID(mobileDeviceID, extensionAttributeID))
+
+	return read(ctx, d, meta)
+}
+
+// read is responsible for reading the value of the extension attribute on the mobile device. A
+// mobile device removed from Jamf Pro removes the value from state.
+func read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	mobileDeviceID, extensionAttributeID, _, err := eavalue.ParseID(d.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	value, err := getExtensionAttributeValue(meta.(*jamfpro.Client), mobileDeviceID, extensionAttributeID)
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, true)
+	}
+
+	// Serial numbers are looked up ignoring case, so the configured casing is kept rather than
+	// replacing the value with the casing of the inventory.
+	serialNumber := value.SerialNumber
+	if configured := d.Get("serial_number").(string); strings.EqualFold(configured, serialNumber) {
+		serialNumber = configured
+	}
+
+	for key, val := range map[string]any{
+		"mobile_device_id":         value.MobileDeviceID,
+		"serial_number":            serialNumber,
+		"extension_attribute_id":   extensionAttributeID,
+		"extension_attribute_name": value.ExtensionAttributeName,
+		"value":                    value.Value,
+	} {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// update is responsible for setting a changed value of the extension attribute on the mobile device.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	mobileDeviceID, extensionAttributeID, _, err := eavalue.ParseID(d.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setValidExtensionAttributeValue(meta.(*jamfpro.Client), mobileDeviceID, extensionAttributeID, d.Get("value").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return read(ctx, d, meta)
+}
+
+// delete is responsible for clearing the value of the extension attribute on the mobile device.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	mobileDeviceID, extensionAttributeID, _, err := eavalue.ParseID(d.Id(), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*jamfpro.Client)
+	definition, err := getDefinition(client, extensionAttributeID)
+	if err == nil {
+		err = setExtensionAttributeValue(client, mobileDeviceID, extensionAttributeID, definition, "")
+	}
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// setValidExtensionAttributeValue checks the value against the definition of the extension
+// attribute, which the plan could not when the extension attribute was not yet known, and sets it.
+func setValidExtensionAttributeValue(client *jamfpro.Client, mobileDeviceID, extensionAttributeID, value string) error {
+	definition, err := getDefinition(client, extensionAttributeID)
+	if err != nil {
+		return err
+	}
+	if err := eavalue.Validate(definition, value); err != nil {
+		return err
+	}
+
+	return setExtensionAttributeValue(client, mobileDeviceID, extensionAttributeID, definition, value)
+}
+
+// importState imports a value by its ID, in the form <mobile_device_id>:<extension_attribute_id>, or
+// by the serial number of the mobile device, in the form <serial_number>:<extension_attribute_id>.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	mobileDeviceID, extensionAttributeID, isSerialNumber, err := eavalue.ParseID(d.Id(), true)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected import ID: %v", err)
+	}
+
+	if isSerialNumber {
+		mobileDeviceID, err = getMobileDeviceIDBySerialNumber(meta.(*jamfpro.Client), mobileDeviceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(eavalue.FormatID(mobileDeviceID, extensionAttributeID))
+
+	return []*schema.ResourceData{d}, nil
+}
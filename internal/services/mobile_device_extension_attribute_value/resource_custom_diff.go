@@ -0,0 +1,27 @@
+package mobile_device_extension_attribute_value
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	eavalue "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/extension_attribute_value"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customDiff checks a new or changed value against the definition of the extension attribute, so
+// that values Jamf Pro would reject, or pop-up menu values that are not a choice, fail the plan.
+func customDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() != "" && !diff.HasChange("value") {
+		return nil
+	}
+	if !diff.NewValueKnown("extension_attribute_id") || !diff.NewValueKnown("value") {
+		return nil
+	}
+
+	definition, err := getDefinition(meta.(*jamfpro.Client), diff.Get("extension_attribute_id").(string))
+	if err != nil {
+		return err
+	}
+
+	return eavalue.Validate(definition, diff.Get("value").(string))
+}
@@ -0,0 +1,78 @@
+package mobile_device_extension_attribute_value
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProMobileDeviceExtensionAttributeValues defines the schema and CRUD operations for
+// managing the value of an extension attribute on a Jamf Pro mobile device in Terraform.
+func ResourceJamfProMobileDeviceExtensionAttributeValues() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   read,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: customDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Description: "Manages the value of a text field or pop-up menu extension attribute on a mobile device, given by ID or " +
+			"serial number. The value is checked against the extension attribute's definition when planning, and a value " +
+			"changed in Jamf Pro is set again on the next apply. Destroying the resource clears the value.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the value, in the form `<mobile_device_id>:<extension_attribute_id>`.",
+			},
+			"mobile_device_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"mobile_device_id", "serial_number"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be the numeric ID of a mobile device"),
+				Description:  "The ID of the mobile device. Exactly one of `mobile_device_id` and `serial_number` is set.",
+			},
+			"serial_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"mobile_device_id", "serial_number"},
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The serial number of the mobile device, which is looked up when the value is created.",
+			},
+			"extension_attribute_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be the numeric ID of a mobile device extension attribute"),
+				Description:  "The ID of the `jamfpro_mobile_device_extension_attribute`, whose input type must be `TEXT` or `POPUP`.",
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The value of the extension attribute. A pop-up menu value must be one of the " +
+					"`popup_menu_choices` of the extension attribute, and the value must have its `data_type`: an integer " +
+					"for `INTEGER`, and a date of the form `YYYY-MM-DD` or `YYYY-MM-DD hh:mm:ss` for `DATE`. An empty " +
+					"value clears the extension attribute.",
+			},
+			"extension_attribute_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the extension attribute.",
+			},
+		},
+	}
+}
@@ -0,0 +1,185 @@
+package mobile_device_inventory
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// The SDK has no support for the Jamf Pro API mobile device endpoints, so the inventory is read
+// from the mobile device detail list with the types below.
+const uriMobileDevicesDetail = "/api/v2/mobile-devices/detail"
+
+// detailPageSize is the number of devices fetched with each request of the detail list.
+const detailPageSize = 100
+
+// inventorySections are the sections of the mobile device detail requested for the data sources.
+var inventorySections = []string{
+	"GENERAL", "HARDWARE", "USER_AND_LOCATION", "SECURITY", "NETWORK",
+	"APPLICATIONS", "PROFILES", "EXTENSION_ATTRIBUTES", "GROUPS",
+}
+
+// responseMobileDeviceDetailList is a page of the mobile device detail list.
+type responseMobileDeviceDetailList struct {
+	TotalCount int                  `json:"totalCount"`
+	Results    []mobileDeviceDetail `json:"results"`
+}
+
+// mobileDeviceDetail is the inventory of a mobile device.
+type mobileDeviceDetail struct {
+	MobileDeviceID      string                           `json:"mobileDeviceId"`
+	DeviceType          string                           `json:"deviceType"`
+	General             mobileDeviceGeneral              `json:"general"`
+	Hardware            mobileDeviceHardware             `json:"hardware"`
+	UserAndLocation     mobileDeviceUserAndLocation      `json:"userAndLocation"`
+	Security            mobileDeviceSecurity             `json:"security"`
+	Network             mobileDeviceNetwork              `json:"network"`
+	Applications        []mobileDeviceApplication        `json:"applications"`
+	Profiles            []mobileDeviceProfile            `json:"profiles"`
+	ExtensionAttributes []mobileDeviceExtensionAttribute `json:"extensionAttributes"`
+	Groups              []mobileDeviceGroup              `json:"groups"`
+}
+
+type mobileDeviceGeneral struct {
+	UDID                                        string `json:"udid"`
+	DisplayName                                 string `json:"displayName"`
+	AssetTag                                    string `json:"assetTag"`
+	OSVersion                                   string `json:"osVersion"`
+	OSBuild                                     string `json:"osBuild"`
+	IPAddress                                   string `json:"ipAddress"`
+	Managed                                     bool   `json:"managed"`
+	Supervised                                  bool   `json:"supervised"`
+	SharedIpad                                  bool   `json:"sharedIpad"`
+	DeviceOwnershipType                         string `json:"deviceOwnershipType"`
+	LastInventoryUpdateDate                     string `json:"lastInventoryUpdateDate"`
+	LastEnrolledDate                            string `json:"lastEnrolledDate"`
+	ItunesStoreAccountActive                    bool   `json:"itunesStoreAccountActive"`
+	CloudBackupEnabled                          bool   `json:"cloudBackupEnabled"`
+	LastCloudBackupDate                         string `json:"lastCloudBackupDate"`
+	LocationServicesForSelfServiceMobileEnabled bool   `json:"locationServicesForSelfServiceMobileEnabled"`
+	DoNotDisturbEnabled                         bool   `json:"doNotDisturbEnabled"`
+	DeviceLocatorServiceEnabled                 bool   `json:"deviceLocatorServiceEnabled"`
+}
+
+type mobileDeviceHardware struct {
+	SerialNumber              string `json:"serialNumber"`
+	Model                     string `json:"model"`
+	ModelIdentifier           string `json:"modelIdentifier"`
+	ModelNumber               string `json:"modelNumber"`
+	CapacityMb                int    `json:"capacityMb"`
+	AvailableSpaceMb          int    `json:"availableSpaceMb"`
+	UsedSpacePercentage       int    `json:"usedSpacePercentage"`
+	BatteryLevel              int    `json:"batteryLevel"`
+	WifiMacAddress            string `json:"wifiMacAddress"`
+	BluetoothMacAddress       string `json:"bluetoothMacAddress"`
+	ModemFirmwareVersion      string `json:"modemFirmwareVersion"`
+	BluetoothLowEnergyCapable bool   `json:"bluetoothLowEnergyCapable"`
+}
+
+type mobileDeviceUserAndLocation struct {
+	Username     string `json:"username"`
+	RealName     string `json:"realName"`
+	EmailAddress string `json:"emailAddress"`
+	Position     string `json:"position"`
+	PhoneNumber  string `json:"phoneNumber"`
+	DepartmentID string `json:"departmentId"`
+	BuildingID   string `json:"buildingId"`
+	Room         string `json:"room"`
+}
+
+type mobileDeviceSecurity struct {
+	DataProtected                bool `json:"dataProtected"`
+	BlockLevelEncryptionCapable  bool `json:"blockLevelEncryptionCapable"`
+	FileLevelEncryptionCapable   bool `json:"fileLevelEncryptionCapable"`
+	PasscodePresent              bool `json:"passcodePresent"`
+	PasscodeCompliant            bool `json:"passcodeCompliant"`
+	PasscodeCompliantWithProfile bool `json:"passcodeCompliantWithProfile"`
+	HardwareEncryption           int  `json:"hardwareEncryption"`
+	ActivationLockEnabled        bool `json:"activationLockEnabled"`
+	JailBreakDetected            bool `json:"jailBreakDetected"`
+	LostModeEnabled              bool `json:"lostModeEnabled"`
+	LostModePersistent           bool `json:"lostModePersistent"`
+}
+
+type mobileDeviceNetwork struct {
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+type mobileDeviceApplication struct {
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+}
+
+type mobileDeviceProfile struct {
+	DisplayName string `json:"displayName"`
+	Version     string `json:"version"`
+	UUID        string `json:"uuid"`
+	Identifier  string `json:"identifier"`
+}
+
+type mobileDeviceExtensionAttribute struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Type  string   `json:"type"`
+	Value []string `json:"value"`
+}
+
+type mobileDeviceGroup struct {
+	GroupID   string `json:"groupId"`
+	GroupName string `json:"groupName"`
+}
+
+// listMobileDeviceDetails returns the inventory of every mobile device matching the RSQL filter,
+// ordered by ID, fetching every page of results. An empty filter matches every device.
+func listMobileDeviceDetails(client *jamfpro.Client, filter string) ([]mobileDeviceDetail, error) {
+	params := url.Values{}
+	for _, section := range inventorySections {
+		params.Add("section", section)
+	}
+	params.Set("sort", "mobileDeviceId:asc")
+	params.Set("page-size", strconv.Itoa(detailPageSize))
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+
+	var devices []mobileDeviceDetail
+	for page := 0; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		var out responseMobileDeviceDetailList
+		resp, err := client.HTTP.DoRequest("GET", uriMobileDevicesDetail+"?"+params.Encode(), nil, &out)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list mobile device details with filter '%s': %w", filter, err)
+		}
+
+		devices = append(devices, out.Results...)
+		if len(out.Results) == 0 || len(devices) >= out.TotalCount {
+			return devices, nil
+		}
+	}
+}
+
+// rsqlEquals returns an RSQL comparison of the field with the value, quoted.
+func rsqlEquals(field, value string) string {
+	return fmt.Sprintf("%s==%s", field, rsqlQuote(value))
+}
+
+// rsqlIn returns an RSQL comparison of the field with any of the values, quoted.
+func rsqlIn(field string, values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = rsqlQuote(value)
+	}
+	return fmt.Sprintf("%s=in=(%s)", field, strings.Join(quoted, ","))
+}
+
+func rsqlQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
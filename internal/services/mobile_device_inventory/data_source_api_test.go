@@ -0,0 +1,197 @@
+package mobile_device_inventory_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest/fakejamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/mobile_device_inventory"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// detailServer starts a fake Jamf Pro server serving the mobile device detail list through handler
+// and returns a client configured against it.
+func detailServer(t *testing.T, handler func(w http.ResponseWriter, query url.Values)) *jamfpro.Client {
+	t.Helper()
+
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+	server.HandleFunc("GET /api/v2/mobile-devices/detail", func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r.URL.Query())
+	})
+	return serverClient(t, server)
+}
+
+// serverClient returns a client configured against the fake Jamf Pro server.
+func serverClient(t *testing.T, server *fakejamfpro.Server) *jamfpro.Client {
+	t.Helper()
+
+	t.Setenv("JAMFPRO_INSTANCE_FQDN", server.URL)
+	t.Setenv("JAMFPRO_AUTH_METHOD", "oauth2")
+	t.Setenv("JAMFPRO_AUTH_PROVIDER", "direct")
+	t.Setenv("JAMFPRO_CLIENT_ID", server.ClientID)
+	t.Setenv("JAMFPRO_CLIENT_SECRET", server.ClientSecret)
+
+	client, err := acctest.Client()
+	require.NoError(t, err)
+	return client
+}
+
+func writeDevices(w http.ResponseWriter, total int, devices ...map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"totalCount": total, "results": devices})
+}
+
+func device(id int, name, serialNumber string) map[string]any {
+	return map[string]any{
+		"mobileDeviceId": strconv.Itoa(id),
+		"deviceType":     "iOS",
+		"general":        map[string]any{"displayName": name, "udid": "UDID-" + strconv.Itoa(id)},
+		"hardware":       map[string]any{"serialNumber": serialNumber, "modelIdentifier": "iPad13,1"},
+	}
+}
+
+func TestDataSourceReadFiltersBySerialNumber(t *testing.T) {
+	var filters []string
+	client := detailServer(t, func(w http.ResponseWriter, query url.Values) {
+		filters = append(filters, query.Get("filter"))
+		assert.Contains(t, query["section"], "HARDWARE")
+		writeDevices(w, 1, device(12, "iPad-Lab-01", "DMPXJ0ABCD12"))
+	})
+
+	resource := mobile_device_inventory.DataSourceJamfProMobileDeviceInventory()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"serial_number": "DMPXJ0ABCD12"})
+
+	diags := resource.ReadContext(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{`hardware.serialNumber=="DMPXJ0ABCD12"`}, filters)
+	assert.Equal(t, "12", d.Id())
+	assert.Equal(t, "iPad-Lab-01", d.Get("name"))
+	assert.Equal(t, "UDID-12", d.Get("udid"))
+}
+
+func TestDataSourceReadNotFound(t *testing.T) {
+	client := detailServer(t, func(w http.ResponseWriter, query url.Values) {
+		writeDevices(w, 0)
+	})
+
+	resource := mobile_device_inventory.DataSourceJamfProMobileDeviceInventory()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"name": "Missing"})
+
+	diags := resource.ReadContext(context.Background(), d, client)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, "no mobile device matches 'Missing'", diags[0].Summary)
+}
+
+func TestDataSourceListReadFetchesEveryPage(t *testing.T) {
+	// 150 devices are served in pages of 100.
+	var pages []string
+	client := detailServer(t, func(w http.ResponseWriter, query url.Values) {
+		pages = append(pages, query.Get("page"))
+		assert.Equal(t, "mobileDeviceId:asc", query.Get("sort"))
+
+		page, _ := strconv.Atoi(query.Get("page"))
+		pageSize, _ := strconv.Atoi(query.Get("page-size"))
+		var devices []map[string]any
+		for id := page*pageSize + 1; id <= min((page+1)*pageSize, 150); id++ {
+			name := "Lobby TV"
+			if id%50 == 0 {
+				name = "iPad-Lab-" + strconv.Itoa(id)
+			}
+			devices = append(devices, device(id, name, "SERIAL"+strconv.Itoa(id)))
+		}
+		writeDevices(w, 150, devices...)
+	})
+
+	resource := mobile_device_inventory.DataSourceJamfProMobileDeviceInventories()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"name_regex": "^iPad-Lab-"})
+
+	diags := resource.ReadContext(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{"0", "1"}, pages)
+	var ids []string
+	for _, device := range d.Get("mobile_devices").([]any) {
+		ids = append(ids, device.(map[string]any)["id"].(string))
+	}
+	assert.Equal(t, []string{"50", "100", "150"}, ids)
+}
+
+func TestDataSourceListReadDoesNotRetryClientErrors(t *testing.T) {
+	requests := 0
+	client := detailServer(t, func(w http.ResponseWriter, query url.Values) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"httpStatus": 400, "errors": [{"code": "INVALID_RSQL_FILTER", "description": "Invalid RSQL filter"}]}`))
+	})
+
+	resource := mobile_device_inventory.DataSourceJamfProMobileDeviceInventories()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"serial_numbers": []any{"DMPXJ0ABCD12"}})
+
+	diags := resource.ReadContext(context.Background(), d, client)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, 1, requests)
+}
+
+func TestDataSourceListReadSplitsLargeGroups(t *testing.T) {
+	// A group of 250 members, listed out of order, is filtered in chunks of 100 members.
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+	server.HandleFunc("GET /JSSResource/mobiledevicegroups/id/7", func(w http.ResponseWriter, r *http.Request) {
+		group := jamfpro.ResourceMobileDeviceGroup{ID: 7, Name: "Large"}
+		members := []jamfpro.MobileDeviceGroupSubsetDeviceItem{}
+		for id := 250; id >= 1; id-- {
+			members = append(members, jamfpro.MobileDeviceGroupSubsetDeviceItem{ID: id})
+		}
+		group.MobileDevices = &members
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(struct {
+			XMLName xml.Name `xml:"mobile_device_group"`
+			jamfpro.ResourceMobileDeviceGroup
+		}{ResourceMobileDeviceGroup: group})
+	})
+	var chunkSizes []int
+	server.HandleFunc("GET /api/v2/mobile-devices/detail", func(w http.ResponseWriter, r *http.Request) {
+		filter := r.URL.Query().Get("filter")
+		require.True(t, strings.HasPrefix(filter, "mobileDeviceId=in=("), filter)
+		ids := strings.Split(strings.TrimSuffix(strings.TrimPrefix(filter, "mobileDeviceId=in=("), ")"), ",")
+		chunkSizes = append(chunkSizes, len(ids))
+
+		var devices []map[string]any
+		for _, quoted := range ids {
+			id, err := strconv.Atoi(strings.Trim(quoted, `"`))
+			require.NoError(t, err)
+			devices = append(devices, device(id, fmt.Sprintf("iPad-%d", id), "SERIAL"+strconv.Itoa(id)))
+		}
+		writeDevices(w, len(devices), devices...)
+	})
+	client := serverClient(t, server)
+
+	resource := mobile_device_inventory.DataSourceJamfProMobileDeviceInventories()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{"group_id": "7"})
+
+	diags := resource.ReadContext(context.Background(), d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []int{100, 100, 50}, chunkSizes)
+	devices := d.Get("mobile_devices").([]any)
+	require.Len(t, devices, 250)
+	for i, device := range devices {
+		assert.Equal(t, strconv.Itoa(i+1), device.(map[string]any)["id"])
+	}
+}
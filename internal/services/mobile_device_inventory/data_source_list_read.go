@@ -0,0 +1,164 @@
+package mobile_device_inventory
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// numericID matches a Jamf Pro object ID.
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// groupMemberChunkSize is the number of group members sent in the filter of each device list, keeping
+// the request URL within the length Jamf Pro accepts for large groups.
+const groupMemberChunkSize = 100
+
+// deviceFilter selects mobile devices from the device detail list. Serial numbers and group
+// members are filtered by Jamf Pro, the regular expressions once the devices are listed.
+type deviceFilter struct {
+	name            *regexp.Regexp
+	modelIdentifier *regexp.Regexp
+	serialNumbers   []string
+	groupMembers    []string
+}
+
+// rsql returns the RSQL filter of the serial numbers and group members, empty when neither is set.
+func (f deviceFilter) rsql() string {
+	var clauses []string
+	if f.serialNumbers != nil {
+		clauses = append(clauses, rsqlIn("hardware.serialNumber", f.serialNumbers))
+	}
+	if f.groupMembers != nil {
+		clauses = append(clauses, rsqlIn("mobileDeviceId", f.groupMembers))
+	}
+	return strings.Join(clauses, ";")
+}
+
+// chunks splits the filter into filters of at most groupMemberChunkSize group members each, in
+// member order. A filter without group members is returned as is.
+func (f deviceFilter) chunks() []deviceFilter {
+	if len(f.groupMembers) <= groupMemberChunkSize {
+		return []deviceFilter{f}
+	}
+	var chunks []deviceFilter
+	for start := 0; start < len(f.groupMembers); start += groupMemberChunkSize {
+		chunk := f
+		chunk.groupMembers = f.groupMembers[start:min(start+groupMemberChunkSize, len(f.groupMembers))]
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// matches reports whether the device passes the regular expressions.
+func (f deviceFilter) matches(device mobileDeviceDetail) bool {
+	if f.name != nil && !f.name.MatchString(device.General.DisplayName) {
+		return false
+	}
+	if f.modelIdentifier != nil && !f.modelIdentifier.MatchString(device.Hardware.ModelIdentifier) {
+		return false
+	}
+	return true
+}
+
+// dataSourceListRead lists the inventory of the mobile devices matching the filters.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	filter, err := deviceFilterFromResourceData(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var list []mobileDeviceDetail
+	if filter.groupMembers == nil || len(filter.groupMembers) > 0 {
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+			list = nil
+			for _, chunk := range filter.chunks() {
+				devices, apiErr := listMobileDeviceDetails(client, chunk.rsql())
+				if apiErr != nil {
+					return crud.ClassifyAPIError(apiErr)
+				}
+				list = append(list, devices...)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to list Jamf Pro Mobile Devices after retries: %v", err))
+		}
+	}
+
+	var ids []string
+	devices := make([]any, 0, len(list))
+	for _, device := range list {
+		if filter.matches(device) {
+			ids = append(ids, device.MobileDeviceID)
+			devices = append(devices, flattenMobileDevice(device))
+		}
+	}
+
+	if err := d.Set("mobile_devices", devices); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprint(ids)))))
+
+	return nil
+}
+
+// deviceFilterFromResourceData builds the device filter from the data source arguments, reading
+// the members of the group filter from Jamf Pro.
+func deviceFilterFromResourceData(client *jamfpro.Client, d *schema.ResourceData) (deviceFilter, error) {
+	var filter deviceFilter
+
+	if pattern := d.Get("name_regex").(string); pattern != "" {
+		filter.name = regexp.MustCompile(pattern)
+	}
+	if pattern := d.Get("model_identifier_regex").(string); pattern != "" {
+		filter.modelIdentifier = regexp.MustCompile(pattern)
+	}
+	if serials, ok := d.GetOk("serial_numbers"); ok {
+		for _, serial := range serials.(*schema.Set).List() {
+			filter.serialNumbers = append(filter.serialNumbers, serial.(string))
+		}
+	}
+	if groupID := d.Get("group_id").(string); groupID != "" {
+		group, err := client.GetMobileDeviceGroupByID(groupID)
+		if err != nil {
+			return filter, fmt.Errorf("failed to read Jamf Pro Mobile Device Group with ID '%s': %v", groupID, err)
+		}
+		filter.groupMembers = []string{}
+		if group.MobileDevices != nil {
+			for _, member := range *group.MobileDevices {
+				filter.groupMembers = append(filter.groupMembers, fmt.Sprint(member.ID))
+			}
+		}
+		// Members are listed in ID order so that the devices of consecutive chunks stay sorted.
+		sort.Slice(filter.groupMembers, func(i, j int) bool {
+			a, _ := strconv.Atoi(filter.groupMembers[i])
+			b, _ := strconv.Atoi(filter.groupMembers[j])
+			return a < b
+		})
+	}
+
+	return filter, nil
+}
+
+// flattenMobileDevice returns the state of a mobile device in the mobile_devices list.
+func flattenMobileDevice(device mobileDeviceDetail) map[string]any {
+	flat := flattenInventorySections(device)
+	flat["id"] = device.MobileDeviceID
+	flat["serial_number"] = device.Hardware.SerialNumber
+	flat["udid"] = device.General.UDID
+	flat["name"] = device.General.DisplayName
+	return flat
+}
@@ -0,0 +1,64 @@
+package mobile_device_inventory
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceJamfProMobileDeviceInventories provides the inventory of every mobile device matching
+// the given filters.
+func DataSourceJamfProMobileDeviceInventories() *schema.Resource {
+	device := inventorySectionsSchema()
+	for _, attribute := range []string{"id", "serial_number", "udid", "name"} {
+		device[attribute] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Description: "Provides the inventory of every iPhone, iPad and Apple TV matching the given filters. All filters " +
+			"must match. Serial numbers and groups are filtered by Jamf Pro, while the regular expressions are applied to " +
+			"the inventory of every device listed, so combine them with a serial number or group filter on large fleets.",
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the device name must match.",
+			},
+			"model_identifier_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the model identifier must match, e.g. `^iPad` or `^AppleTV`.",
+			},
+			"serial_numbers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Serial numbers the device must have one of.",
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric Jamf Pro ID"),
+				Description:  "The ID of a smart or static mobile device group the device must belong to.",
+			},
+			"mobile_devices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mobile devices matching the filters, ordered by ID.",
+				Elem: &schema.Resource{
+					Schema: device,
+				},
+			},
+		},
+	}
+}
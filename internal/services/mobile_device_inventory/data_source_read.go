@@ -0,0 +1,75 @@
+package mobile_device_inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRead fetches the inventory of a mobile device from Jamf Pro using its ID, serial number,
+// UDID or name, filtering the mobile device detail list on the matching field.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var filter, identifier string
+	switch {
+	case d.Get("id").(string) != "":
+		identifier = d.Get("id").(string)
+		filter = rsqlEquals("mobileDeviceId", identifier)
+	case d.Get("serial_number").(string) != "":
+		identifier = d.Get("serial_number").(string)
+		filter = rsqlEquals("hardware.serialNumber", identifier)
+	case d.Get("udid").(string) != "":
+		identifier = d.Get("udid").(string)
+		filter = rsqlEquals("general.udid", identifier)
+	default:
+		identifier = d.Get("name").(string)
+		filter = rsqlEquals("general.displayName", identifier)
+	}
+
+	var devices []mobileDeviceDetail
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		devices, apiErr = listMobileDeviceDetails(client, filter)
+		if apiErr != nil {
+			return crud.ClassifyAPIError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Mobile Device Inventory with identifier '%s' after retries: %v", identifier, err))
+	}
+
+	switch len(devices) {
+	case 0:
+		return diag.Errorf("no mobile device matches '%s'", identifier)
+	case 1:
+	default:
+		return diag.Errorf("%d mobile devices match '%s', use the ID, serial number or UDID of the device instead", len(devices), identifier)
+	}
+	device := devices[0]
+
+	d.SetId(device.MobileDeviceID)
+
+	for key, value := range map[string]any{
+		"id":            device.MobileDeviceID,
+		"serial_number": device.Hardware.SerialNumber,
+		"udid":          device.General.UDID,
+		"name":          device.General.DisplayName,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := setInventorySections(d, device); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package mobile_device_inventory
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMobileDevice() mobileDeviceDetail {
+	return mobileDeviceDetail{
+		MobileDeviceID: "12",
+		DeviceType:     "iOS",
+		General: mobileDeviceGeneral{
+			UDID:        "00008103-000A1B2C3D4E5F60",
+			DisplayName: "iPad-Lab-01",
+			OSVersion:   "17.6",
+			Supervised:  true,
+		},
+		Hardware: mobileDeviceHardware{
+			SerialNumber:    "DMPXJ0ABCD12",
+			ModelIdentifier: "iPad13,1",
+		},
+		Security:            mobileDeviceSecurity{PasscodePresent: true},
+		Applications:        []mobileDeviceApplication{{Name: "Safari", Version: "17.6", Identifier: "com.apple.mobilesafari"}},
+		ExtensionAttributes: []mobileDeviceExtensionAttribute{{ID: "3", Name: "Carts", Type: "STRING", Value: []string{"A", "B"}}},
+		Groups:              []mobileDeviceGroup{{GroupID: "4", GroupName: "Lab iPads"}},
+	}
+}
+
+func TestSetInventorySections(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceJamfProMobileDeviceInventory().Schema, map[string]any{"id": "12"})
+
+	require.NoError(t, setInventorySections(d, testMobileDevice()))
+
+	assert.Equal(t, "iOS", d.Get("general.0.os_type"))
+	assert.Equal(t, "17.6", d.Get("general.0.os_version"))
+	assert.Equal(t, "DMPXJ0ABCD12", d.Get("hardware.0.serial_number"))
+	assert.Equal(t, "iPad13,1", d.Get("hardware.0.model_identifier"))
+	assert.Equal(t, true, d.Get("security.0.passcode_present"))
+	assert.Equal(t, "com.apple.mobilesafari", d.Get("applications.0.identifier"))
+	assert.Equal(t, "A, B", d.Get("extension_attributes.0.value"))
+	assert.Equal(t, "4", d.Get("group_memberships.0.id"))
+}
+
+func TestFlattenMobileDevice(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceJamfProMobileDeviceInventories().Schema, map[string]any{})
+
+	require.NoError(t, d.Set("mobile_devices", []any{flattenMobileDevice(testMobileDevice())}))
+
+	assert.Equal(t, "12", d.Get("mobile_devices.0.id"))
+	assert.Equal(t, "iPad-Lab-01", d.Get("mobile_devices.0.name"))
+	assert.Equal(t, "00008103-000A1B2C3D4E5F60", d.Get("mobile_devices.0.udid"))
+	assert.Equal(t, "Lab iPads", d.Get("mobile_devices.0.group_memberships.0.name"))
+}
+
+func TestDeviceFilter(t *testing.T) {
+	ipad := mobileDeviceDetail{General: mobileDeviceGeneral{DisplayName: "iPad-Lab-01"}, Hardware: mobileDeviceHardware{ModelIdentifier: "iPad13,1"}}
+	tv := mobileDeviceDetail{General: mobileDeviceGeneral{DisplayName: "Lobby TV"}, Hardware: mobileDeviceHardware{ModelIdentifier: "AppleTV11,1"}}
+
+	tests := []struct {
+		name   string
+		filter deviceFilter
+		want   []bool
+	}{
+		{"no filter", deviceFilter{}, []bool{true, true}},
+		{"name", deviceFilter{name: regexp.MustCompile("Lab")}, []bool{true, false}},
+		{"model identifier", deviceFilter{modelIdentifier: regexp.MustCompile("^AppleTV")}, []bool{false, true}},
+		{"all filters must match", deviceFilter{name: regexp.MustCompile("Lab"), modelIdentifier: regexp.MustCompile("^AppleTV")}, []bool{false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, []bool{tt.filter.matches(ipad), tt.filter.matches(tv)})
+		})
+	}
+}
+
+func TestDeviceFilterRSQL(t *testing.T) {
+	assert.Empty(t, deviceFilter{name: regexp.MustCompile("Lab")}.rsql(), "regular expressions are not sent to Jamf Pro")
+	assert.Equal(t, `hardware.serialNumber=in=("C07ZX1","DMPX\"J0")`, deviceFilter{serialNumbers: []string{"C07ZX1", `DMPX"J0`}}.rsql())
+	assert.Equal(t, `hardware.serialNumber=in=("C07ZX1");mobileDeviceId=in=("12","13")`,
+		deviceFilter{serialNumbers: []string{"C07ZX1"}, groupMembers: []string{"12", "13"}}.rsql())
+}
@@ -0,0 +1,441 @@
+package mobile_device_inventory
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDeviceInventory provides the inventory of a single mobile device, found by
+// its ID, serial number, UDID or name.
+func DataSourceJamfProMobileDeviceInventory() *schema.Resource {
+	s := inventorySectionsSchema()
+	s["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "serial_number", "udid", "name"},
+		Description:  "The Jamf Pro ID of the mobile device.",
+	}
+	s["serial_number"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The serial number of the mobile device.",
+	}
+	s["udid"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The UDID of the mobile device.",
+	}
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the mobile device.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(70 * time.Second),
+		},
+		Description: "Provides the inventory of an iPhone, iPad or Apple TV, found by its ID, serial number, UDID or name.",
+		Schema:      s,
+	}
+}
+
+// inventorySectionsSchema returns the computed inventory sections shared by the singular and plural
+// mobile device inventory data sources.
+func inventorySectionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"general": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "General information about the device.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The display name of the device.",
+					},
+					"asset_tag": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The asset tag of the device.",
+					},
+					"os_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The operating system type, e.g. `iOS` or `tvOS`.",
+					},
+					"os_version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The operating system version.",
+					},
+					"os_build": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The operating system build.",
+					},
+					"phone_number": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The phone number of the device.",
+					},
+					"ip_address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The last IP address of the device.",
+					},
+					"managed": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the device is managed.",
+					},
+					"supervised": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the device is supervised.",
+					},
+					"shared": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the device is a Shared iPad.",
+					},
+					"device_ownership_level": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ownership level of the device, e.g. `Institutional`.",
+					},
+					"last_inventory_update_utc": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "When the device last submitted inventory.",
+					},
+					"last_enrollment_utc": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "When the device last enrolled.",
+					},
+					"itunes_store_account_is_active": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether an App Store account is signed in.",
+					},
+					"cloud_backup_enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether iCloud backup is enabled.",
+					},
+					"last_cloud_backup_date_utc": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "When the device was last backed up to iCloud.",
+					},
+					"location_services_enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether location services are enabled for Self Service.",
+					},
+					"do_not_disturb_enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether Do Not Disturb is enabled.",
+					},
+					"device_locator_service_enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether Find My is enabled.",
+					},
+				},
+			},
+		},
+		"hardware": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Hardware information about the device.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"serial_number": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The serial number of the device.",
+					},
+					"model": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The model of the device.",
+					},
+					"model_identifier": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The model identifier of the device, e.g. `iPad13,1`.",
+					},
+					"model_number": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The model number of the device.",
+					},
+					"capacity_mb": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The storage capacity in megabytes.",
+					},
+					"available_mb": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The available storage in megabytes.",
+					},
+					"percentage_used": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The percentage of storage used.",
+					},
+					"battery_level": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The battery level as a percentage.",
+					},
+					"wifi_mac_address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The Wi-Fi MAC address.",
+					},
+					"bluetooth_mac_address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The Bluetooth MAC address.",
+					},
+					"modem_firmware": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The modem firmware version.",
+					},
+					"ble_capable": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the device supports Bluetooth Low Energy.",
+					},
+				},
+			},
+		},
+		"user_and_location": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The user and location assigned to the device.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The username of the assigned user.",
+					},
+					"real_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The full name of the assigned user.",
+					},
+					"email_address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The email address of the assigned user.",
+					},
+					"position": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The position of the assigned user.",
+					},
+					"phone": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The phone number of the assigned user.",
+					},
+					"department_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the department of the device.",
+					},
+					"building_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the building of the device.",
+					},
+					"room": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The room of the device.",
+					},
+				},
+			},
+		},
+		"security": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Security information about the device.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"data_protection": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether data protection is enabled.",
+					},
+					"block_level_encryption_capable": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the device supports block level encryption.",
+					},
+					"file_level_encryption_capable": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the device supports file level encryption.",
+					},
+					"passcode_present": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether a passcode is set.",
+					},
+					"passcode_compliant": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the passcode is compliant.",
+					},
+					"passcode_compliant_with_profile": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the passcode complies with the passcode profile.",
+					},
+					"hardware_encryption": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The hardware encryption capabilities, as reported by the device.",
+					},
+					"activation_lock_enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether Activation Lock is enabled.",
+					},
+					"jailbreak_detected": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the device is jailbroken.",
+					},
+					"lost_mode_enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether Lost Mode is enabled.",
+					},
+					"lost_mode_persistent": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether Lost Mode persists after the device is erased.",
+					},
+				},
+			},
+		},
+		"applications": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The applications installed on the device.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the application.",
+					},
+					"version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The version of the application.",
+					},
+					"identifier": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The bundle identifier of the application.",
+					},
+				},
+			},
+		},
+		"configuration_profiles": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The configuration profiles installed on the device.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"display_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The display name of the profile.",
+					},
+					"version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The version of the profile.",
+					},
+					"identifier": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The identifier of the profile.",
+					},
+					"uuid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The UUID of the profile.",
+					},
+				},
+			},
+		},
+		"extension_attributes": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The extension attribute values of the device.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the extension attribute.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the extension attribute.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The data type of the extension attribute.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The value of the extension attribute. Multiple values are separated by commas.",
+					},
+				},
+			},
+		},
+		"group_memberships": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The mobile device groups the device belongs to.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the mobile device group.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the mobile device group.",
+					},
+				},
+			},
+		},
+	}
+}
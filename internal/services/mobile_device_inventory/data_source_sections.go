@@ -0,0 +1,181 @@
+package mobile_device_inventory
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setInventorySections sets every inventory section of the device in state.
+func setInventorySections(d *schema.ResourceData, device mobileDeviceDetail) error {
+	for key, value := range flattenInventorySections(device) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flattenInventorySections returns the state of every inventory section of the device, keyed by
+// attribute name.
+func flattenInventorySections(device mobileDeviceDetail) map[string]any {
+	return map[string]any{
+		"general":                flattenGeneralSection(device),
+		"hardware":               flattenHardwareSection(device.Hardware),
+		"user_and_location":      flattenUserAndLocationSection(device.UserAndLocation),
+		"security":               flattenSecuritySection(device.Security),
+		"applications":           flattenApplicationsSection(device.Applications),
+		"configuration_profiles": flattenConfigurationProfilesSection(device.Profiles),
+		"extension_attributes":   flattenExtensionAttributesSection(device.ExtensionAttributes),
+		"group_memberships":      flattenGroupMembershipsSection(device.Groups),
+	}
+}
+
+// flattenGeneralSection maps the 'general' section of the mobile device detail, with the device
+// type and the phone number of the 'network' section, to the Terraform schema.
+func flattenGeneralSection(device mobileDeviceDetail) []any {
+	general := device.General
+	gen := make(map[string]any)
+
+	gen["name"] = general.DisplayName
+	gen["asset_tag"] = general.AssetTag
+	gen["os_type"] = device.DeviceType
+	gen["os_version"] = general.OSVersion
+	gen["os_build"] = general.OSBuild
+	gen["phone_number"] = device.Network.PhoneNumber
+	gen["ip_address"] = general.IPAddress
+	gen["managed"] = general.Managed
+	gen["supervised"] = general.Supervised
+	gen["shared"] = general.SharedIpad
+	gen["device_ownership_level"] = general.DeviceOwnershipType
+	gen["last_inventory_update_utc"] = general.LastInventoryUpdateDate
+	gen["last_enrollment_utc"] = general.LastEnrolledDate
+	gen["itunes_store_account_is_active"] = general.ItunesStoreAccountActive
+	gen["cloud_backup_enabled"] = general.CloudBackupEnabled
+	gen["last_cloud_backup_date_utc"] = general.LastCloudBackupDate
+	gen["location_services_enabled"] = general.LocationServicesForSelfServiceMobileEnabled
+	gen["do_not_disturb_enabled"] = general.DoNotDisturbEnabled
+	gen["device_locator_service_enabled"] = general.DeviceLocatorServiceEnabled
+
+	return []any{gen}
+}
+
+// flattenHardwareSection maps the 'hardware' section of the mobile device detail to the Terraform schema.
+func flattenHardwareSection(hardware mobileDeviceHardware) []any {
+	hw := make(map[string]any)
+
+	hw["serial_number"] = hardware.SerialNumber
+	hw["model"] = hardware.Model
+	hw["model_identifier"] = hardware.ModelIdentifier
+	hw["model_number"] = hardware.ModelNumber
+	hw["capacity_mb"] = hardware.CapacityMb
+	hw["available_mb"] = hardware.AvailableSpaceMb
+	hw["percentage_used"] = hardware.UsedSpacePercentage
+	hw["battery_level"] = hardware.BatteryLevel
+	hw["wifi_mac_address"] = hardware.WifiMacAddress
+	hw["bluetooth_mac_address"] = hardware.BluetoothMacAddress
+	hw["modem_firmware"] = hardware.ModemFirmwareVersion
+	hw["ble_capable"] = hardware.BluetoothLowEnergyCapable
+
+	return []any{hw}
+}
+
+// flattenUserAndLocationSection maps the 'userAndLocation' section of the mobile device detail to the Terraform schema.
+func flattenUserAndLocationSection(location mobileDeviceUserAndLocation) []any {
+	loc := make(map[string]any)
+
+	loc["username"] = location.Username
+	loc["real_name"] = location.RealName
+	loc["email_address"] = location.EmailAddress
+	loc["position"] = location.Position
+	loc["phone"] = location.PhoneNumber
+	loc["department_id"] = location.DepartmentID
+	loc["building_id"] = location.BuildingID
+	loc["room"] = location.Room
+
+	return []any{loc}
+}
+
+// flattenSecuritySection maps the 'security' section of the mobile device detail to the Terraform schema.
+func flattenSecuritySection(security mobileDeviceSecurity) []any {
+	sec := make(map[string]any)
+
+	sec["data_protection"] = security.DataProtected
+	sec["block_level_encryption_capable"] = security.BlockLevelEncryptionCapable
+	sec["file_level_encryption_capable"] = security.FileLevelEncryptionCapable
+	sec["passcode_present"] = security.PasscodePresent
+	sec["passcode_compliant"] = security.PasscodeCompliant
+	sec["passcode_compliant_with_profile"] = security.PasscodeCompliantWithProfile
+	sec["hardware_encryption"] = security.HardwareEncryption
+	sec["activation_lock_enabled"] = security.ActivationLockEnabled
+	sec["jailbreak_detected"] = security.JailBreakDetected
+	sec["lost_mode_enabled"] = security.LostModeEnabled
+	sec["lost_mode_persistent"] = security.LostModePersistent
+
+	return []any{sec}
+}
+
+// flattenApplicationsSection maps the 'applications' section of the mobile device detail to the Terraform schema.
+func flattenApplicationsSection(applications []mobileDeviceApplication) []any {
+	apps := make([]any, len(applications))
+
+	for i, app := range applications {
+		appMap := make(map[string]any)
+		appMap["name"] = app.Name
+		appMap["version"] = app.Version
+		appMap["identifier"] = app.Identifier
+
+		apps[i] = appMap
+	}
+
+	return apps
+}
+
+// flattenConfigurationProfilesSection maps the 'profiles' section of the mobile device detail to the Terraform schema.
+func flattenConfigurationProfilesSection(profiles []mobileDeviceProfile) []any {
+	profs := make([]any, len(profiles))
+
+	for i, profile := range profiles {
+		profMap := make(map[string]any)
+		profMap["display_name"] = profile.DisplayName
+		profMap["version"] = profile.Version
+		profMap["identifier"] = profile.Identifier
+		profMap["uuid"] = profile.UUID
+
+		profs[i] = profMap
+	}
+
+	return profs
+}
+
+// flattenExtensionAttributesSection maps the 'extensionAttributes' section of the mobile device detail to the Terraform schema.
+func flattenExtensionAttributesSection(attributes []mobileDeviceExtensionAttribute) []any {
+	extAttrs := make([]any, len(attributes))
+
+	for i, attr := range attributes {
+		attrMap := make(map[string]any)
+		attrMap["id"] = attr.ID
+		attrMap["name"] = attr.Name
+		attrMap["type"] = attr.Type
+		attrMap["value"] = strings.Join(attr.Value, ", ")
+
+		extAttrs[i] = attrMap
+	}
+
+	return extAttrs
+}
+
+// flattenGroupMembershipsSection maps the 'groups' section of the mobile device detail to the Terraform schema.
+func flattenGroupMembershipsSection(groups []mobileDeviceGroup) []any {
+	memberships := make([]any, len(groups))
+
+	for i, group := range groups {
+		groupMap := make(map[string]any)
+		groupMap["id"] = group.GroupID
+		groupMap["name"] = group.GroupName
+
+		memberships[i] = groupMap
+	}
+
+	return memberships
+}
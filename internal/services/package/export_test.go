@@ -0,0 +1,5 @@
+package packages
+
+// Exported for the tests of the packages_test package, which configure a client against the fake
+// Jamf Pro server.
+var JCDSHashDiffers = jcdsHashDiffers
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
@@ -73,3 +74,39 @@ func verifyPackageUpload(ctx context.Context, client *jamfpro.Client, packageID
 
 	return nil
 }
+
+// setFileHashes sets the hashes of the package file on the package.
+func setFileHashes(resource *jamfpro.ResourcePackage, file *packageFile) error {
+	hashes, err := file.Hashes()
+	if err != nil {
+		return fmt.Errorf("failed to calculate hashes of package file %s: %v", file.path, err)
+	}
+
+	resource.SHA3512 = hashes.SHA3512
+	resource.HashType = "SHA3_512"
+	resource.HashValue = hashes.SHA3512
+	resource.SHA256 = hashes.SHA256
+	resource.MD5 = hashes.MD5
+
+	return nil
+}
+
+// jcdsHashDiffers reports whether the file JCDS holds under the file name differs from the file
+// with the SHA3-512 hash, i.e. whether the file must be uploaded. The file is uploaded when JCDS
+// holds no file of that name or its files cannot be listed, e.g. on instances without JCDS.
+func jcdsHashDiffers(client *jamfpro.Client, fileName string, sha3512 string) bool {
+	files, err := client.GetJCDS2Packages()
+	if err != nil {
+		log.Printf("[WARN] Failed to list JCDS files, uploading package file %s: %v", fileName, err)
+		return true
+	}
+
+	for _, file := range files {
+		if file.FileName == fileName && strings.EqualFold(file.SHA3, sha3512) {
+			log.Printf("[INFO] JCDS already holds %s with SHA3-512 %s, skipping upload", fileName, sha3512)
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package packages_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest/fakejamfpro"
+	packages "github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/package"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jcdsClient returns a client configured against a fake Jamf Pro server whose JCDS holds files,
+// or fails to list them when files is nil.
+func jcdsClient(t *testing.T, files []map[string]any) *jamfpro.Client {
+	t.Helper()
+
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+	server.HandleFunc("GET /api/v1/jcds/files", func(w http.ResponseWriter, r *http.Request) {
+		if files == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(files)
+	})
+
+	t.Setenv("JAMFPRO_INSTANCE_FQDN", server.URL)
+	t.Setenv("JAMFPRO_AUTH_METHOD", "oauth2")
+	t.Setenv("JAMFPRO_AUTH_PROVIDER", "direct")
+	t.Setenv("JAMFPRO_CLIENT_ID", server.ClientID)
+	t.Setenv("JAMFPRO_CLIENT_SECRET", server.ClientSecret)
+
+	client, err := acctest.Client()
+	require.NoError(t, err)
+	return client
+}
+
+func TestJCDSHashDiffers(t *testing.T) {
+	files := []map[string]any{
+		{"fileName": "Firefox.pkg", "sha3": "ABC123"},
+		{"fileName": "Chrome.pkg", "sha3": "def456"},
+	}
+
+	tests := []struct {
+		name     string
+		files    []map[string]any
+		fileName string
+		sha3512  string
+		want     bool
+	}{
+		{name: "same file", files: files, fileName: "Firefox.pkg", sha3512: "abc123", want: false},
+		{name: "changed file", files: files, fileName: "Firefox.pkg", sha3512: "def456", want: true},
+		{name: "file not in JCDS", files: files, fileName: "Safari.pkg", sha3512: "abc123", want: true},
+		{name: "JCDS not available", files: nil, fileName: "Firefox.pkg", sha3512: "abc123", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := jcdsClient(t, tt.files)
+			assert.Equal(t, tt.want, packages.JCDSHashDiffers(client, tt.fileName, tt.sha3512))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package packages
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewPackageListResource creates the jamfpro_package list resource used by `terraform query`.
+func NewPackageListResource() list.ListResource {
+	return sdkv2_list.New("_package", ResourceJamfProPackages, listPackageItems)
+}
+
+// listPackageItems returns every package as list resource items.
+func listPackageItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	packages, err := listPackages(client)()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(packages))
+	for _, pkg := range packages {
+		items = append(items, sdkv2_list.Item{ID: pkg.ID, Name: pkg.PackageName})
+	}
+	return items, nil
+}
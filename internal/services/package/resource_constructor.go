@@ -10,32 +10,96 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/files"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/package_cache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// constructJamfProPackageCreate constructs a ResourcePackage object from the provided schema data.
+// packageFile is the local copy of a package file to upload.
+type packageFile struct {
+	path   string
+	hashes *package_cache.Hashes
+	cache  *package_cache.Cache
+}
+
+// Hashes returns the hashes of the file, calculating them on first use. Files downloaded into the
+// package cache come with their hashes, and the cache stores the hashes of local files.
+func (f *packageFile) Hashes() (package_cache.Hashes, error) {
+	if f.hashes == nil {
+		hashes, err := f.cache.HashFile(f.path)
+		if err != nil {
+			return package_cache.Hashes{}, err
+		}
+		f.hashes = &hashes
+	}
+	return *f.hashes, nil
+}
+
+// cleanup removes the file if it was downloaded to the temporary directory for this run.
+func (f *packageFile) cleanup(packageFileSource string) {
+	if f.cache.Contains(f.path) {
+		return
+	}
+	files.CleanupDownloadedPackage(packageFileSource, f.path)
+}
+
+// resolvePackageFile returns the local copy of the package file source, downloading it when the
+// source is a URL. Downloads go through the package cache when the provider has one. When
+// expected_sha256 is set, the file's SHA-256 must match it.
+func resolvePackageFile(d *schema.ResourceData, meta any) (*packageFile, error) {
+	source := d.Get("package_file_source").(string)
+	expectedSHA256 := d.Get("expected_sha256").(string)
+	file := &packageFile{path: source, cache: package_cache.ForMeta(meta)}
+
+	if strings.HasPrefix(source, "http") {
+		log.Printf("[INFO] URL detected: %s. Attempting to download.", source)
+		if file.cache != nil {
+			path, hashes, err := file.cache.Fetch(source, expectedSHA256)
+			if err != nil {
+				return nil, fmt.Errorf("failed to download file: %v", err)
+			}
+			file.path, file.hashes = path, &hashes
+		} else {
+			path, err := files.DownloadFile(source)
+			if err != nil {
+				return nil, fmt.Errorf("failed to download file: %v", err)
+			}
+			file.path = path
+		}
+		log.Printf("[INFO] Successfully downloaded file from URL: %s", source)
+	}
+
+	if expectedSHA256 != "" {
+		hashes, err := file.Hashes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate SHA-256: %v", err)
+		}
+		if !strings.EqualFold(hashes.SHA256, expectedSHA256) {
+			file.cleanup(source)
+			return nil, fmt.Errorf("package file %s has SHA-256 %s, which does not match expected_sha256 %s",
+				source, hashes.SHA256, strings.ToLower(expectedSHA256))
+		}
+	}
+
+	return file, nil
+}
+
+// construct constructs a ResourcePackage object from the provided schema data.
 // It extracts the filename from the full path provided in the schema and uses it for the FileName field.
 // If the full path is a URL, it downloads the file and uses the downloaded file path.
-// The function returns the constructed ResourcePackage, the local file path, and an error if any.
-func construct(d *schema.ResourceData) (*jamfpro.ResourcePackage, string, error) {
+// The function returns the constructed ResourcePackage, the local package file, which is nil for
+// metadata-only packages, and an error if any.
+func construct(d *schema.ResourceData, meta any) (*jamfpro.ResourcePackage, *packageFile, error) {
 	fullPath := d.Get("package_file_source").(string)
 	var fileName string
-	var localFilePath string
+	var file *packageFile
 	var err error
 
 	if fullPath != "" {
-		if strings.HasPrefix(fullPath, "http") {
-			log.Printf("[INFO] URL detected: %s. Attempting to download.", fullPath)
-			localFilePath, err = files.DownloadFile(fullPath)
-			if err != nil {
-				return nil, "", fmt.Errorf("failed to download file: %v", err)
-			}
-			fileName = filepath.Base(localFilePath)
-			log.Printf("[INFO] Successfully downloaded file from URL: %s", fullPath)
-		} else {
-			fileName = filepath.Base(fullPath)
-			localFilePath = fullPath
+		file, err = resolvePackageFile(d, meta)
+		if err != nil {
+			return nil, nil, err
 		}
+		fileName = filepath.Base(file.path)
 	} else {
 		fileName = d.Get("filename").(string)
 		log.Printf("[INFO] No package_file_source specified, creating metadata-only package with filename: %s", fileName)
@@ -83,10 +147,10 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourcePackage, string, error)
 
 	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to marshal Jamf Pro Package '%s' to JSON: %v", resource.FileName, err)
+		return nil, nil, fmt.Errorf("failed to marshal Jamf Pro Package '%s' to JSON: %v", resource.FileName, err)
 	}
 
 	log.Printf("[DEBUG] Constructed Jamf Pro Package JSON:\n%s\n", string(resourceJSON))
 
-	return resource, localFilePath, nil
+	return resource, file, nil
 }
@@ -5,11 +5,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
-	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/files"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -21,8 +22,8 @@ const PackagesMetaTimeout time.Duration = 10 * time.Minute
 
 // create handles the creation of a Jamf Pro package resource:
 // 1. Constructs the attribute data using the provided Terraform configuration.
-// 2. Calculates initial SHA3-512 hash of the package file.
-// 3. Calculates the MD5 hash of the package file.
+// 2. Calculates the SHA3-512, SHA-256 and MD5 hashes of the package file, or reuses them from the package cache.
+// 3. Checks the package file against expected_sha256 and require_signed, when set, and reads its metadata.
 // 4. Calls the API to create the package metadata in Jamf Pro.
 // 5. Uploads the package file to the Jamf Pro server.
 // 6. Verifies the uploaded package hash matches the initial hash.
@@ -35,31 +36,22 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 	var diags diag.Diagnostics
 	var packageID string
 
-	resource, localFilePath, err := construct(d)
+	resource, file, err := construct(d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Package: %v", err))
 	}
 
-	if localFilePath != "" {
-		initialHash, err := jamfpro.CalculateSHA3_512(localFilePath)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to calculate SHA3-512: %v", err))
-		}
-		resource.SHA3512 = initialHash
-		resource.HashType = "SHA3_512"
-		resource.HashValue = initialHash
-
-		sha256Hash, err := jamfpro.CalculateSHA256(localFilePath)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to calculate SHA-256: %v", err))
+	var pkgMetadata []any
+	if file != nil {
+		if err := setFileHashes(resource, file); err != nil {
+			return diag.FromErr(err)
 		}
-		resource.SHA256 = sha256Hash
 
-		md5Hash, err := jamfpro.CalculateMD5(localFilePath)
+		pkgMetadata, err = inspectPackageFile(file.path, d.Get("require_signed").(bool))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to calculate MD5: %v", err))
+			file.cleanup(d.Get("package_file_source").(string))
+			return diag.FromErr(err)
 		}
-		resource.MD5 = md5Hash
 	}
 
 	// Meta
@@ -82,12 +74,12 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 	}
 
 	// Package file upload (skipped for metadata-only packages)
-	if localFilePath != "" {
+	if file != nil {
+		defer client.HTTP.ModifyHttpTimeout(client.HTTP.HttpTimeout())
 		client.HTTP.ModifyHttpTimeout(d.Timeout(schema.TimeoutCreate))
-		defer client.HTTP.ResetTimeout()
 
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-			_, err = client.UploadPackage(packageID, []string{localFilePath})
+			_, err = client.UploadPackage(packageID, []string{file.path})
 
 			if err != nil {
 				log.Printf("[ERROR] Failed to upload package file '%s': %v", resource.FileName, err)
@@ -114,11 +106,15 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 			return diag.FromErr(fmt.Errorf("failed to verify Jamf Pro Package '%s': %v", resource.PackageName, err))
 		}
 
-		files.CleanupDownloadedPackage(d.Get("package_file_source").(string), localFilePath)
+		file.cleanup(d.Get("package_file_source").(string))
 	}
 
 	d.SetId(packageID)
 
+	if err := d.Set("pkg_metadata", pkgMetadata); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
 
@@ -129,10 +125,17 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 	var diags diag.Diagnostics
 
 	var response *jamfpro.ResourcePackage
+	getPackage := read_cache.Getter(
+		meta,
+		"package",
+		listPackages(client),
+		func(r *jamfpro.ResourcePackage) string { return r.ID },
+		client.GetPackageByID,
+	)
 
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
-		response, apiErr = client.GetPackageByID(resourceID)
+		response, apiErr = getPackage(resourceID)
 		if apiErr != nil {
 			return retry.RetryableError(apiErr)
 		}
@@ -143,7 +146,23 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		return append(diags, errors.HandleResourceNotFoundError(err, d, cleanup)...)
 	}
 
-	return append(diags, updateState(d, response)...)
+	diags = append(diags, updateState(d, response)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, crud.SetIDIdentity(d)...)
+}
+
+// listPackages returns every package for the read cache prefetch.
+func listPackages(client *jamfpro.Client) func() ([]jamfpro.ResourcePackage, error) {
+	return func() ([]jamfpro.ResourcePackage, error) {
+		response, err := client.GetPackages("", "")
+		if err != nil {
+			return nil, err
+		}
+		return response.Results, nil
+	}
 }
 
 // readWithCleanup reads the resource with cleanup enabled
@@ -159,7 +178,7 @@ func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 // update handles the updating of a Jamf Pro package resource:
 //  1. Constructs the updated attribute data from the Terraform configuration.
 //  2. If the file has changed, calculates SHA3-512, SHA-256, and MD5 hashes of the new package file.
-//  3. If the file was changed, uploads the new package file to JCDS.
+//  3. If the file was changed and JCDS does not already hold it, uploads the new package file to JCDS.
 //  4. Updates the package metadata in Jamf Pro (with new hashes if file changed).
 //  5. Refreshes the JCDS inventory for the package file to trigger reprocessing.
 //  6. Verifies the uploaded package hash matches the expected hash.
@@ -170,35 +189,38 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, localFilePath, err := construct(d)
+	resource, file, err := construct(d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Package for update: %v", err))
 	}
 
 	// Check if this is a file-related update or metadata-only update
-	fileChanged := localFilePath != "" && (d.HasChange("package_file_source") || d.HasChange("hash_value") || d.HasChange("package_file_source_checksum"))
+	fileChanged := file != nil && (d.HasChange("package_file_source") || d.HasChange("hash_value") ||
+		d.HasChange("package_file_source_checksum") || d.HasChange("expected_sha256"))
 
 	// Compute new hashes if file changed
 	if fileChanged {
-		newSHA3512, err := jamfpro.CalculateSHA3_512(localFilePath)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to calculate SHA3-512 hash for %s: %v", localFilePath, err))
+		if err := setFileHashes(resource, file); err != nil {
+			return diag.FromErr(err)
 		}
-		resource.SHA3512 = newSHA3512
-		resource.HashType = "SHA3_512"
-		resource.HashValue = newSHA3512
+	}
 
-		newSHA256, err := jamfpro.CalculateSHA256(localFilePath)
+	// Read the package metadata, which the plan already holds for local files, and check
+	// require_signed before anything is uploaded
+	var pkgMetadata []any
+	inspectFile := file != nil && (fileChanged || d.HasChange("require_signed") || !strings.HasPrefix(d.Get("package_file_source").(string), "http"))
+	if inspectFile {
+		pkgMetadata, err = inspectPackageFile(file.path, d.Get("require_signed").(bool))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to calculate SHA-256: %v", err))
+			file.cleanup(d.Get("package_file_source").(string))
+			return diag.FromErr(err)
 		}
-		resource.SHA256 = newSHA256
+	}
 
-		newMD5, err := jamfpro.CalculateMD5(localFilePath)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to calculate MD5: %v", err))
-		}
-		resource.MD5 = newMD5
+	// Skip the upload when JCDS already holds the file, e.g. when only the URL changed
+	uploadRequired := fileChanged
+	if fileChanged {
+		uploadRequired = jcdsHashDiffers(client, resource.FileName, resource.SHA3512)
 	}
 
 	// Metadata PUT — sends new hashes and any other metadata changes
@@ -216,13 +238,15 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 			resource.PackageName, resourceID, err))
 	}
 
+	read_cache.Invalidate(meta, resourceID)
+
 	// Upload package file
-	if fileChanged {
+	if uploadRequired {
+		defer client.HTTP.ModifyHttpTimeout(client.HTTP.HttpTimeout())
 		client.HTTP.ModifyHttpTimeout(d.Timeout(schema.TimeoutUpdate))
-		defer client.HTTP.ResetTimeout()
 
 		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-			_, err := client.UploadPackage(resourceID, []string{localFilePath})
+			_, err := client.UploadPackage(resourceID, []string{file.path})
 			if err != nil {
 				return retry.RetryableError(fmt.Errorf("failed to upload package file: %v", err))
 			}
@@ -236,13 +260,21 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 	}
 
 	// Verify upload (refresh is called inside the verify retry loop)
-	if fileChanged {
+	if uploadRequired {
 		if err := verifyPackageUpload(ctx, client, resourceID, resource.FileName, resource.SHA3512,
 			d.Timeout(schema.TimeoutUpdate), false, true); err != nil {
 			return diag.FromErr(fmt.Errorf("failed to verify updated package file: %v", err))
 		}
+	}
 
-		files.CleanupDownloadedPackage(d.Get("package_file_source").(string), localFilePath)
+	if file != nil {
+		file.cleanup(d.Get("package_file_source").(string))
+	}
+
+	if inspectFile {
+		if err := d.Set("pkg_metadata", pkgMetadata); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
 	}
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
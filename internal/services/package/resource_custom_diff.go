@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/package_cache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,6 +20,10 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta any
 		return err
 	}
 
+	if err := inspectPackageMetadata(ctx, diff, meta); err != nil {
+		return err
+	}
+
 	return computeFileHash(ctx, diff, meta)
 }
 
@@ -64,8 +68,8 @@ func customValidateFilePath(_ context.Context, d *schema.ResourceDiff, _ any) er
 	return nil
 }
 
-// computeFileHash calculates the SHA3-512 hash of the local package file during the plan phase
-// and compares it against the hash_value in state (JCDS-computed SHA3-512). If they differ,
+// computeFileHash calculates the SHA3-512 hash of the local package file during the plan phase,
+// or reuses it from the package cache, and compares it against the hash_value in state (JCDS-computed SHA3-512). If they differ,
 // hash_value is updated which triggers a file re-upload during update.
 //
 // Guards:
@@ -73,7 +77,7 @@ func customValidateFilePath(_ context.Context, d *schema.ResourceDiff, _ any) er
 //   - Skips HTTP/HTTPS sources — URL string changes already trigger updates.
 //   - Skips when hash_value in state is empty — JCDS hasn't computed the hash yet,
 //     or this is a provider upgrade where the field hasn't been populated yet.
-func computeFileHash(_ context.Context, d *schema.ResourceDiff, _ any) error {
+func computeFileHash(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	if d.Id() == "" {
 		return nil
 	}
@@ -92,16 +96,41 @@ func computeFileHash(_ context.Context, d *schema.ResourceDiff, _ any) error {
 		return nil
 	}
 
-	localHash, err := jamfpro.CalculateSHA3_512(filePath)
+	hashes, err := package_cache.ForMeta(meta).HashFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to calculate SHA3-512 hash of package file: %v", err)
 	}
 
-	if localHash != currentHash {
-		if err := d.SetNew("hash_value", localHash); err != nil {
+	if hashes.SHA3512 != currentHash {
+		if err := d.SetNew("hash_value", hashes.SHA3512); err != nil {
 			return fmt.Errorf("failed to set hash_value: %v", err)
 		}
 	}
 
 	return nil
 }
+
+// inspectPackageMetadata reads the metadata of a local package file during the plan phase, so that
+// pkg_metadata is known and require_signed fails the plan for packages without a valid signature.
+// The metadata of packages downloaded from a URL is only known once they are downloaded, so it is
+// marked as unknown when the download may have changed.
+func inspectPackageMetadata(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	filePath, ok := d.Get("package_file_source").(string)
+	if !ok || filePath == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(filePath, "http") {
+		if d.Id() == "" || d.HasChange("package_file_source") || d.HasChange("package_file_source_checksum") || d.HasChange("expected_sha256") || d.HasChange("require_signed") {
+			return d.SetNewComputed("pkg_metadata")
+		}
+		return nil
+	}
+
+	metadata, err := inspectPackageFile(filePath, d.Get("require_signed").(bool))
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("pkg_metadata", metadata)
+}
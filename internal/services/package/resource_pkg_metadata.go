@@ -0,0 +1,145 @@
+package packages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/flat_package"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pkgMetadataSchema describes the metadata read from a flat package file.
+func pkgMetadataSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The product identifier from the Distribution file, or the package identifier from PackageInfo for component packages.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The product or package version.",
+			},
+			"bundle_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The identifiers of the bundles installed by the package.",
+			},
+			"minimum_os_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lowest macOS version allowed by the Distribution file.",
+			},
+			"install_location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The install location of the first component package.",
+			},
+			"signed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the package has a signature.",
+			},
+			"signature_valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the signature matches the package and was made with a Developer ID Installer certificate whose chain leads to the Apple Root CA.",
+			},
+			"notarized": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the package ends with the trailer of a stapled notarization ticket. The ticket is neither parsed nor checked with Apple, so this does not establish that the package is notarized.",
+			},
+			"signing_certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The signing certificate chain, leaf first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sha256_fingerprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// isFlatPackagePath reports whether the package file is a flat package, whose metadata can be read.
+func isFlatPackagePath(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".pkg")
+}
+
+// inspectPackageFile returns the pkg_metadata state of the package file, which is empty for files
+// other than flat packages. When requireSigned is set, the package must be a flat package with a
+// valid signature.
+func inspectPackageFile(path string, requireSigned bool) ([]any, error) {
+	if !isFlatPackagePath(path) {
+		if requireSigned {
+			return nil, fmt.Errorf("require_signed is set, but %s is not a flat .pkg package whose signature can be checked", path)
+		}
+		return []any{}, nil
+	}
+
+	metadata, err := flat_package.Inspect(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package metadata: %v", err)
+	}
+
+	if requireSigned && !metadata.SignatureValid {
+		reason := "it is not signed"
+		if metadata.Signed {
+			reason = metadata.SignatureError
+		}
+		return nil, fmt.Errorf("require_signed is set, but package %s does not have a valid signature: %s", path, reason)
+	}
+
+	return flattenPkgMetadata(metadata), nil
+}
+
+// flattenPkgMetadata returns the pkg_metadata state of the metadata.
+func flattenPkgMetadata(metadata *flat_package.Metadata) []any {
+	certificates := make([]any, 0, len(metadata.Certificates))
+	for _, cert := range metadata.Certificates {
+		certificates = append(certificates, map[string]any{
+			"subject":            cert.Subject,
+			"issuer":             cert.Issuer,
+			"sha256_fingerprint": cert.SHA256Fingerprint,
+			"not_after":          cert.NotAfter,
+		})
+	}
+
+	bundleIDs := make([]any, 0, len(metadata.BundleIDs))
+	for _, id := range metadata.BundleIDs {
+		bundleIDs = append(bundleIDs, id)
+	}
+
+	return []any{map[string]any{
+		"identifier":           metadata.Identifier,
+		"version":              metadata.Version,
+		"bundle_ids":           bundleIDs,
+		"minimum_os_version":   metadata.MinimumOSVersion,
+		"install_location":     metadata.InstallLocation,
+		"signed":               metadata.Signed,
+		"signature_valid":      metadata.SignatureValid,
+		"notarized":            metadata.Notarized,
+		"signing_certificates": certificates,
+	}}
+}
@@ -0,0 +1,28 @@
+package packages
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspectPackageFile(t *testing.T) {
+	dir := t.TempDir()
+	dmg := filepath.Join(dir, "Agent.dmg")
+	require.NoError(t, os.WriteFile(dmg, []byte("disk image"), 0o644))
+	pkg := filepath.Join(dir, "Agent.pkg")
+	require.NoError(t, os.WriteFile(pkg, []byte("bundle, not flat"), 0o644))
+
+	metadata, err := inspectPackageFile(dmg, false)
+	require.NoError(t, err)
+	assert.Empty(t, metadata, "only flat packages have metadata")
+
+	_, err = inspectPackageFile(dmg, true)
+	assert.ErrorContains(t, err, "not a flat .pkg")
+
+	_, err = inspectPackageFile(pkg, false)
+	assert.ErrorContains(t, err, "not a flat package")
+}
@@ -2,10 +2,13 @@
 package packages
 
 import (
+	"regexp"
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceJamfProPackages defines the schema and CRUD operations for managing Jamf Pro Packages in Terraform.
@@ -23,8 +26,9 @@ func ResourceJamfProPackages() *schema.Resource {
 		},
 		CustomizeDiff: mainCustomDiffFunc,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -54,6 +58,25 @@ func ResourceJamfProPackages() *schema.Resource {
 				Description:   "An optional checksum value for the package file source. Used to trigger a re-upload when the file content changes at an HTTP/HTTPS URL without the URL itself changing. For local files, content changes are detected automatically via SHA-256. Set this to any value that changes when the remote file changes (e.g., a SHA-256 hash, version string, or build number).",
 				ConflictsWith: []string{"md5", "sha256", "sha3512", "hash_type", "hash_value"},
 			},
+			"expected_sha256": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"package_file_source"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a hex encoded SHA-256 hash"),
+				Description:  "The SHA-256 hash the package file source must have. The apply fails before anything is uploaded when the file does not match. Changing it triggers a re-upload, like package_file_source_checksum. When the provider's package_cache_dir is set, a cached download with this hash is reused without contacting the source.",
+			},
+			"require_signed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the package file source must be a flat .pkg with a valid signature made with a Developer ID Installer certificate chaining to the Apple Root CA. Local files are checked at plan time, and files downloaded from a URL before they are uploaded.",
+			},
+			"pkg_metadata": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Metadata read from the package file source when it is a flat .pkg: identifiers, versions, requirements and the state of its signature.",
+				Elem:        pkgMetadataSchema(),
+			},
 			"category_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
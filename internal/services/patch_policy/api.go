@@ -0,0 +1,141 @@
+package patch_policy
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// The SDK reads patch policies from the Jamf Pro API, which returns neither their scope nor most
+// of their user interaction settings, and its Classic API request only scopes all computers. Patch
+// policies are therefore read and written through the Classic API with the types below.
+const uriPatchPolicies = "/JSSResource/patchpolicies"
+
+// resourcePatchPolicy is a Classic API patch policy.
+type resourcePatchPolicy struct {
+	XMLName                      xml.Name                         `xml:"patch_policy"`
+	General                      patchPolicySubsetGeneral         `xml:"general"`
+	Scope                        patchPolicySubsetScope           `xml:"scope"`
+	UserInteraction              patchPolicySubsetUserInteraction `xml:"user_interaction"`
+	SoftwareTitleConfigurationID int                              `xml:"software_title_configuration_id"`
+}
+
+// patchPolicySubsetGeneral holds the general settings of a patch policy. The release date,
+// minimum OS, reboot and incremental update settings come from the definition of the target
+// version and are only read.
+type patchPolicySubsetGeneral struct {
+	ID                 int    `xml:"id,omitempty"`
+	Name               string `xml:"name"`
+	Enabled            bool   `xml:"enabled"`
+	TargetVersion      string `xml:"target_version"`
+	ReleaseDate        string `xml:"release_date,omitempty"`
+	IncrementalUpdates bool   `xml:"incremental_updates,omitempty"`
+	Reboot             bool   `xml:"reboot,omitempty"`
+	MinimumOS          string `xml:"minimum_os,omitempty"`
+	DistributionMethod string `xml:"distribution_method"`
+	AllowDowngrade     bool   `xml:"allow_downgrade"`
+	PatchUnknown       bool   `xml:"patch_unknown"`
+}
+
+// patchPolicySubsetScope is the scope of a patch policy, which targets computers only.
+type patchPolicySubsetScope struct {
+	AllComputers   bool                                `xml:"all_computers"`
+	Computers      []jamfpro.PolicySubsetComputer      `xml:"computers>computer"`
+	ComputerGroups []jamfpro.PolicySubsetComputerGroup `xml:"computer_groups>computer_group"`
+	Buildings      []jamfpro.PolicySubsetBuilding      `xml:"buildings>building"`
+	Departments    []jamfpro.PolicySubsetDepartment    `xml:"departments>department"`
+	Limitations    patchPolicySubsetLimitations        `xml:"limitations"`
+	Exclusions     patchPolicySubsetExclusions         `xml:"exclusions"`
+}
+
+type patchPolicySubsetLimitations struct {
+	NetworkSegments []jamfpro.PolicySubsetNetworkSegment `xml:"network_segments>network_segment"`
+	IBeacons        []jamfpro.PolicySubsetIBeacon        `xml:"ibeacons>ibeacon"`
+	Users           []jamfpro.PolicySubsetUser           `xml:"users>user"`
+	UserGroups      []jamfpro.PolicySubsetUserGroup      `xml:"user_groups>user_group"`
+}
+
+type patchPolicySubsetExclusions struct {
+	Computers       []jamfpro.PolicySubsetComputer       `xml:"computers>computer"`
+	ComputerGroups  []jamfpro.PolicySubsetComputerGroup  `xml:"computer_groups>computer_group"`
+	Buildings       []jamfpro.PolicySubsetBuilding       `xml:"buildings>building"`
+	Departments     []jamfpro.PolicySubsetDepartment     `xml:"departments>department"`
+	NetworkSegments []jamfpro.PolicySubsetNetworkSegment `xml:"network_segments>network_segment"`
+	JSSUsers        []jamfpro.PolicySubsetJSSUser        `xml:"jss_users>user"`
+	JSSUserGroups   []jamfpro.PolicySubsetJSSUserGroup   `xml:"jss_user_groups>user_group"`
+	IBeacons        []jamfpro.PolicySubsetIBeacon        `xml:"ibeacons>ibeacon"`
+	Users           []jamfpro.PolicySubsetUser           `xml:"users>user"`
+	UserGroups      []jamfpro.PolicySubsetUserGroup      `xml:"user_groups>user_group"`
+}
+
+// patchPolicySubsetUserInteraction holds the Self Service, notification, deadline and grace period
+// settings of a patch policy.
+type patchPolicySubsetUserInteraction struct {
+	InstallButtonText      string                                                `xml:"install_button_text"`
+	SelfServiceDescription string                                                `xml:"self_service_description"`
+	SelfServiceIcon        *patchPolicySelfServiceIcon                           `xml:"self_service_icon,omitempty"`
+	Notifications          jamfpro.ResourcePatchPolicyCreateRequestNotifications `xml:"notifications"`
+	Deadlines              jamfpro.ResourcePatchPolicyCreateRequestDeadlines     `xml:"deadlines"`
+	GracePeriod            jamfpro.ResourcePatchPolicyCreateRequestGracePeriod   `xml:"grace_period"`
+}
+
+type patchPolicySelfServiceIcon struct {
+	ID int `xml:"id"`
+}
+
+// responsePatchPolicyID is the response to a Classic API patch policy create or update.
+type responsePatchPolicyID struct {
+	ID int `xml:"id"`
+}
+
+// createPatchPolicy returns a function creating a patch policy of its software title
+// configuration.
+func createPatchPolicy(client *jamfpro.Client) func(*resourcePatchPolicy) (*responsePatchPolicyID, error) {
+	return func(policy *resourcePatchPolicy) (*responsePatchPolicyID, error) {
+		endpoint := fmt.Sprintf("%s/softwaretitleconfig/id/%d", uriPatchPolicies, policy.SoftwareTitleConfigurationID)
+
+		var out responsePatchPolicyID
+		resp, err := client.HTTP.DoRequest("POST", endpoint, policy, &out)
+		if resp != nil {
+			defer resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create patch policy %q: %v", policy.General.Name, err)
+		}
+
+		return &out, nil
+	}
+}
+
+// getPatchPolicy returns a function reading a patch policy.
+func getPatchPolicy(client *jamfpro.Client) func(string) (*resourcePatchPolicy, error) {
+	return func(id string) (*resourcePatchPolicy, error) {
+		var out resourcePatchPolicy
+		resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/id/%s", uriPatchPolicies, id), nil, &out)
+		if resp != nil {
+			defer resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read patch policy %s: %v", id, err)
+		}
+
+		return &out, nil
+	}
+}
+
+// updatePatchPolicy returns a function updating a patch policy.
+func updatePatchPolicy(client *jamfpro.Client) func(string, *resourcePatchPolicy) (*responsePatchPolicyID, error) {
+	return func(id string, policy *resourcePatchPolicy) (*responsePatchPolicyID, error) {
+		var out responsePatchPolicyID
+		resp, err := client.HTTP.DoRequest("PUT", fmt.Sprintf("%s/id/%s", uriPatchPolicies, id), policy, &out)
+		if resp != nil {
+			defer resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to update patch policy %s: %v", id, err)
+		}
+
+		return &out, nil
+	}
+}
@@ -0,0 +1,125 @@
+package patch_policy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a Classic API patch policy from the provided schema data.
+func construct(d *schema.ResourceData) (*resourcePatchPolicy, error) {
+	configurationID, err := strconv.Atoi(d.Get("software_title_configuration_id").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid software_title_configuration_id: %v", err)
+	}
+
+	resource := &resourcePatchPolicy{
+		General: patchPolicySubsetGeneral{
+			Name:               d.Get("name").(string),
+			Enabled:            d.Get("enabled").(bool),
+			TargetVersion:      d.Get("target_version").(string),
+			DistributionMethod: d.Get("distribution_method").(string),
+			AllowDowngrade:     d.Get("allow_downgrade").(bool),
+			PatchUnknown:       d.Get("patch_unknown_versions").(bool),
+		},
+		SoftwareTitleConfigurationID: configurationID,
+	}
+
+	if err := constructScope(d, &resource.Scope); err != nil {
+		return nil, err
+	}
+
+	constructUserInteraction(d, &resource.UserInteraction)
+
+	return resource, nil
+}
+
+// constructScope builds the scope of the patch policy from the shared computer scope block.
+func constructScope(d *schema.ResourceData, scope *patchPolicySubsetScope) error {
+	if len(d.Get("scope").([]any)) == 0 {
+		return nil
+	}
+
+	scope.AllComputers = d.Get("scope.0.all_computers").(bool)
+
+	mappings := []error{
+		constructors.MapSetToStructs[jamfpro.PolicySubsetComputer, int]("scope.0.computer_ids", "ID", d, &scope.Computers),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetComputerGroup, int]("scope.0.computer_group_ids", "ID", d, &scope.ComputerGroups),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetBuilding, int]("scope.0.building_ids", "ID", d, &scope.Buildings),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetDepartment, int]("scope.0.department_ids", "ID", d, &scope.Departments),
+
+		constructors.MapSetToStructs[jamfpro.PolicySubsetNetworkSegment, int]("scope.0.limitations.0.network_segment_ids", "ID", d, &scope.Limitations.NetworkSegments),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetIBeacon, int]("scope.0.limitations.0.ibeacon_ids", "ID", d, &scope.Limitations.IBeacons),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetUser, string]("scope.0.limitations.0.directory_service_or_local_usernames", "Name", d, &scope.Limitations.Users),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetUserGroup, string]("scope.0.limitations.0.directory_service_usergroup_names", "Name", d, &scope.Limitations.UserGroups),
+
+		constructors.MapSetToStructs[jamfpro.PolicySubsetComputer, int]("scope.0.exclusions.0.computer_ids", "ID", d, &scope.Exclusions.Computers),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetComputerGroup, int]("scope.0.exclusions.0.computer_group_ids", "ID", d, &scope.Exclusions.ComputerGroups),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetBuilding, int]("scope.0.exclusions.0.building_ids", "ID", d, &scope.Exclusions.Buildings),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetDepartment, int]("scope.0.exclusions.0.department_ids", "ID", d, &scope.Exclusions.Departments),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetNetworkSegment, int]("scope.0.exclusions.0.network_segment_ids", "ID", d, &scope.Exclusions.NetworkSegments),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetJSSUser, int]("scope.0.exclusions.0.jss_user_ids", "ID", d, &scope.Exclusions.JSSUsers),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetJSSUserGroup, int]("scope.0.exclusions.0.jss_user_group_ids", "ID", d, &scope.Exclusions.JSSUserGroups),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetIBeacon, int]("scope.0.exclusions.0.ibeacon_ids", "ID", d, &scope.Exclusions.IBeacons),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetUser, string]("scope.0.exclusions.0.directory_service_or_local_usernames", "Name", d, &scope.Exclusions.Users),
+		constructors.MapSetToStructs[jamfpro.PolicySubsetUserGroup, string]("scope.0.exclusions.0.directory_service_usergroup_names", "Name", d, &scope.Exclusions.UserGroups),
+	}
+
+	for _, err := range mappings {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// constructUserInteraction builds the Self Service, notification, deadline and grace period
+// settings. Notifications and the deadline are disabled when their blocks are unset.
+func constructUserInteraction(d *schema.ResourceData, out *patchPolicySubsetUserInteraction) {
+	out.InstallButtonText = defaultInstallButtonText
+	if v, ok := d.GetOk("self_service.0"); ok {
+		selfService := v.(map[string]any)
+		out.InstallButtonText = selfService["install_button_text"].(string)
+		out.SelfServiceDescription = selfService["description"].(string)
+		if iconID := selfService["icon_id"].(int); iconID > 0 {
+			out.SelfServiceIcon = &patchPolicySelfServiceIcon{ID: iconID}
+		}
+	}
+
+	if v, ok := d.GetOk("notifications.0"); ok {
+		notifications := v.(map[string]any)
+		frequency := notifications["reminder_frequency_days"].(int)
+		out.Notifications = jamfpro.ResourcePatchPolicyCreateRequestNotifications{
+			Enabled: true,
+			Type:    notifications["type"].(string),
+			Subject: notifications["subject"].(string),
+			Message: notifications["message"].(string),
+			Reminders: jamfpro.ResourcePatchPolicyCreateRequestReminders{
+				Enabled:   frequency > 0,
+				Frequency: frequency,
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("deadline.0.period_days"); ok {
+		out.Deadlines = jamfpro.ResourcePatchPolicyCreateRequestDeadlines{Enabled: true, Period: v.(int)}
+	}
+
+	out.GracePeriod = jamfpro.ResourcePatchPolicyCreateRequestGracePeriod{
+		Duration:            defaultGracePeriodMinutes,
+		NotificationSubject: defaultGracePeriodSubject,
+		Message:             defaultGracePeriodMessage,
+	}
+	if v, ok := d.GetOk("grace_period.0"); ok {
+		gracePeriod := v.(map[string]any)
+		out.GracePeriod = jamfpro.ResourcePatchPolicyCreateRequestGracePeriod{
+			Duration:            gracePeriod["duration_minutes"].(int),
+			NotificationSubject: gracePeriod["notification_subject"].(string),
+			Message:             gracePeriod["message"].(string),
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package patch_policy
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstruct(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPatchPolicies().Schema, map[string]any{
+		"name":                            "Google Chrome",
+		"software_title_configuration_id": "12",
+		"target_version":                  "126.0.6478.127",
+		"distribution_method":             "selfservice",
+		"scope": []any{map[string]any{
+			"computer_group_ids": []any{3, 1},
+			"limitations":        []any{map[string]any{"network_segment_ids": []any{5}}},
+			"exclusions":         []any{map[string]any{"computer_ids": []any{9}, "jss_user_ids": []any{2}}},
+		}},
+		"self_service":  []any{map[string]any{"description": "Updates Chrome", "icon_id": 40}},
+		"notifications": []any{map[string]any{"subject": "Chrome update", "reminder_frequency_days": 2}},
+		"deadline":      []any{map[string]any{"period_days": 7}},
+	})
+
+	policy, err := construct(d)
+	require.NoError(t, err)
+
+	assert.Equal(t, 12, policy.SoftwareTitleConfigurationID)
+	assert.True(t, policy.General.Enabled)
+	assert.Equal(t, "selfservice", policy.General.DistributionMethod)
+	assert.Len(t, policy.Scope.ComputerGroups, 2)
+	assert.Equal(t, 5, policy.Scope.Limitations.NetworkSegments[0].ID)
+	assert.Equal(t, 2, policy.Scope.Exclusions.JSSUsers[0].ID)
+
+	ui := policy.UserInteraction
+	assert.Equal(t, "Update", ui.InstallButtonText)
+	assert.Equal(t, 40, ui.SelfServiceIcon.ID)
+	assert.True(t, ui.Notifications.Enabled)
+	assert.Equal(t, "Self Service", ui.Notifications.Type)
+	assert.True(t, ui.Notifications.Reminders.Enabled)
+	assert.True(t, ui.Deadlines.Enabled)
+	assert.Equal(t, 7, ui.Deadlines.Period)
+	assert.Equal(t, 15, ui.GracePeriod.Duration, "the grace period defaults when unset")
+
+	body, err := xml.Marshal(policy)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "<computer_group><id>1</id></computer_group>")
+	assert.Contains(t, string(body), "<computers></computers>", "empty targets are sent, clearing them on update")
+	assert.NotContains(t, string(body), "<release_date>", "values of the version definition are not sent")
+}
+
+func TestConstructDefaults(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPatchPolicies().Schema, map[string]any{
+		"name":                            "Firefox",
+		"software_title_configuration_id": "3",
+		"target_version":                  "128.0",
+		"scope":                           []any{map[string]any{"all_computers": true}},
+	})
+
+	policy, err := construct(d)
+	require.NoError(t, err)
+
+	assert.True(t, policy.Scope.AllComputers)
+	assert.Equal(t, "prompt", policy.General.DistributionMethod)
+	assert.False(t, policy.UserInteraction.Notifications.Enabled)
+	assert.False(t, policy.UserInteraction.Deadlines.Enabled)
+	assert.Nil(t, policy.UserInteraction.SelfServiceIcon)
+}
+
+func TestFlatten(t *testing.T) {
+	const body = `<patch_policy>
+  <general><id>4</id><name>Firefox</name><target_version>128.0</target_version></general>
+  <scope>
+    <all_computers>false</all_computers>
+    <computers><computer><id>8</id><name>lab-01</name></computer></computers>
+    <exclusions><users><user><name>kiosk</name></user></users></exclusions>
+  </scope>
+  <user_interaction>
+    <install_button_text>Update</install_button_text>
+    <notifications>
+      <notification_enabled>true</notification_enabled>
+      <notification_type>Self Service</notification_type>
+      <reminders><notification_reminders_enabled>false</notification_reminders_enabled><notification_reminder_frequency>1</notification_reminder_frequency></reminders>
+    </notifications>
+    <deadlines><deadline_enabled>false</deadline_enabled><deadline_period>7</deadline_period></deadlines>
+    <grace_period><grace_period_duration>30</grace_period_duration></grace_period>
+  </user_interaction>
+  <software_title_configuration_id>3</software_title_configuration_id>
+</patch_policy>`
+
+	var policy resourcePatchPolicy
+	require.NoError(t, xml.Unmarshal([]byte(body), &policy))
+
+	scope := flattenScope(policy.Scope)
+	assert.Equal(t, []int{8}, scope["computer_ids"])
+	assert.NotContains(t, scope, "limitations", "empty limitations are omitted")
+	assert.Equal(t, []string{"kiosk"}, scope["exclusions"].([]any)[0].(map[string]any)["directory_service_or_local_usernames"])
+
+	ui := flattenUserInteraction(policy.UserInteraction)
+	notifications := ui["notifications"].([]any)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, 0, notifications[0].(map[string]any)["reminder_frequency_days"], "disabled reminders have no frequency")
+	assert.Empty(t, ui["deadline"], "a disabled deadline is not set")
+	assert.Equal(t, 30, ui["grace_period"].([]any)[0].(map[string]any)["duration_minutes"])
+}
@@ -0,0 +1,66 @@
+package patch_policy
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating a new Jamf Pro Patch Policy in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		createPatchPolicy(meta.(*jamfpro.Client)),
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Patch Policy from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		getPatchPolicy(meta.(*jamfpro.Client)),
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Patch Policy on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		updatePatchPolicy(meta.(*jamfpro.Client)),
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Patch Policy.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeletePatchPolicyByID,
+	)
+}
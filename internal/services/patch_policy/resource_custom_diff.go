@@ -0,0 +1,29 @@
+package patch_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc rejects scope targets a patch policy cannot have.
+func mainCustomDiffFunc(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	return validateComputerOnlyScope(d)
+}
+
+// validateComputerOnlyScope returns an error when the scope targets Jamf Pro users, which patch
+// policies do not support. Jamf Pro users can still be excluded.
+func validateComputerOnlyScope(d *schema.ResourceDiff) error {
+	if d.Get("scope.0.all_jss_users").(bool) {
+		return fmt.Errorf("scope.0.all_jss_users: patch policies target computers and cannot target all Jamf Pro users")
+	}
+
+	for _, key := range []string{"jss_user_ids", "jss_user_group_ids"} {
+		if set, ok := d.Get("scope.0." + key).(*schema.Set); ok && set.Len() > 0 {
+			return fmt.Errorf("scope.0.%s: patch policies target computers and cannot target Jamf Pro users, use scope.0.exclusions.0.%s to exclude them", key, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package patch_policy
+
+import (
+	"regexp"
+	"time"
+
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Distribution methods of a patch policy.
+const (
+	distributionMethodPrompt      = "prompt"
+	distributionMethodSelfService = "selfservice"
+)
+
+// Defaults Jamf Pro gives the user interaction settings of a new patch policy.
+const (
+	defaultInstallButtonText  = "Update"
+	defaultGracePeriodMinutes = 15
+	defaultGracePeriodSubject = "Important"
+	defaultGracePeriodMessage = "$APP_NAMES will quit in $DELAY_MINUTES minutes so that $SOFTWARE_TITLE can be updated. Save anything you are working on and quit the app(s)."
+	defaultNotificationType   = "Self Service"
+)
+
+// ResourceJamfProPatchPolicies defines the schema and CRUD operations for managing Jamf Pro patch
+// policies in Terraform.
+func ResourceJamfProPatchPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
+		},
+		Identity: crud.IDIdentity(),
+		Description: "Manages a patch policy, which updates the computers in scope to a version of the title of a " +
+			"`jamfpro_patch_software_title_configuration`, using the package the configuration maps to that version.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Jamf Pro unique identifier (ID) of the patch policy.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The name of the patch policy.",
+			},
+			"software_title_configuration_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be the numeric ID of a software title configuration"),
+				Description:  "The ID of the `jamfpro_patch_software_title_configuration` the policy patches.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the patch policy is enabled.",
+			},
+			"target_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The version of the title to update computers to. The software title configuration must map a package to it.",
+			},
+			"distribution_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      distributionMethodPrompt,
+				ValidateFunc: validation.StringInSlice([]string{distributionMethodPrompt, distributionMethodSelfService}, false),
+				Description:  "How the update is distributed: `prompt` installs it automatically, prompting users to quit the application first, and `selfservice` makes it available in Self Service.",
+			},
+			"allow_downgrade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether computers with a later version than the target version are downgraded to it.",
+			},
+			"patch_unknown_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether computers with a version of the title unknown to the patch source are updated.",
+			},
+			"release_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The release date of the target version.",
+			},
+			"minimum_os": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The minimum macOS version the target version requires.",
+			},
+			"reboot_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether installing the target version requires a restart.",
+			},
+			"incremental_updates": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the target version must be installed over each earlier version in turn.",
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Required:    true,
+				Description: "Scope configuration for the patch policy. Patch policies target computers only, so `all_jss_users`, `jss_user_ids` and `jss_user_group_ids` cannot be set, other than as exclusions.",
+				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+			},
+			"self_service": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Description: "Self Service settings of the patch policy, used when `distribution_method` is `selfservice`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"install_button_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultInstallButtonText,
+							Description: "The text of the button installing the update in Self Service.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the update in Self Service.",
+						},
+						"icon_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The ID of the icon of the update in Self Service.",
+						},
+					},
+				},
+			},
+			"notifications": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "User notifications that an update is available. No notifications are sent when unset.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultNotificationType,
+							Description: "Where users are notified, e.g. `Self Service`.",
+						},
+						"subject": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The subject of the notification.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The message of the notification.",
+						},
+						"reminder_frequency_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "How often, in days, users are reminded of the update. Users are not reminded when 0.",
+						},
+					},
+				},
+			},
+			"deadline": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "A deadline after which the update is installed automatically. There is no deadline when unset.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of days users have to install the update before it is installed automatically.",
+						},
+					},
+				},
+			},
+			"grace_period": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Description: "The grace period users have to quit the application before an automatic update quits it.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultGracePeriodMinutes,
+							ValidateFunc: validation.IntBetween(0, 1440),
+							Description:  "The number of minutes users have to quit the application.",
+						},
+						"notification_subject": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultGracePeriodSubject,
+							Description: "The subject of the notification shown during the grace period.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultGracePeriodMessage,
+							Description: "The message shown during the grace period. `$APP_NAMES`, `$DELAY_MINUTES` and `$SOFTWARE_TITLE` are replaced by Jamf Pro.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package patch_policy
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest patch policy information from the
+// Jamf Pro Classic API.
+func updateState(d *schema.ResourceData, resp *resourcePatchPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]any{
+		"id":                              fmt.Sprint(resp.General.ID),
+		"name":                            resp.General.Name,
+		"software_title_configuration_id": fmt.Sprint(resp.SoftwareTitleConfigurationID),
+		"enabled":                         resp.General.Enabled,
+		"target_version":                  resp.General.TargetVersion,
+		"distribution_method":             resp.General.DistributionMethod,
+		"allow_downgrade":                 resp.General.AllowDowngrade,
+		"patch_unknown_versions":          resp.General.PatchUnknown,
+		"release_date":                    resp.General.ReleaseDate,
+		"minimum_os":                      resp.General.MinimumOS,
+		"reboot_required":                 resp.General.Reboot,
+		"incremental_updates":             resp.General.IncrementalUpdates,
+		"scope":                           []any{flattenScope(resp.Scope)},
+	}
+
+	for key, val := range flattenUserInteraction(resp.UserInteraction) {
+		resourceData[key] = val
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// flattenScope returns the shared computer scope block of the patch policy scope. Limitations and
+// exclusions are omitted when they are empty.
+func flattenScope(scope patchPolicySubsetScope) map[string]any {
+	out := map[string]any{
+		"all_computers": scope.AllComputers,
+		"computer_ids":  utils.FlattenSortIDs(scope.Computers, func(v jamfpro.PolicySubsetComputer) int { return v.ID }),
+		"computer_group_ids": utils.FlattenSortIDs(scope.ComputerGroups, func(v jamfpro.PolicySubsetComputerGroup) int {
+			return v.ID
+		}),
+		"building_ids":   utils.FlattenSortIDs(scope.Buildings, func(v jamfpro.PolicySubsetBuilding) int { return v.ID }),
+		"department_ids": utils.FlattenSortIDs(scope.Departments, func(v jamfpro.PolicySubsetDepartment) int { return v.ID }),
+	}
+
+	limitations := withoutEmpty(map[string]any{
+		"network_segment_ids": utils.FlattenSortIDs(scope.Limitations.NetworkSegments, func(v jamfpro.PolicySubsetNetworkSegment) int {
+			return v.ID
+		}),
+		"ibeacon_ids": utils.FlattenSortIDs(scope.Limitations.IBeacons, func(v jamfpro.PolicySubsetIBeacon) int { return v.ID }),
+		"directory_service_or_local_usernames": utils.FlattenSortStrings(scope.Limitations.Users, func(v jamfpro.PolicySubsetUser) string {
+			return v.Name
+		}),
+		"directory_service_usergroup_names": utils.FlattenSortStrings(scope.Limitations.UserGroups, func(v jamfpro.PolicySubsetUserGroup) string {
+			return v.Name
+		}),
+	})
+	if len(limitations) > 0 {
+		out["limitations"] = []any{limitations}
+	}
+
+	exclusions := withoutEmpty(map[string]any{
+		"computer_ids": utils.FlattenSortIDs(scope.Exclusions.Computers, func(v jamfpro.PolicySubsetComputer) int { return v.ID }),
+		"computer_group_ids": utils.FlattenSortIDs(scope.Exclusions.ComputerGroups, func(v jamfpro.PolicySubsetComputerGroup) int {
+			return v.ID
+		}),
+		"building_ids":   utils.FlattenSortIDs(scope.Exclusions.Buildings, func(v jamfpro.PolicySubsetBuilding) int { return v.ID }),
+		"department_ids": utils.FlattenSortIDs(scope.Exclusions.Departments, func(v jamfpro.PolicySubsetDepartment) int { return v.ID }),
+		"network_segment_ids": utils.FlattenSortIDs(scope.Exclusions.NetworkSegments, func(v jamfpro.PolicySubsetNetworkSegment) int {
+			return v.ID
+		}),
+		"jss_user_ids": utils.FlattenSortIDs(scope.Exclusions.JSSUsers, func(v jamfpro.PolicySubsetJSSUser) int { return v.ID }),
+		"jss_user_group_ids": utils.FlattenSortIDs(scope.Exclusions.JSSUserGroups, func(v jamfpro.PolicySubsetJSSUserGroup) int {
+			return v.ID
+		}),
+		"ibeacon_ids": utils.FlattenSortIDs(scope.Exclusions.IBeacons, func(v jamfpro.PolicySubsetIBeacon) int { return v.ID }),
+		"directory_service_or_local_usernames": utils.FlattenSortStrings(scope.Exclusions.Users, func(v jamfpro.PolicySubsetUser) string {
+			return v.Name
+		}),
+		"directory_service_usergroup_names": utils.FlattenSortStrings(scope.Exclusions.UserGroups, func(v jamfpro.PolicySubsetUserGroup) string {
+			return v.Name
+		}),
+	})
+	if len(exclusions) > 0 {
+		out["exclusions"] = []any{exclusions}
+	}
+
+	return out
+}
+
+// withoutEmpty returns the entries of block holding at least one ID or name.
+func withoutEmpty(block map[string]any) map[string]any {
+	out := map[string]any{}
+	for key, value := range block {
+		switch v := value.(type) {
+		case []int:
+			if len(v) > 0 {
+				out[key] = v
+			}
+		case []string:
+			if len(v) > 0 {
+				out[key] = v
+			}
+		}
+	}
+	return out
+}
+
+// flattenUserInteraction returns the self_service, notifications, deadline and grace_period
+// blocks. Notifications and the deadline are only set when they are enabled.
+func flattenUserInteraction(ui patchPolicySubsetUserInteraction) map[string]any {
+	selfService := map[string]any{
+		"install_button_text": ui.InstallButtonText,
+		"description":         ui.SelfServiceDescription,
+		"icon_id":             0,
+	}
+	if ui.SelfServiceIcon != nil {
+		selfService["icon_id"] = ui.SelfServiceIcon.ID
+	}
+
+	out := map[string]any{
+		"self_service":  []any{selfService},
+		"notifications": []any{},
+		"deadline":      []any{},
+		"grace_period": []any{map[string]any{
+			"duration_minutes":     ui.GracePeriod.Duration,
+			"notification_subject": ui.GracePeriod.NotificationSubject,
+			"message":              ui.GracePeriod.Message,
+		}},
+	}
+
+	if ui.Notifications.Enabled {
+		frequency := 0
+		if ui.Notifications.Reminders.Enabled {
+			frequency = ui.Notifications.Reminders.Frequency
+		}
+		out["notifications"] = []any{map[string]any{
+			"type":                    ui.Notifications.Type,
+			"subject":                 ui.Notifications.Subject,
+			"message":                 ui.Notifications.Message,
+			"reminder_frequency_days": frequency,
+		}}
+	}
+
+	if ui.Deadlines.Enabled {
+		out["deadline"] = []any{map[string]any{"period_days": ui.Deadlines.Period}}
+	}
+
+	return out
+}
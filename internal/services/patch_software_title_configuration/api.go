@@ -0,0 +1,133 @@
+package patch_software_title_configuration
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// The Jamf Pro API only creates a configuration from the internal ID of a software title, which it
+// does not list. The Classic API creates one from a title of a patch source, as the GUI does, so
+// configurations are created there and their settings then applied through the Jamf Pro API. The
+// SDK update omits false notification settings, so updates are sent through its HTTP client too.
+const (
+	uriPatchSoftwareTitles              = "/JSSResource/patchsoftwaretitles"
+	uriPatchSoftwareTitleConfigurations = "/api/v2/patch-software-title-configurations"
+)
+
+// requestPatchSoftwareTitleConfiguration holds the settings of a software title configuration,
+// and the patch source title it is created from.
+type requestPatchSoftwareTitleConfiguration struct {
+	DisplayName         string                                                 `json:"displayName"`
+	CategoryID          string                                                 `json:"categoryId"`
+	SiteID              string                                                 `json:"siteId"`
+	UINotifications     bool                                                   `json:"uiNotifications"`
+	EmailNotifications  bool                                                   `json:"emailNotifications"`
+	ExtensionAttributes []patchExtensionAttribute                              `json:"extensionAttributes"`
+	Packages            []jamfpro.PatchSoftwareTitleConfigurationSubsetPackage `json:"packages"`
+
+	// SourceID and NameID select the title of a new configuration and are not sent on update.
+	SourceID int    `json:"-"`
+	NameID   string `json:"-"`
+}
+
+// patchExtensionAttribute is the acceptance of an extension attribute a software title needs to
+// report its versions.
+type patchExtensionAttribute struct {
+	Accepted bool   `json:"accepted"`
+	EaID     string `json:"eaId"`
+}
+
+// requestClassicPatchSoftwareTitle is the body of a Classic API software title configuration.
+type requestClassicPatchSoftwareTitle struct {
+	XMLName  xml.Name `xml:"patch_software_title"`
+	Name     string   `xml:"name"`
+	NameID   string   `xml:"name_id"`
+	SourceID int      `xml:"source_id"`
+}
+
+// responseClassicPatchSoftwareTitle is the part of a Classic API software title configuration
+// the Jamf Pro API does not return.
+type responseClassicPatchSoftwareTitle struct {
+	ID       int    `xml:"id"`
+	NameID   string `xml:"name_id"`
+	SourceID int    `xml:"source_id"`
+}
+
+// responsePatchSoftwareTitleConfiguration is a software title configuration together with the ID of
+// the patch source of its title.
+type responsePatchSoftwareTitleConfiguration struct {
+	jamfpro.ResourcePatchSoftwareTitleConfiguration
+	SourceID int
+}
+
+// createPatchSoftwareTitleConfiguration returns a function creating a configuration from the patch
+// source title, then applying its settings. The title is deleted again when its settings cannot be
+// applied, so that retrying the creation does not leave a duplicate behind.
+func createPatchSoftwareTitleConfiguration(client *jamfpro.Client) func(*requestPatchSoftwareTitleConfiguration) (*jamfpro.ResponsePatchSoftwareTitleConfigurationCreate, error) {
+	return func(req *requestPatchSoftwareTitleConfiguration) (*jamfpro.ResponsePatchSoftwareTitleConfigurationCreate, error) {
+		title := &requestClassicPatchSoftwareTitle{Name: req.DisplayName, NameID: req.NameID, SourceID: req.SourceID}
+
+		var created responseClassicPatchSoftwareTitle
+		resp, err := client.HTTP.DoRequest("POST", fmt.Sprintf("%s/id/0", uriPatchSoftwareTitles), title, &created)
+		if resp != nil {
+			defer resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create a configuration of title %q from patch source %d: %v", req.NameID, req.SourceID, err)
+		}
+
+		id := fmt.Sprint(created.ID)
+		if _, err := updatePatchSoftwareTitleConfiguration(client)(id, req); err != nil {
+			if deleteErr := client.DeletePatchSoftwareTitleConfigurationById(id); deleteErr != nil {
+				return nil, fmt.Errorf("%v, and the new configuration %s could not be deleted: %v", err, id, deleteErr)
+			}
+			return nil, err
+		}
+
+		return &jamfpro.ResponsePatchSoftwareTitleConfigurationCreate{ID: id}, nil
+	}
+}
+
+// getPatchSoftwareTitleConfiguration returns a function reading a configuration and the patch
+// source of its title.
+func getPatchSoftwareTitleConfiguration(client *jamfpro.Client) func(string) (*responsePatchSoftwareTitleConfiguration, error) {
+	return func(id string) (*responsePatchSoftwareTitleConfiguration, error) {
+		configuration, err := client.GetPatchSoftwareTitleConfigurationById(id)
+		if err != nil {
+			return nil, err
+		}
+
+		var title responseClassicPatchSoftwareTitle
+		resp, err := client.HTTP.DoRequest("GET", fmt.Sprintf("%s/id/%s", uriPatchSoftwareTitles, id), nil, &title)
+		if resp != nil {
+			defer resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the patch source of software title configuration %s: %v", id, err)
+		}
+
+		return &responsePatchSoftwareTitleConfiguration{
+			ResourcePatchSoftwareTitleConfiguration: *configuration,
+			SourceID:                                title.SourceID,
+		}, nil
+	}
+}
+
+// updatePatchSoftwareTitleConfiguration returns a function applying the settings of a
+// configuration.
+func updatePatchSoftwareTitleConfiguration(client *jamfpro.Client) func(string, *requestPatchSoftwareTitleConfiguration) (*jamfpro.ResourcePatchSoftwareTitleConfiguration, error) {
+	return func(id string, req *requestPatchSoftwareTitleConfiguration) (*jamfpro.ResourcePatchSoftwareTitleConfiguration, error) {
+		var out jamfpro.ResourcePatchSoftwareTitleConfiguration
+		resp, err := client.HTTP.DoRequest("PATCH", fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id), req, &out)
+		if resp != nil {
+			defer resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to update patch software title configuration %s: %v", id, err)
+		}
+
+		return &out, nil
+	}
+}
@@ -0,0 +1,54 @@
+package patch_software_title_configuration
+
+import (
+	"sort"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds the settings of a patch software title configuration from the provided schema
+// data.
+func construct(d *schema.ResourceData) (*requestPatchSoftwareTitleConfiguration, error) {
+	resource := &requestPatchSoftwareTitleConfiguration{
+		DisplayName:        d.Get("display_name").(string),
+		CategoryID:         d.Get("category_id").(string),
+		SiteID:             d.Get("site_id").(string),
+		UINotifications:    d.Get("ui_notifications").(bool),
+		EmailNotifications: d.Get("email_notifications").(bool),
+		SourceID:           d.Get("source_id").(int),
+		NameID:             d.Get("name_id").(string),
+		Packages:           []jamfpro.PatchSoftwareTitleConfigurationSubsetPackage{},
+	}
+
+	previous, accepted := d.GetChange("accepted_extension_attribute_ids")
+	resource.ExtensionAttributes = constructExtensionAttributes(previous.(*schema.Set), accepted.(*schema.Set))
+
+	for _, v := range d.Get("package").(*schema.Set).List() {
+		pkg := v.(map[string]any)
+		resource.Packages = append(resource.Packages, jamfpro.PatchSoftwareTitleConfigurationSubsetPackage{
+			PackageId: pkg["package_id"].(string),
+			Version:   pkg["version"].(string),
+		})
+	}
+	sort.Slice(resource.Packages, func(i, j int) bool {
+		return resource.Packages[i].Version < resource.Packages[j].Version
+	})
+
+	return resource, nil
+}
+
+// constructExtensionAttributes accepts each extension attribute in the accepted set, and withdraws
+// the acceptance of those only in the previous set.
+func constructExtensionAttributes(previous, accepted *schema.Set) []patchExtensionAttribute {
+	out := []patchExtensionAttribute{}
+	for _, id := range accepted.List() {
+		out = append(out, patchExtensionAttribute{EaID: id.(string), Accepted: true})
+	}
+	for _, id := range previous.Difference(accepted).List() {
+		out = append(out, patchExtensionAttribute{EaID: id.(string), Accepted: false})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].EaID < out[j].EaID })
+	return out
+}
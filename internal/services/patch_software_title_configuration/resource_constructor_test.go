@@ -0,0 +1,44 @@
+package patch_software_title_configuration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstructExtensionAttributes(t *testing.T) {
+	previous := schema.NewSet(schema.HashString, []any{"1", "2"})
+	accepted := schema.NewSet(schema.HashString, []any{"2", "3"})
+
+	assert.Equal(t, []patchExtensionAttribute{
+		{EaID: "1", Accepted: false},
+		{EaID: "2", Accepted: true},
+		{EaID: "3", Accepted: true},
+	}, constructExtensionAttributes(previous, accepted))
+}
+
+func TestConstruct(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPatchSoftwareTitleConfigurations().Schema, map[string]any{
+		"source_id":    1,
+		"name_id":      "GoogleChrome",
+		"display_name": "Google Chrome",
+		"package": []any{
+			map[string]any{"package_id": "21", "version": "126.0.6478.127"},
+			map[string]any{"package_id": "20", "version": "125.0.6422.142"},
+		},
+	})
+
+	configuration, err := construct(d)
+	require.NoError(t, err)
+
+	assert.Equal(t, "125.0.6422.142", configuration.Packages[0].Version)
+	assert.Equal(t, "-1", configuration.CategoryID)
+
+	body, err := json.Marshal(configuration)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"uiNotifications":false`, "disabled notifications are sent")
+	assert.NotContains(t, string(body), "GoogleChrome", "the title is only sent on create, through the Classic API")
+}
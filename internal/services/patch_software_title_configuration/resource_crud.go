@@ -0,0 +1,66 @@
+package patch_software_title_configuration
+
+import (
+	"context"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating a new Jamf Pro Patch Software Title Configuration in the remote system.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Create(
+		ctx,
+		d,
+		meta,
+		construct,
+		createPatchSoftwareTitleConfiguration(meta.(*jamfpro.Client)),
+		readNoCleanup,
+	)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Patch Software Title Configuration from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) diag.Diagnostics {
+	return crud.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		getPatchSoftwareTitleConfiguration(meta.(*jamfpro.Client)),
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Patch Software Title Configuration on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		updatePatchSoftwareTitleConfiguration(meta.(*jamfpro.Client)),
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Patch Software Title Configuration.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return crud.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeletePatchSoftwareTitleConfigurationById,
+	)
+}
@@ -0,0 +1,137 @@
+package patch_software_title_configuration
+
+import (
+	"time"
+
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProPatchSoftwareTitleConfigurations defines the schema and CRUD operations for
+// managing Jamf Pro patch software title configurations in Terraform.
+func ResourceJamfProPatchSoftwareTitleConfigurations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
+		},
+		Identity: crud.IDIdentity(),
+		Description: "Manages a patch software title configuration: a software title of a patch source that Jamf Pro " +
+			"reports patch versions for, the packages installing each version, and the extension attributes the title " +
+			"needs to report them.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Jamf Pro unique identifier (ID) of the software title configuration.",
+			},
+			"source_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The ID of the patch source offering the title, e.g. from the `jamfpro_patch_sources` data source.",
+			},
+			"name_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The identifier of the title within the patch source, e.g. `GoogleChrome`, from the `jamfpro_patch_available_titles` data source.",
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The name of the software title configuration.",
+			},
+			"category_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The ID of the category of the software title configuration.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-1",
+				Description: "The ID of the site of the software title configuration.",
+			},
+			"ui_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Jamf Pro shows a notification in its GUI when the title is updated.",
+			},
+			"email_notifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Jamf Pro sends an email notification when the title is updated.",
+			},
+			"accepted_extension_attribute_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the extension attributes of the title to accept. Titles whose versions are reported " +
+					"by an extension attribute report no versions until it is accepted. Extension attributes removed from the " +
+					"set are no longer accepted.",
+			},
+			"package": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A package installing a version of the title, used by patch policies targeting that version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"package_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the package, e.g. of a `jamfpro_package`.",
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The version of the title the package installs.",
+						},
+					},
+				},
+			},
+			"software_title_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Jamf Pro ID of the software title.",
+			},
+			"software_title_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the software title in the patch source.",
+			},
+			"software_title_publisher": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The publisher of the software title.",
+			},
+			"patch_source_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the patch source offering the title.",
+			},
+			"jamf_official": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the title is maintained by Jamf.",
+			},
+		},
+	}
+}
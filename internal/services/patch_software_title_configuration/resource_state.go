@@ -0,0 +1,66 @@
+package patch_software_title_configuration
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest patch software title configuration
+// information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *responsePatchSoftwareTitleConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var accepted []string
+	for _, attribute := range resp.ExtensionAttributes {
+		if attribute.Accepted {
+			accepted = append(accepted, attribute.EaID)
+		}
+	}
+
+	packages := make([]any, 0, len(resp.Packages))
+	for _, pkg := range resp.Packages {
+		packages = append(packages, map[string]any{
+			"package_id": pkg.PackageId,
+			"version":    pkg.Version,
+		})
+	}
+
+	resourceData := map[string]any{
+		"id":                               resp.ID,
+		"display_name":                     resp.DisplayName,
+		"category_id":                      defaultID(resp.CategoryID),
+		"site_id":                          defaultID(resp.SiteID),
+		"ui_notifications":                 resp.UiNotifications,
+		"email_notifications":              resp.EmailNotifications,
+		"accepted_extension_attribute_ids": accepted,
+		"package":                          packages,
+		"software_title_id":                resp.SoftwareTitleID,
+		"software_title_name":              resp.SoftwareTitleName,
+		"software_title_publisher":         resp.SoftwareTitlePublisher,
+		"patch_source_name":                resp.PatchSourceName,
+		"jamf_official":                    resp.JamfOfficial,
+	}
+
+	if resp.SoftwareTitleNameId != "" {
+		resourceData["name_id"] = resp.SoftwareTitleNameId
+	}
+	if resp.SourceID != 0 {
+		resourceData["source_id"] = resp.SourceID
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// defaultID returns the ID Jamf Pro uses for no category or site when the ID is empty.
+func defaultID(id string) string {
+	if id == "" {
+		return "-1"
+	}
+	return id
+}
@@ -0,0 +1,69 @@
+package patch_source
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// The SDK has no support for internal patch sources or the titles a source offers, so they are
+// called through its HTTP client.
+const (
+	uriPatchInternalSources = "/JSSResource/patchinternalsources"
+	uriPatchAvailableTitles = "/JSSResource/patchavailabletitles/sourceid"
+)
+
+// responsePatchInternalSourcesList is the Classic API list of internal patch sources, such as the
+// Jamf patch management catalog.
+type responsePatchInternalSourcesList struct {
+	PatchInternalSources []responsePatchSourceListItem `xml:"patch_internal_source"`
+}
+
+type responsePatchSourceListItem struct {
+	ID   int    `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// responsePatchAvailableTitles is the Classic API list of the software titles a patch source
+// offers.
+type responsePatchAvailableTitles struct {
+	AvailableTitles []responsePatchAvailableTitle `xml:"available_titles>available_title"`
+}
+
+type responsePatchAvailableTitle struct {
+	NameID         string `xml:"name_id"`
+	CurrentVersion string `xml:"current_version"`
+	Publisher      string `xml:"publisher"`
+	LastModified   string `xml:"last_modified"`
+	AppName        string `xml:"app_name"`
+}
+
+// getPatchInternalSources lists the internal patch sources.
+func getPatchInternalSources(client *jamfpro.Client) (*responsePatchInternalSourcesList, error) {
+	var out responsePatchInternalSourcesList
+	resp, err := client.HTTP.DoRequest("GET", uriPatchInternalSources, nil, &out)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list patch internal sources: %v", err)
+	}
+
+	return &out, nil
+}
+
+// getPatchAvailableTitles lists the software titles offered by the patch source.
+func getPatchAvailableTitles(client *jamfpro.Client, sourceID int) (*responsePatchAvailableTitles, error) {
+	endpoint := fmt.Sprintf("%s/%d", uriPatchAvailableTitles, sourceID)
+
+	var out responsePatchAvailableTitles
+	resp, err := client.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the titles available from patch source %d: %v", sourceID, err)
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,88 @@
+package patch_source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceReadSources lists the internal patch sources, then the external ones.
+func dataSourceReadSources(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+
+	var internal *responsePatchInternalSourcesList
+	var external *jamfpro.ResponsePatchExternalSourcesList
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		if internal, apiErr = getPatchInternalSources(client); apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		if external, apiErr = client.GetPatchExternalSources(); apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list Jamf Pro Patch Sources after retries: %v", err))
+	}
+
+	sources := make([]any, 0, len(internal.PatchInternalSources)+len(external.PatchExternalSources))
+	for _, source := range internal.PatchInternalSources {
+		sources = append(sources, map[string]any{"id": source.ID, "name": source.Name, "type": sourceTypeInternal})
+	}
+	for _, source := range external.PatchExternalSources {
+		sources = append(sources, map[string]any{"id": source.ID, "name": source.Name, "type": sourceTypeExternal})
+	}
+
+	if err := d.Set("sources", sources); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("patch_sources")
+
+	return nil
+}
+
+// dataSourceReadAvailableTitles lists the software titles offered by the patch source.
+func dataSourceReadAvailableTitles(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	sourceID := d.Get("source_id").(int)
+
+	var resp *responsePatchAvailableTitles
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resp, apiErr = getPatchAvailableTitles(client, sourceID)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list Jamf Pro Patch Available Titles after retries: %v", err))
+	}
+
+	titles := make([]any, 0, len(resp.AvailableTitles))
+	for _, title := range resp.AvailableTitles {
+		titles = append(titles, map[string]any{
+			"name_id":         title.NameID,
+			"app_name":        title.AppName,
+			"publisher":       title.Publisher,
+			"current_version": title.CurrentVersion,
+			"last_modified":   title.LastModified,
+		})
+	}
+
+	if err := d.Set("titles", titles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(sourceID))
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package patch_source
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Patch source types, as the Jamf Pro GUI names them.
+const (
+	sourceTypeInternal = "internal"
+	sourceTypeExternal = "external"
+)
+
+// DataSourceJamfProPatchSources lists the internal and external patch sources of Jamf Pro.
+func DataSourceJamfProPatchSources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReadSources,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(70 * time.Second),
+		},
+		Description: "Lists the patch sources of Jamf Pro: the internal sources, such as the Jamf patch management " +
+			"catalog, and any external patch servers.",
+		Schema: map[string]*schema.Schema{
+			"sources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The patch sources, internal sources first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The Jamf Pro ID of the patch source.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the patch source.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the source is `internal` or `external`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// DataSourceJamfProPatchAvailableTitles lists the software titles a patch source offers.
+func DataSourceJamfProPatchAvailableTitles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReadAvailableTitles,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(70 * time.Second),
+		},
+		Description: "Lists the software titles a patch source offers. The `name_id` of a title, together with the " +
+			"ID of its source, selects the title of a `jamfpro_patch_software_title_configuration`.",
+		Schema: map[string]*schema.Schema{
+			"source_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The ID of the patch source, e.g. from the `jamfpro_patch_sources` data source.",
+			},
+			"titles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The software titles the source offers.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the title within the source, e.g. `GoogleChrome`.",
+						},
+						"app_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the application.",
+						},
+						"publisher": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The publisher of the application.",
+						},
+						"current_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The latest version of the application known to the source.",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the source last updated the title.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewPolicyListResource creates the jamfpro_policy list resource used by `terraform query`.
+func NewPolicyListResource() list.ListResource {
+	return sdkv2_list.New("_policy", ResourceJamfProPolicies, listPolicyItems)
+}
+
+// listPolicyItems returns every policy as list resource items.
+func listPolicyItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	response, err := client.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(response.Policy))
+	for _, policy := range response.Policy {
+		items = append(items, sdkv2_list.Item{ID: strconv.Itoa(policy.ID), Name: policy.Name})
+	}
+	return items, nil
+}
@@ -0,0 +1,141 @@
+package policy_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicy_script(t *testing.T) {
+	name := acctest.RandomName("policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CheckDestroy("jamfpro_policy", func(client *jamfpro.Client, id string) error {
+			_, err := client.GetPolicyByID(id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig(name, false, "param_value_4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_policy.test", "name", name),
+					resource.TestCheckResourceAttr("jamfpro_policy.test", "enabled", "false"),
+					resource.TestCheckResourceAttrPair("jamfpro_policy.test", "category_id", "jamfpro_category.test", "id"),
+					resource.TestCheckResourceAttrPair("jamfpro_policy.test", "scope.0.computer_group_ids.0", "jamfpro_static_computer_group.test", "id"),
+					resource.TestCheckResourceAttrPair("jamfpro_policy.test", "payloads.0.scripts.0.id", "jamfpro_script.test", "id"),
+					resource.TestCheckResourceAttr("jamfpro_policy.test", "payloads.0.scripts.0.parameter4", "param_value_4"),
+				),
+			},
+			{
+				Config: testAccPolicyConfig(name, true, "updated_value_4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_policy.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("jamfpro_policy.test", "payloads.0.scripts.0.parameter4", "updated_value_4"),
+				),
+			},
+			{
+				ResourceName:      "jamfpro_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// package_distribution_point is not returned by the API, and defaults are not set on import.
+				ImportStateVerifyIgnore: []string{"package_distribution_point", "ignore_external_scope", "validate_references", "validate_script_parameters"},
+			},
+		},
+	})
+}
+
+func testAccPolicyConfig(name string, enabled bool, parameter4 string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_category" "test" {
+  name = "%[1]s-category"
+}
+
+resource "jamfpro_script" "test" {
+  name            = "%[1]s-script"
+  priority        = "BEFORE"
+  script_contents = "#!/bin/zsh\necho hello\n"
+}
+
+resource "jamfpro_static_computer_group" "test" {
+  name = "%[1]s-group"
+}
+
+resource "jamfpro_policy" "test" {
+  name            = %[1]q
+  enabled         = %[2]t
+  trigger_checkin = true
+  frequency       = "Once per computer"
+  category_id     = jamfpro_category.test.id
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [jamfpro_static_computer_group.test.id]
+  }
+
+  payloads {
+    scripts {
+      id         = jamfpro_script.test.id
+      priority   = "After"
+      parameter4 = %[3]q
+    }
+  }
+}
+`, name, enabled, parameter4)
+}
+
+func TestAccPolicy_validateReferences(t *testing.T) {
+	name := acctest.RandomName("policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidateReferencesConfig(name, "jamfpro_script.test.id"),
+				Check:  resource.TestCheckResourceAttr("jamfpro_policy.test", "validate_references", "true"),
+			},
+			{
+				Config:      testAccPolicyValidateReferencesConfig(name, `"999999"`),
+				ExpectError: regexp.MustCompile(`payloads\.0\.scripts\.0\.id: script 999999 not found`),
+			},
+		},
+	})
+}
+
+func testAccPolicyValidateReferencesConfig(name, scriptID string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_category" "test" {
+  name = "%[1]s-category"
+}
+
+resource "jamfpro_script" "test" {
+  name            = "%[1]s-script"
+  priority        = "BEFORE"
+  script_contents = "#!/bin/zsh\necho hello\n"
+}
+
+resource "jamfpro_policy" "test" {
+  name                = %[1]q
+  enabled             = false
+  frequency           = "Once per computer"
+  category_id         = jamfpro_category.test.id
+  validate_references = true
+
+  scope {
+    all_computers = false
+  }
+
+  payloads {
+    scripts {
+      id = %[2]s
+    }
+  }
+}
+`, name, scriptID)
+}
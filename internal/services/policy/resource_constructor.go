@@ -4,9 +4,11 @@ import (
 	"encoding/xml"
 	"fmt"
 	"log"
+	"slices"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/constructors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,7 +27,10 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
 
 	constructSelfService(d, resource)
 
-	constructPayloads(d, resource)
+	err = constructPayloads(d, resource)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
 	if err != nil {
@@ -143,6 +148,10 @@ func constructScope(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) er
 	if err != nil {
 		return err
 	}
+	err = appendComputerGroupsByName(d, "scope.0.computer_group_names", resource.Scope.ComputerGroups)
+	if err != nil {
+		return err
+	}
 
 	// JSS Users
 	err = constructors.MapSetToStructs[jamfpro.PolicySubsetJSSUser, int]("scope.0.jss_user_ids", "ID", d, resource.Scope.JSSUsers)
@@ -229,6 +238,10 @@ func constructScope(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) er
 	if err != nil {
 		return err
 	}
+	err = appendComputerGroupsByName(d, "scope.0.exclusions.0.computer_group_names", resource.Scope.Exclusions.ComputerGroups)
+	if err != nil {
+		return err
+	}
 
 	// Users
 	err = constructors.MapSetToStructs[jamfpro.PolicySubsetUser, string]("scope.0.exclusions.0.directory_service_or_local_usernames", "Name", d, resource.Scope.Exclusions.Users)
@@ -281,6 +294,23 @@ func constructScope(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) er
 	return nil
 }
 
+// appendComputerGroupsByName appends the computer groups named in the set at path, by the IDs the
+// names resolved to when planning, to groups.
+func appendComputerGroupsByName(d *schema.ResourceData, path string, groups *[]jamfpro.PolicySubsetComputerGroup) error {
+	ids, err := sharedschemas.NameReferenceIDs(d, path, name_references.ComputerGroup)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if !slices.ContainsFunc(*groups, func(group jamfpro.PolicySubsetComputerGroup) bool { return group.ID == id }) {
+			*groups = append(*groups, jamfpro.PolicySubsetComputerGroup{ID: id})
+		}
+	}
+
+	return nil
+}
+
 // Pulls "self service" settings from HCL and packages into object
 func constructSelfService(d *schema.ResourceData, out *jamfpro.ResourcePolicy) {
 	if len(d.Get("self_service").([]any)) == 0 {
@@ -329,8 +359,10 @@ func constructSelfService(d *schema.ResourceData, out *jamfpro.ResourcePolicy) {
 }
 
 // constructPayloads builds the policy payload(s) from the HCL
-func constructPayloads(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) {
-	constructPayloadPackages(d, resource)
+func constructPayloads(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) error {
+	if err := constructPayloadPackages(d, resource); err != nil {
+		return err
+	}
 	constructPayloadScripts(d, resource)
 	constructPayloadDiskEncryption(d, resource)
 	constructPayloadPrinters(d, resource)
@@ -340,21 +372,33 @@ func constructPayloads(d *schema.ResourceData, resource *jamfpro.ResourcePolicy)
 	constructPayloadUserInteraction(d, resource)
 	constructPayloadReboot(d, resource)
 	constructPayloadMaintenance(d, resource)
+
+	return nil
 }
 
-// constructPayloadPackages builds the packages payload settings of the policy.
-func constructPayloadPackages(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) {
+// constructPayloadPackages builds the packages payload settings of the policy. Packages referenced
+// by name take the ID the name resolved to when planning.
+func constructPayloadPackages(d *schema.ResourceData, resource *jamfpro.ResourcePolicy) error {
 	hcl := d.Get("payloads.0.packages.0")
 	if len(hcl.(map[string]any)) == 0 {
-		return
+		return nil
 	}
 	var payload jamfpro.PolicySubsetPackageConfiguration
 	payload.DistributionPoint = hcl.(map[string]any)["distribution_point"].(string)
 	packageList := hcl.(map[string]any)["package"].([]any)
 
-	for _, v := range packageList {
+	for i, v := range packageList {
+		id := v.(map[string]any)["id"].(int)
+		if name, _ := v.(map[string]any)["name"].(string); name != "" {
+			ids, err := sharedschemas.NameReferenceIDs(d, fmt.Sprintf("payloads.0.packages.0.package.%d.name", i), name_references.Package)
+			if err != nil {
+				return err
+			}
+			id = ids[0]
+		}
+
 		payload.Packages = append(payload.Packages, jamfpro.PolicySubsetPackageConfigurationPackage{
-			ID:                v.(map[string]any)["id"].(int),
+			ID:                id,
 			Action:            v.(map[string]any)["action"].(string),
 			FillUserTemplate:  v.(map[string]any)["fill_user_template"].(bool),
 			FillExistingUsers: v.(map[string]any)["fill_existing_user_template"].(bool),
@@ -362,6 +406,8 @@ func constructPayloadPackages(d *schema.ResourceData, resource *jamfpro.Resource
 	}
 
 	resource.PackageConfiguration = payload
+
+	return nil
 }
 
 // Pulls "script" settings from HCL and packages them into the resource.
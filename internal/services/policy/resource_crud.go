@@ -5,6 +5,8 @@ import (
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/scope_locks"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,8 +17,7 @@ import (
 
 // create is responsible for creating a new Jamf Pro Policy in the remote system.
 func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-
-	return crud.Create(
+	diags := crud.Create(
 		ctx,
 		d,
 		meta,
@@ -24,6 +25,11 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		meta.(*jamfpro.Client).CreatePolicy,
 		readNoCleanup,
 	)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, scriptParameterWarnings(d, meta)...)
 }
 
 // Reads and states
@@ -34,7 +40,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		d,
 		meta,
 		cleanup,
-		meta.(*jamfpro.Client).GetPolicyByID,
+		read_cache.Memoized(meta, "policy", meta.(*jamfpro.Client).GetPolicyByID),
 		updateState,
 	)
 }
@@ -71,14 +77,22 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		})
 	}
 
-	return append(diags, crud.Update(
+	// Held across the read and write of the policy, so scope targets added meanwhile are kept.
+	defer scope_locks.Policy(d.Id())()
+
+	diags = append(diags, crud.Update(
 		ctx,
 		d,
 		meta,
-		construct,
+		constructForUpdate(meta),
 		meta.(*jamfpro.Client).UpdatePolicyByID,
 		readNoCleanup,
 	)...)
+	if diags.HasError() || !d.HasChanges("payloads", "validate_script_parameters") {
+		return diags
+	}
+
+	return append(diags, scriptParameterWarnings(d, meta)...)
 }
 
 // Deletes and removes from state
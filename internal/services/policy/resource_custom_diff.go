@@ -15,6 +15,14 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i any) e
 		return fmt.Errorf("validating scope directory service user/group names: %w", err)
 	}
 
+	if err := resolvePolicyNames(diff, i); err != nil {
+		return err
+	}
+
+	if err := validatePolicyReferences(diff, i); err != nil {
+		return err
+	}
+
 	return nil
 }
 
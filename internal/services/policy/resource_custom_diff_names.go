@@ -0,0 +1,84 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resolvePolicyNames resolves the category, site, scope computer groups and packages the policy
+// references by name to their IDs.
+func resolvePolicyNames(diff *schema.ResourceDiff, meta any) error {
+	if err := validatePackageReferences(diff.GetRawConfig()); err != nil {
+		return err
+	}
+
+	if err := sharedschemas.ResolveCategoryAndSiteNames(diff, meta); err != nil {
+		return err
+	}
+
+	return sharedschemas.ResolveNameReferences(diff, meta, policyNamePaths(diff))
+}
+
+// policyNamePaths returns the paths of every name the policy references an object by.
+func policyNamePaths(d referenceGetter) []sharedschemas.NameReferencePath {
+	paths := sharedschemas.ScopeGroupNamePaths("computer_group", name_references.ComputerGroup)
+
+	count := func(path string) int {
+		list, _ := d.Get(path).([]any)
+		return len(list)
+	}
+	for i := range count("payloads") {
+		for j := range count(fmt.Sprintf("payloads.%d.packages", i)) {
+			packages := fmt.Sprintf("payloads.%d.packages.%d.package", i, j)
+			for k := range count(packages) {
+				paths = append(paths, sharedschemas.NameReferencePath{Path: fmt.Sprintf("%s.%d.name", packages, k), Kind: name_references.Package})
+			}
+		}
+	}
+
+	return paths
+}
+
+// validatePackageReferences checks that each package block of the configuration sets either id
+// or name. id is also computed, so only the configuration tells whether it was set.
+func validatePackageReferences(config cty.Value) error {
+	for _, payload := range blocks(config, "payloads") {
+		for _, packages := range blocks(payload, "packages") {
+			for i, pkg := range blocks(packages, "package") {
+				id, name := pkg.GetAttr("id"), pkg.GetAttr("name")
+				if !id.IsKnown() || !name.IsKnown() {
+					continue
+				}
+				if id.IsNull() == name.IsNull() {
+					return fmt.Errorf("package %d of the policy must set exactly one of id or name", i+1)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// blocks returns the known nested blocks named key of a configuration block.
+func blocks(block cty.Value, key string) []cty.Value {
+	if block.IsNull() || !block.IsKnown() || !block.Type().IsObjectType() || !block.Type().HasAttribute(key) {
+		return nil
+	}
+
+	list := block.GetAttr(key)
+	if list.IsNull() || !list.IsKnown() || !list.CanIterateElements() {
+		return nil
+	}
+
+	var out []cty.Value
+	for it := list.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		if !element.IsNull() && element.IsKnown() {
+			out = append(out, element)
+		}
+	}
+	return out
+}
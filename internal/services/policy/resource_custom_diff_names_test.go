@@ -0,0 +1,91 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func namedPolicyTestData(t *testing.T) *schema.ResourceData {
+	t.Helper()
+
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPolicies().Schema, map[string]any{
+		"name":    "test",
+		"enabled": true,
+		"scope": []any{map[string]any{
+			"computer_group_ids":   []any{1},
+			"computer_group_names": []any{"Engineering"},
+			"exclusions":           []any{map[string]any{"computer_group_names": []any{"Kiosks"}}},
+		}},
+		"payloads": []any{map[string]any{
+			"packages": []any{map[string]any{
+				"distribution_point": "default",
+				"package":            []any{map[string]any{"name": "Chrome.pkg"}, map[string]any{"id": 11}},
+			}},
+		}},
+	})
+	require.NoError(t, d.Set("name_references", map[string]any{
+		"computer_group/Engineering": "4",
+		"computer_group/Kiosks":      "12",
+		"package/Chrome.pkg":         "21",
+	}))
+	return d
+}
+
+func TestPolicyNamePaths(t *testing.T) {
+	var got []string
+	for _, path := range policyNamePaths(knownData{namedPolicyTestData(t)}) {
+		got = append(got, path.Path+"="+path.Kind.Name)
+	}
+
+	assert.ElementsMatch(t, []string{
+		"scope.0.computer_group_names=computer group",
+		"scope.0.exclusions.0.computer_group_names=computer group",
+		"payloads.0.packages.0.package.0.name=package",
+		"payloads.0.packages.0.package.1.name=package",
+	}, got)
+}
+
+func TestConstructWithNames(t *testing.T) {
+	resource, err := construct(namedPolicyTestData(t))
+	require.NoError(t, err)
+
+	var groups []int
+	for _, group := range *resource.Scope.ComputerGroups {
+		groups = append(groups, group.ID)
+	}
+	assert.ElementsMatch(t, []int{1, 4}, groups)
+	assert.Equal(t, 12, (*resource.Scope.Exclusions.ComputerGroups)[0].ID)
+
+	require.Len(t, resource.PackageConfiguration.Packages, 2)
+	assert.Equal(t, 21, resource.PackageConfiguration.Packages[0].ID)
+	assert.Equal(t, 11, resource.PackageConfiguration.Packages[1].ID)
+}
+
+func TestValidatePackageReferences(t *testing.T) {
+	config := func(pkg map[string]cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"payloads": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"packages": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"package": cty.ListVal([]cty.Value{cty.ObjectVal(pkg)}),
+				})}),
+			})}),
+		})
+	}
+
+	assert.NoError(t, validatePackageReferences(config(map[string]cty.Value{
+		"id": cty.NullVal(cty.Number), "name": cty.StringVal("Chrome.pkg"),
+	})))
+	assert.NoError(t, validatePackageReferences(config(map[string]cty.Value{
+		"id": cty.NumberIntVal(11), "name": cty.NullVal(cty.String),
+	})))
+	assert.Error(t, validatePackageReferences(config(map[string]cty.Value{
+		"id": cty.NumberIntVal(11), "name": cty.StringVal("Chrome.pkg"),
+	})))
+	assert.Error(t, validatePackageReferences(config(map[string]cty.Value{
+		"id": cty.NullVal(cty.Number), "name": cty.NullVal(cty.String),
+	})))
+}
@@ -0,0 +1,263 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+var errPolicyReferenceNotFound = errors.New("policy references objects that do not exist in Jamf Pro")
+
+// referenceKind is a type of Jamf Pro object a policy references by ID.
+type referenceKind struct {
+	name string
+	// list returns the name of every object of the kind, keyed by ID.
+	list func(client *jamfpro.Client) (map[int]string, error)
+}
+
+var (
+	referenceCategory = referenceKind{name: "category", list: func(client *jamfpro.Client) (map[int]string, error) {
+		response, err := client.GetCategories(nil)
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[int]string, len(response.Results))
+		for _, item := range response.Results {
+			addReference(out, item.Id, item.Name)
+		}
+		return out, nil
+	}}
+	referenceSite = referenceKind{name: "site", list: func(client *jamfpro.Client) (map[int]string, error) {
+		response, err := client.GetSites()
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[int]string, len(response.Site))
+		for _, item := range response.Site {
+			out[item.ID] = item.Name
+		}
+		return out, nil
+	}}
+	referencePackage = referenceKind{name: "package", list: func(client *jamfpro.Client) (map[int]string, error) {
+		response, err := client.GetPackages("", "")
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[int]string, len(response.Results))
+		for _, item := range response.Results {
+			addReference(out, item.ID, item.PackageName)
+		}
+		return out, nil
+	}}
+	referenceScript = referenceKind{name: "script", list: func(client *jamfpro.Client) (map[int]string, error) {
+		response, err := client.GetScripts(nil)
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[int]string, len(response.Results))
+		for _, item := range response.Results {
+			addReference(out, item.ID, item.Name)
+		}
+		return out, nil
+	}}
+	referencePrinter = referenceKind{name: "printer", list: func(client *jamfpro.Client) (map[int]string, error) {
+		response, err := client.GetPrinters()
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[int]string, len(response.Printer))
+		for _, item := range response.Printer {
+			out[item.ID] = item.Name
+		}
+		return out, nil
+	}}
+	referenceDockItem = referenceKind{name: "dock item", list: func(client *jamfpro.Client) (map[int]string, error) {
+		response, err := client.GetDockItems()
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[int]string, len(response.DockItems))
+		for _, item := range response.DockItems {
+			out[item.ID] = item.Name
+		}
+		return out, nil
+	}}
+	referenceDiskEncryption = referenceKind{name: "disk encryption configuration", list: func(client *jamfpro.Client) (map[int]string, error) {
+		response, err := client.GetDiskEncryptionConfigurations()
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[int]string, len(response.DiskEncryptionConfiguration))
+		for _, item := range response.DiskEncryptionConfiguration {
+			out[item.ID] = item.Name
+		}
+		return out, nil
+	}}
+)
+
+// addReference adds an object whose Pro API ID is a string to the list of a reference kind.
+func addReference(out map[int]string, id, name string) {
+	if parsed, err := strconv.Atoi(id); err == nil {
+		out[parsed] = name
+	}
+}
+
+// policyReference is an ID, set at path in the configuration, of an object of the given kind. name
+// is the name of the object configured alongside the ID, e.g. for printers, or empty.
+type policyReference struct {
+	path string
+	kind *referenceKind
+	id   int
+	name string
+}
+
+// referenceGetter is the part of schema.ResourceDiff used to collect references.
+type referenceGetter interface {
+	Get(key string) any
+	NewValueKnown(key string) bool
+}
+
+// validatePolicyReferences checks, when validate_references is set, that every object the policy
+// references by ID exists in Jamf Pro. Each kind of object is listed at most once, and only when
+// the policy references it.
+func validatePolicyReferences(d *schema.ResourceDiff, meta any) error {
+	if !d.Get("validate_references").(bool) {
+		return nil
+	}
+
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return fmt.Errorf("jamf client is not configured for reference validation")
+	}
+
+	references := collectPolicyReferences(d)
+	existing := make(map[*referenceKind]map[int]string)
+	var missing []string
+	for _, ref := range references {
+		objects, listed := existing[ref.kind]
+		if !listed {
+			var err error
+			objects, err = ref.kind.list(client)
+			if err != nil {
+				return fmt.Errorf("failed to list %s objects to validate policy references: %v", ref.kind.name, err)
+			}
+			existing[ref.kind] = objects
+		}
+
+		if _, found := objects[ref.id]; !found {
+			missing = append(missing, fmt.Sprintf("%s: %s %d not found%s", ref.path, ref.kind.name, ref.id, suggestReference(ref.name, objects)))
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w:\n  %s", errPolicyReferenceNotFound, strings.Join(missing, "\n  "))
+	}
+
+	return nil
+}
+
+// collectPolicyReferences returns the known, set IDs of every object referenced by the policy.
+// IDs of zero or less, such as the -1 default of category_id and site_id, mean no reference.
+func collectPolicyReferences(d referenceGetter) []policyReference {
+	var references []policyReference
+	add := func(path string, kind *referenceKind) {
+		if !d.NewValueKnown(path) {
+			return
+		}
+
+		var id int
+		switch v := d.Get(path).(type) {
+		case int:
+			id = v
+		case string:
+			id, _ = strconv.Atoi(v)
+		}
+		if id > 0 {
+			var name string
+			if object, ok := strings.CutSuffix(path, ".id"); ok {
+				name, _ = d.Get(object + ".name").(string)
+			}
+			references = append(references, policyReference{path: path, kind: kind, id: id, name: name})
+		}
+	}
+	count := func(path string) int {
+		list, _ := d.Get(path).([]any)
+		return len(list)
+	}
+
+	add("category_id", &referenceCategory)
+	add("site_id", &referenceSite)
+
+	for i := range count("payloads") {
+		payload := fmt.Sprintf("payloads.%d", i)
+		for j := range count(payload + ".packages") {
+			packages := fmt.Sprintf("%s.packages.%d.package", payload, j)
+			for k := range count(packages) {
+				// Packages referenced by name were checked when resolving the name.
+				if name, _ := d.Get(fmt.Sprintf("%s.%d.name", packages, k)).(string); name == "" {
+					add(fmt.Sprintf("%s.%d.id", packages, k), &referencePackage)
+				}
+			}
+		}
+		for j := range count(payload + ".scripts") {
+			add(fmt.Sprintf("%s.scripts.%d.id", payload, j), &referenceScript)
+		}
+		for j := range count(payload + ".printers") {
+			add(fmt.Sprintf("%s.printers.%d.id", payload, j), &referencePrinter)
+		}
+		for j := range count(payload + ".dock_items") {
+			add(fmt.Sprintf("%s.dock_items.%d.id", payload, j), &referenceDockItem)
+		}
+		for j := range count(payload + ".disk_encryption") {
+			encryption := fmt.Sprintf("%s.disk_encryption.%d", payload, j)
+			add(encryption+".disk_encryption_configuration_id", &referenceDiskEncryption)
+			add(encryption+".remediate_disk_encryption_configuration_id", &referenceDiskEncryption)
+		}
+	}
+
+	for i := range count("self_service") {
+		categories := fmt.Sprintf("self_service.%d.self_service_category", i)
+		for j := range count(categories) {
+			add(fmt.Sprintf("%s.%d.id", categories, j), &referenceCategory)
+		}
+	}
+
+	return references
+}
+
+// suggestReference returns a "did you mean" hint naming the existing object whose name is closest
+// to the name configured with the ID, ignoring case, or an empty string when no name is configured
+// or no name is close enough. IDs are not compared, as a mistyped ID says nothing about which object
+// was meant.
+func suggestReference(name string, existing map[int]string) string {
+	if name == "" {
+		return ""
+	}
+	wanted := strings.ToLower(name)
+	maxDistance := len(wanted) / 2
+
+	ids := make([]int, 0, len(existing))
+	for candidate := range existing {
+		ids = append(ids, candidate)
+	}
+	slices.Sort(ids)
+
+	best, bestDistance := 0, maxDistance+1
+	for _, candidate := range ids {
+		distance := fuzzy.LevenshteinDistance(wanted, strings.ToLower(existing[candidate]))
+		if distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	if bestDistance > maxDistance {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %d (%q)?", best, existing[best])
+}
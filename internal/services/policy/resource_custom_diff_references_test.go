@@ -0,0 +1,77 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// knownData reports every value of its resource data as known.
+type knownData struct{ *schema.ResourceData }
+
+func (knownData) NewValueKnown(string) bool { return true }
+
+func TestCollectPolicyReferences(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPolicies().Schema, map[string]any{
+		"name":        "test",
+		"enabled":     true,
+		"category_id": 3,
+		"payloads": []any{map[string]any{
+			"packages": []any{map[string]any{
+				"distribution_point": "default",
+				"package":            []any{map[string]any{"id": 10}, map[string]any{"id": 11}},
+			}},
+			"scripts":         []any{map[string]any{"id": "20"}},
+			"disk_encryption": []any{map[string]any{"action": "apply", "disk_encryption_configuration_id": 30}},
+		}},
+		"self_service": []any{map[string]any{
+			"self_service_category": []any{map[string]any{"id": 4}},
+		}},
+	})
+
+	var got []string
+	for _, ref := range collectPolicyReferences(knownData{d}) {
+		got = append(got, ref.path+"="+ref.kind.name)
+	}
+
+	assert.ElementsMatch(t, []string{
+		"category_id=category",
+		"payloads.0.packages.0.package.0.id=package",
+		"payloads.0.packages.0.package.1.id=package",
+		"payloads.0.scripts.0.id=script",
+		"payloads.0.disk_encryption.0.disk_encryption_configuration_id=disk encryption configuration",
+		"self_service.0.self_service_category.0.id=category",
+	}, got, "site_id and the remediation configuration are unset")
+}
+
+func TestCollectPolicyReferenceNames(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPolicies().Schema, map[string]any{
+		"name":        "test",
+		"enabled":     true,
+		"category_id": 3,
+		"payloads": []any{map[string]any{
+			"printers": []any{map[string]any{"id": 5, "name": "Lobby Printer"}},
+		}},
+	})
+
+	names := map[string]string{}
+	for _, ref := range collectPolicyReferences(knownData{d}) {
+		names[ref.path] = ref.name
+	}
+
+	assert.Equal(t, map[string]string{
+		"category_id":              "",
+		"payloads.0.printers.0.id": "Lobby Printer",
+	}, names)
+}
+
+func TestSuggestReference(t *testing.T) {
+	existing := map[int]string{12: "Install Chrome", 210: "Install Firefox", 7: "Lobby Printer"}
+
+	assert.Equal(t, `, did you mean 7 ("Lobby Printer")?`, suggestReference("lobby printer", existing), "ignoring case")
+	assert.Equal(t, `, did you mean 210 ("Install Firefox")?`, suggestReference("Install Firefx", existing))
+	assert.Empty(t, suggestReference("", existing), "no suggestion without a configured name")
+	assert.Empty(t, suggestReference("Rosetta", existing), "no name is close enough")
+	assert.Empty(t, suggestReference("Lobby Printer", nil))
+}
@@ -0,0 +1,39 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// constructForUpdate returns the policy constructor used on update. When ignore_external_scope is
+// set, scope entries held by Jamf Pro which were added outside of this resource, e.g. by
+// jamfpro_policy_scope_target, are added to the constructed policy so the update keeps them.
+func constructForUpdate(meta any) func(*schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
+	return func(d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
+		resource, err := construct(d)
+		if err != nil || !d.Get("ignore_external_scope").(bool) {
+			return resource, err
+		}
+
+		remote, err := meta.(*jamfpro.Client).GetPolicyByID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Jamf Pro Policy '%s' to keep its external scope: %v", resource.General.Name, err)
+		}
+
+		priorData, err := sharedschemas.PriorScopeData(d, getPolicySchemaScope())
+		if err != nil {
+			return nil, err
+		}
+		previous := &jamfpro.ResourcePolicy{}
+		if err := constructScope(priorData, previous); err != nil {
+			return nil, err
+		}
+
+		sharedschemas.MergeExternalScope(&resource.Scope, &remote.Scope, &previous.Scope)
+
+		return resource, nil
+	}
+}
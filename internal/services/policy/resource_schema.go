@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,8 +21,9 @@ func ResourceJamfProPolicies() *schema.Resource {
 		DeleteContext: delete,
 		CustomizeDiff: mainCustomDiffFunc,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(70 * time.Second),
 			Read:   schema.DefaultTimeout(70 * time.Second),
@@ -155,8 +158,10 @@ func ResourceJamfProPolicies() *schema.Resource {
 					"Ethernet",
 				}, false),
 			},
-			"category_id": sharedschemas.GetSharedSchemaCategory(),
-			"site_id":     sharedschemas.GetSharedSchemaSite(),
+			"category_id":   sharedschemas.GetSharedSchemaCategoryWithName(),
+			"category_name": sharedschemas.GetSharedSchemaCategoryName(),
+			"site_id":       sharedschemas.GetSharedSchemaSiteWithName(),
+			"site_name":     sharedschemas.GetSharedSchemaSiteName(),
 			"date_time_limitations": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -182,8 +187,10 @@ func ResourceJamfProPolicies() *schema.Resource {
 				MaxItems:    1,
 				Required:    true,
 				Description: "Scope configuration for the profile.",
-				Elem:        sharedschemas.GetSharedmacOSComputerSchemaScope(),
+				Elem:        getPolicySchemaScope(),
 			},
+			"ignore_external_scope": sharedschemas.GetSharedSchemaIgnoreExternalScope(),
+			"name_references":       sharedschemas.GetSharedSchemaNameReferences(),
 			"self_service": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -198,6 +205,30 @@ func ResourceJamfProPolicies() *schema.Resource {
 				Default:     "default",
 				Description: "repository of which packages are collected from",
 			},
+			"validate_references": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, the plan checks that every package, script, printer, dock item, category, site " +
+					"and disk encryption configuration referenced by ID exists in Jamf Pro, and reports each missing " +
+					"ID with its attribute path and, for printers and dock items, the existing object whose name is " +
+					"closest to the configured name. Each referenced object type is listed once per plan of the policy.",
+			},
+			"validate_script_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, applying the policy warns when a `scripts` block sets a parameter the script " +
+					"never reads, e.g. `parameter5` for a script that only reads `$4`. Scripts whose interpreter " +
+					"cannot be analysed are skipped. The warnings are reported on apply, as the plans of this " +
+					"resource cannot carry warnings.",
+			},
 		},
 	}
 }
+
+// getPolicySchemaScope returns the shared computer scope schema, with computer groups also
+// accepted by name.
+func getPolicySchemaScope() *schema.Resource {
+	return sharedschemas.WithScopeGroupNames(sharedschemas.GetSharedmacOSComputerSchemaScope(), "computer_group", name_references.ComputerGroup)
+}
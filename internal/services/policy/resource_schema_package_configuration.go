@@ -21,8 +21,15 @@ func getPolicySchemaPackages() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"id": {
 							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Unique identifier of the package.",
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier of the package. Either `id` or `name` must be set.",
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "Name of the package, resolved to its ID when planning, as an alternative to `id`.",
 						},
 						"action": {
 							Type:         schema.TypeString,
@@ -0,0 +1,92 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/script_analysis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scriptParameterWarnings returns, when validate_script_parameters is set, a warning for each parameter
+// a scripts block passes to a script that never reads it. Plans of SDKv2 resources cannot carry
+// warnings, so they are reported on apply. Scripts whose interpreter cannot be analysed are skipped.
+func scriptParameterWarnings(d *schema.ResourceData, meta any) diag.Diagnostics {
+	if !d.Get("validate_script_parameters").(bool) {
+		return nil
+	}
+
+	blocks := scriptBlocks(d)
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	response, err := meta.(*jamfpro.Client).GetScripts(nil)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Script parameter validation skipped",
+			Detail:   fmt.Sprintf("Failed to list scripts: %v", err),
+		}}
+	}
+
+	scripts := make(map[string]jamfpro.ResourceScript, len(response.Results))
+	for _, script := range response.Results {
+		scripts[script.ID] = script
+	}
+
+	var diags diag.Diagnostics
+	for path, block := range blocks {
+		script, ok := scripts[block["id"].(string)]
+		if !ok {
+			continue
+		}
+		for _, warning := range unreadScriptParameters(script, block) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Script parameter never read",
+				Detail:   fmt.Sprintf("%s: %s.", path, warning),
+			})
+		}
+	}
+	return diags
+}
+
+// scriptBlocks returns the scripts blocks of the policy, keyed by attribute path.
+func scriptBlocks(d *schema.ResourceData) map[string]map[string]any {
+	blocks := make(map[string]map[string]any)
+	payloads, _ := d.Get("payloads").([]any)
+	for i, payload := range payloads {
+		payloadMap, ok := payload.(map[string]any)
+		if !ok {
+			continue
+		}
+		scripts, _ := payloadMap["scripts"].([]any)
+		for j, script := range scripts {
+			if block, ok := script.(map[string]any); ok {
+				blocks[fmt.Sprintf("payloads.%d.scripts.%d", i, j)] = block
+			}
+		}
+	}
+	return blocks
+}
+
+// unreadScriptParameters describes each parameter value the scripts block passes that the script
+// never reads.
+func unreadScriptParameters(script jamfpro.ResourceScript, block map[string]any) []string {
+	interpreter, err := script_analysis.ParseInterpreter(script.ScriptContents)
+	if err != nil {
+		return nil
+	}
+
+	params := script_analysis.ReadParameters(script.ScriptContents, interpreter)
+	var unread []string
+	for number := script_analysis.FirstParameter; number <= script_analysis.LastParameter; number++ {
+		value, _ := block[fmt.Sprintf("parameter%d", number)].(string)
+		if value != "" && !params.Reads(number) {
+			unread = append(unread, fmt.Sprintf("parameter%d is set, but the script %q never reads parameter %d", number, script.Name, number))
+		}
+	}
+	return unread
+}
@@ -0,0 +1,34 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnreadScriptParameters(t *testing.T) {
+	script := jamfpro.ResourceScript{Name: "Rename", ScriptContents: "#!/bin/zsh\nscutil --set ComputerName \"$4\"\n"}
+	block := map[string]any{"id": "1", "parameter4": "mac-01", "parameter5": "unused", "parameter6": ""}
+
+	assert.Equal(t, []string{`parameter5 is set, but the script "Rename" never reads parameter 5`}, unreadScriptParameters(script, block))
+
+	script.ScriptContents = "#!/bin/zsh\nfor arg in \"$@\"; do echo $arg; done\n"
+	assert.Empty(t, unreadScriptParameters(script, block), "scripts reading every argument may read any parameter")
+
+	script.ScriptContents = "#!/usr/bin/perl\nprint $ARGV[4];\n"
+	assert.Empty(t, unreadScriptParameters(script, block), "scripts that cannot be analysed are skipped")
+}
+
+func TestScriptParameterWarningsOptIn(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProPolicies().Schema, map[string]any{
+		"name":                "test",
+		"validate_references": true,
+		"payloads": []any{map[string]any{
+			"scripts": []any{map[string]any{"id": "1", "parameter5": "unused"}},
+		}},
+	})
+
+	assert.Empty(t, scriptParameterWarnings(d, nil), "validate_references does not check script parameters")
+}
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,7 +24,7 @@ func statePayloads(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *
 	prepStatePayloadDiskEncryption(&out, resp)
 
 	// Packages
-	prepStatePayloadPackages(&out, resp)
+	prepStatePayloadPackages(&out, resp, d)
 
 	// Scripts
 	prepStatePayloadScripts(&out, resp)
@@ -88,8 +90,9 @@ func prepStatePayloadDiskEncryption(out *[]map[string]any, resp *jamfpro.Resourc
 	(*out)[0]["disk_encryption"] = []map[string]any{diskEncryptionStatePayload}
 }
 
-// Reads response and preps package payload items
-func prepStatePayloadPackages(out *[]map[string]any, resp *jamfpro.ResourcePolicy) {
+// Reads response and preps package payload items. A package keeps the name it is configured by
+// while the name still resolves to its ID.
+func prepStatePayloadPackages(out *[]map[string]any, resp *jamfpro.ResourcePolicy, d *schema.ResourceData) {
 	if len(resp.PackageConfiguration.Packages) == 0 {
 		return
 	}
@@ -98,9 +101,15 @@ func prepStatePayloadPackages(out *[]map[string]any, resp *jamfpro.ResourcePolic
 	packagesMap["distribution_point"] = resp.PackageConfiguration.DistributionPoint
 	packagesMap["package"] = make([]map[string]any, 0)
 
-	for _, v := range resp.PackageConfiguration.Packages {
+	references, _ := d.Get("name_references").(map[string]any)
+	for i, v := range resp.PackageConfiguration.Packages {
 		outMap := make(map[string]any)
 		outMap["id"] = v.ID
+		outMap["name"] = ""
+		if name, _ := d.Get(fmt.Sprintf("payloads.0.packages.0.package.%d.name", i)).(string); name != "" &&
+			references[name_references.Key(name_references.Package, name)] == strconv.Itoa(v.ID) {
+			outMap["name"] = name
+		}
 		outMap["action"] = v.Action
 		outMap["fill_user_template"] = v.FillUserTemplate
 		outMap["fill_existing_user_template"] = v.FillExistingUsers
@@ -7,6 +7,8 @@ import (
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/name_references"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -239,6 +241,9 @@ func stateScope(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *dia
 		log.Println("No exclusions set") // TODO logging
 	}
 
+	sharedschemas.FlattenScopeGroupNames(d, out_scope[0], "computer_group", name_references.ComputerGroup)
+	sharedschemas.FilterExternalScope(d, out_scope[0])
+
 	// State Scope
 	err = d.Set("scope", out_scope)
 	if err != nil {
@@ -0,0 +1,157 @@
+package policy_scope_target_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPolicyScopeTarget_basic(t *testing.T) {
+	name := acctest.RandomName("policy-scope-target")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyScopeTargetConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_policy.test", "scope.0.computer_group_ids.#", "1"),
+					testAccCheckPolicyScopeGroups("jamfpro_policy.test", "jamfpro_static_computer_group.owned", "jamfpro_static_computer_group.target"),
+					testAccCheckPolicyExcludedGroup("jamfpro_policy.test", "jamfpro_static_computer_group.excluded"),
+				),
+			},
+			{
+				// Updating the policy keeps the entries added by the scope targets.
+				Config: testAccPolicyScopeTargetConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_policy.test", "enabled", "true"),
+					testAccCheckPolicyScopeGroups("jamfpro_policy.test", "jamfpro_static_computer_group.owned", "jamfpro_static_computer_group.target"),
+					testAccCheckPolicyExcludedGroup("jamfpro_policy.test", "jamfpro_static_computer_group.excluded"),
+				),
+			},
+			{
+				ResourceName:      "jamfpro_policy_scope_target.exclusion",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckPolicyScopeGroups checks the policy is scoped to exactly the given computer groups.
+func testAccCheckPolicyScopeGroups(policy string, groups ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		p, err := testAccGetPolicy(s, policy)
+		if err != nil {
+			return err
+		}
+
+		var scoped []jamfpro.PolicySubsetComputerGroup
+		if p.Scope.ComputerGroups != nil {
+			scoped = *p.Scope.ComputerGroups
+		}
+		if len(scoped) != len(groups) {
+			return fmt.Errorf("expected %d scoped computer groups, got %d", len(groups), len(scoped))
+		}
+		for _, group := range groups {
+			if !testAccContainsGroup(s, scoped, group) {
+				return fmt.Errorf("%s is not in the scope of %s", group, policy)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckPolicyExcludedGroup checks the computer group is excluded from the policy scope.
+func testAccCheckPolicyExcludedGroup(policy, group string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		p, err := testAccGetPolicy(s, policy)
+		if err != nil {
+			return err
+		}
+
+		if p.Scope.Exclusions == nil || p.Scope.Exclusions.ComputerGroups == nil ||
+			!testAccContainsGroup(s, *p.Scope.Exclusions.ComputerGroups, group) {
+			return fmt.Errorf("%s is not excluded from %s", group, policy)
+		}
+		return nil
+	}
+}
+
+func testAccGetPolicy(s *terraform.State, policy string) (*jamfpro.ResourcePolicy, error) {
+	rs, ok := s.RootModule().Resources[policy]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found in state", policy)
+	}
+	client, err := acctest.Client()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyByID(rs.Primary.ID)
+}
+
+func testAccContainsGroup(s *terraform.State, groups []jamfpro.PolicySubsetComputerGroup, group string) bool {
+	rs, ok := s.RootModule().Resources[group]
+	if !ok {
+		return false
+	}
+	for _, g := range groups {
+		if fmt.Sprint(g.ID) == rs.Primary.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func testAccPolicyScopeTargetConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "jamfpro_static_computer_group" "owned" {
+  name = "%[1]s-owned"
+}
+
+resource "jamfpro_static_computer_group" "target" {
+  name = "%[1]s-target"
+}
+
+resource "jamfpro_static_computer_group" "excluded" {
+  name = "%[1]s-excluded"
+}
+
+resource "jamfpro_policy" "test" {
+  name                  = %[1]q
+  enabled               = %[2]t
+  trigger_checkin       = true
+  frequency             = "Once per computer"
+  ignore_external_scope = true
+
+  scope {
+    all_computers      = false
+    computer_group_ids = [jamfpro_static_computer_group.owned.id]
+  }
+
+  payloads {
+    maintenance {
+      recon = true
+    }
+  }
+}
+
+resource "jamfpro_policy_scope_target" "target" {
+  policy_id   = jamfpro_policy.test.id
+  target_type = "computer_group"
+  target_id   = jamfpro_static_computer_group.target.id
+}
+
+resource "jamfpro_policy_scope_target" "exclusion" {
+  policy_id   = jamfpro_policy.test.id
+  target_type = "computer_group"
+  target_id   = jamfpro_static_computer_group.excluded.id
+  exclusion   = true
+}
+`, name, enabled)
+}
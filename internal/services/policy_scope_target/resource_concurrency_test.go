@@ -0,0 +1,110 @@
+package policy_scope_target_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest/fakejamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/scope_locks"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/services/policy_scope_target"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClient returns a client configured against a new fake Jamf Pro server.
+func fakeClient(t *testing.T) *jamfpro.Client {
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+
+	t.Setenv("JAMFPRO_INSTANCE_FQDN", server.URL)
+	t.Setenv("JAMFPRO_AUTH_METHOD", "oauth2")
+	t.Setenv("JAMFPRO_AUTH_PROVIDER", "direct")
+	t.Setenv("JAMFPRO_CLIENT_ID", server.ClientID)
+	t.Setenv("JAMFPRO_CLIENT_SECRET", server.ClientSecret)
+	client, err := acctest.Client()
+	require.NoError(t, err)
+
+	return client
+}
+
+// createTarget applies a jamfpro_policy_scope_target adding the computer group to the policy.
+func createTarget(t *testing.T, client *jamfpro.Client, policyID string, groupID int) error {
+	r := policy_scope_target.ResourceJamfProPolicyScopeTarget()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]any{
+		"policy_id":   policyID,
+		"target_type": "computer_group",
+		"target_id":   groupID,
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		return fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+	}
+	return nil
+}
+
+// The policy resource holds the shared scope lock while it reads, merges and writes the policy, so
+// a scope target added meanwhile waits, and neither write loses the other.
+func TestScopeTargetWaitsForPolicyUpdate(t *testing.T) {
+	client := fakeClient(t)
+
+	created, err := client.CreatePolicy(&jamfpro.ResourcePolicy{General: jamfpro.PolicySubsetGeneral{Name: "shared"}})
+	require.NoError(t, err)
+	policyID := strconv.Itoa(created.ID)
+
+	unlock := scope_locks.Policy(policyID)
+	done := make(chan error, 1)
+	go func() { done <- createTarget(t, client, policyID, 7) }()
+
+	select {
+	case err := <-done:
+		t.Fatalf("scope target was written while the policy was locked: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	policy, err := client.GetPolicyByID(policyID)
+	require.NoError(t, err)
+	policy.General.Name = "renamed"
+	_, err = client.UpdatePolicyByID(policyID, policy)
+	require.NoError(t, err)
+	unlock()
+
+	require.NoError(t, <-done)
+
+	policy, err = client.GetPolicyByID(policyID)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", policy.General.Name)
+	require.NotNil(t, policy.Scope.ComputerGroups)
+	require.Len(t, *policy.Scope.ComputerGroups, 1)
+	assert.Equal(t, 7, (*policy.Scope.ComputerGroups)[0].ID)
+}
+
+func TestConcurrentScopeTargetsAreAllKept(t *testing.T) {
+	client := fakeClient(t)
+
+	created, err := client.CreatePolicy(&jamfpro.ResourcePolicy{General: jamfpro.PolicySubsetGeneral{Name: "shared"}})
+	require.NoError(t, err)
+	policyID := strconv.Itoa(created.ID)
+
+	var wg sync.WaitGroup
+	for groupID := 1; groupID <= 8; groupID++ {
+		wg.Go(func() {
+			assert.NoError(t, createTarget(t, client, policyID, groupID))
+		})
+	}
+	wg.Wait()
+
+	policy, err := client.GetPolicyByID(policyID)
+	require.NoError(t, err)
+	require.NotNil(t, policy.Scope.ComputerGroups)
+	var ids []int
+	for _, group := range *policy.Scope.ComputerGroups {
+		ids = append(ids, group.ID)
+	}
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, ids)
+}
@@ -0,0 +1,154 @@
+package policy_scope_target
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/scope_locks"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scopeTarget identifies a scope entry of a policy.
+type scopeTarget struct {
+	policyID   string
+	targetType string
+	targetID   int
+	exclusion  bool
+}
+
+// scopeTargetFromResourceData reads the scope target from the resource data.
+func scopeTargetFromResourceData(d *schema.ResourceData) scopeTarget {
+	return scopeTarget{
+		policyID:   d.Get("policy_id").(string),
+		targetType: d.Get("target_type").(string),
+		targetID:   d.Get("target_id").(int),
+		exclusion:  d.Get("exclusion").(bool),
+	}
+}
+
+// id returns the resource ID of the scope target.
+func (t scopeTarget) id() string {
+	kind := "target"
+	if t.exclusion {
+		kind = "exclusion"
+	}
+	return fmt.Sprintf("%s:%s:%s:%d", t.policyID, kind, t.targetType, t.targetID)
+}
+
+// create is responsible for adding the target to the policy scope.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	target := scopeTargetFromResourceData(d)
+
+	if err := modifyScope(meta.(*jamfpro.Client), target, sharedschemas.AddScopeEntry); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(target.id())
+
+	return read(ctx, d, meta)
+}
+
+// read is responsible for checking the target is still part of the policy scope. A target removed
+// outside of Terraform is removed from state, so that it is added again on the next apply.
+func read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	target := scopeTargetFromResourceData(d)
+
+	field, err := sharedschemas.ScopeTargetField(target.targetType, target.exclusion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := read_cache.Memoized(meta, "policy", meta.(*jamfpro.Client).GetPolicyByID)(target.policyID)
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, true)
+	}
+
+	present, err := sharedschemas.HasScopeEntry(&policy.Scope, field, target.targetID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !present {
+		log.Printf("[WARN] %s %d is no longer in the scope of Jamf Pro Policy '%s', removing from state", target.targetType, target.targetID, target.policyID)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// delete is responsible for removing the target from the policy scope.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := modifyScope(meta.(*jamfpro.Client), scopeTargetFromResourceData(d), sharedschemas.RemoveScopeEntry)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// modifyScope applies change to the scope of the target's policy under the policy lock, and
+// writes the policy back when the scope changed.
+func modifyScope(client *jamfpro.Client, target scopeTarget, change func(scope any, field []string, id int) (bool, error)) error {
+	field, err := sharedschemas.ScopeTargetField(target.targetType, target.exclusion)
+	if err != nil {
+		return err
+	}
+
+	defer scope_locks.Policy(target.policyID)()
+
+	policy, err := client.GetPolicyByID(target.policyID)
+	if err != nil {
+		return fmt.Errorf("failed to read Jamf Pro Policy '%s': %v", target.policyID, err)
+	}
+
+	changed, err := change(&policy.Scope, field, target.targetID)
+	if err != nil || !changed {
+		return err
+	}
+
+	_, err = client.UpdatePolicyByID(target.policyID, policy)
+	read_cache.Invalidate(client, target.policyID)
+	if err != nil {
+		return fmt.Errorf("failed to update scope of Jamf Pro Policy '%s' (ID: %s): %v", policy.General.Name, target.policyID, err)
+	}
+
+	return nil
+}
+
+// importState imports a scope target by its ID, in the form
+// <policy_id>:<target|exclusion>:<target_type>:<target_id>.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 4 || !numericID.MatchString(parts[0]) || (parts[1] != "target" && parts[1] != "exclusion") {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <policy_id>:<target|exclusion>:<target_type>:<target_id>", d.Id())
+	}
+	if _, err := sharedschemas.ScopeTargetField(parts[2], false); err != nil || parts[2] == sharedschemas.ScopeTargetMobileDeviceGroup {
+		return nil, fmt.Errorf("unexpected target type %q in import ID %q", parts[2], d.Id())
+	}
+	targetID, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected target ID %q in import ID %q", parts[3], d.Id())
+	}
+
+	for attribute, value := range map[string]any{
+		"policy_id":   parts[0],
+		"exclusion":   parts[1] == "exclusion",
+		"target_type": parts[2],
+		"target_id":   targetID,
+	} {
+		if err := d.Set(attribute, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
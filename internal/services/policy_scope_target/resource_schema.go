@@ -0,0 +1,76 @@
+package policy_scope_target
+
+import (
+	"regexp"
+	"time"
+
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// numericID matches a Jamf Pro object ID.
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// ResourceJamfProPolicyScopeTarget defines the schema and CRUD operations for managing a single
+// scope target or exclusion of a Jamf Pro policy in Terraform.
+func ResourceJamfProPolicyScopeTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   read,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Description: "Adds a single scope target or exclusion to an existing Jamf Pro policy, leaving the rest of " +
+			"its scope untouched. Set `ignore_external_scope` on the `jamfpro_policy` resource so that it keeps " +
+			"entries added this way.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the scope target, in the form `<policy_id>:<target|exclusion>:<target_type>:<target_id>`.",
+			},
+			"policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric Jamf Pro ID"),
+				Description:  "The ID of the policy to scope.",
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					sharedschemas.ScopeTargetComputerGroup,
+					sharedschemas.ScopeTargetBuilding,
+					sharedschemas.ScopeTargetDepartment,
+					sharedschemas.ScopeTargetNetworkSegment,
+					sharedschemas.ScopeTargetJSSUserGroup,
+				}, false),
+				Description: "The type of the target: `computer_group`, `building`, `department`, `network_segment` or " +
+					"`jss_user_group`. A `network_segment` target is added as a scope limitation.",
+			},
+			"target_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The Jamf Pro ID of the target.",
+			},
+			"exclusion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the target is added to the scope exclusions rather than the scope.",
+			},
+		},
+	}
+}
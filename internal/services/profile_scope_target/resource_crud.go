@@ -0,0 +1,226 @@
+package profile_scope_target
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/errors"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/scope_locks"
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// redeployNewlyAssigned limits redeployment after a scope change to newly scoped devices.
+const redeployNewlyAssigned = "Newly Assigned"
+
+// scopeTarget identifies a scope entry of a configuration profile.
+type scopeTarget struct {
+	profileType string
+	profileID   string
+	targetType  string
+	targetID    int
+	exclusion   bool
+}
+
+// scopeTargetFromResourceData reads the scope target from the resource data.
+func scopeTargetFromResourceData(d *schema.ResourceData) scopeTarget {
+	return scopeTarget{
+		profileType: d.Get("profile_type").(string),
+		profileID:   d.Get("profile_id").(string),
+		targetType:  d.Get("target_type").(string),
+		targetID:    d.Get("target_id").(int),
+		exclusion:   d.Get("exclusion").(bool),
+	}
+}
+
+// id returns the resource ID of the scope target.
+func (t scopeTarget) id() string {
+	kind := "target"
+	if t.exclusion {
+		kind = "exclusion"
+	}
+	return fmt.Sprintf("%s:%s:%s:%s:%d", t.profileType, t.profileID, kind, t.targetType, t.targetID)
+}
+
+// profile is a configuration profile read from Jamf Pro, whichever its type.
+type profile struct {
+	name  string
+	scope any
+	write func() error
+}
+
+// getProfile reads the target's profile, through the read cache when cached is set. Writing it back
+// leaves the payload out of the request, so that Jamf Pro keeps it as is, and only redeploys the
+// profile to newly scoped devices.
+func getProfile(client *jamfpro.Client, target scopeTarget, cached bool) (*profile, error) {
+	getMacOS, getMobileDevice := client.GetMacOSConfigurationProfileByID, client.GetMobileDeviceConfigurationProfileByID
+	if cached {
+		getMacOS = read_cache.Memoized(client, "macos_configuration_profile", getMacOS)
+		getMobileDevice = read_cache.Memoized(client, "mobile_device_configuration_profile", getMobileDevice)
+	}
+
+	switch target.profileType {
+	case profileTypeMacOS:
+		resp, err := getMacOS(target.profileID)
+		if err != nil {
+			return nil, err
+		}
+		return &profile{
+			name:  resp.General.Name,
+			scope: &resp.Scope,
+			write: func() error {
+				resp.General.Payloads = ""
+				resp.General.RedeployOnUpdate = redeployNewlyAssigned
+				_, err := client.UpdateMacOSConfigurationProfileByID(target.profileID, resp)
+				return err
+			},
+		}, nil
+	case profileTypeMobileDevice:
+		resp, err := getMobileDevice(target.profileID)
+		if err != nil {
+			return nil, err
+		}
+		return &profile{
+			name:  resp.General.Name,
+			scope: &resp.Scope,
+			write: func() error {
+				resp.General.Payloads = ""
+				resp.General.RedeployOnUpdate = redeployNewlyAssigned
+				_, err := client.UpdateMobileDeviceConfigurationProfileByID(target.profileID, resp)
+				return err
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported profile type %q", target.profileType)
+	}
+}
+
+// create is responsible for adding the target to the profile scope.
+func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	target := scopeTargetFromResourceData(d)
+
+	if err := modifyScope(meta.(*jamfpro.Client), target, sharedschemas.AddScopeEntry); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(target.id())
+
+	return read(ctx, d, meta)
+}
+
+// read is responsible for checking the target is still part of the profile scope. A target removed
+// outside of Terraform is removed from state, so that it is added again on the next apply.
+func read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	target := scopeTargetFromResourceData(d)
+
+	field, err := sharedschemas.ScopeTargetField(target.targetType, target.exclusion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	p, err := getProfile(meta.(*jamfpro.Client), target, true)
+	if err != nil {
+		return errors.HandleResourceNotFoundError(err, d, true)
+	}
+
+	present, err := sharedschemas.HasScopeEntry(p.scope, field, target.targetID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !present {
+		log.Printf("[WARN] %s %d is no longer in the scope of Jamf Pro %s Configuration Profile '%s', removing from state", target.targetType, target.targetID, target.profileType, target.profileID)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// delete is responsible for removing the target from the profile scope.
+func delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := modifyScope(meta.(*jamfpro.Client), scopeTargetFromResourceData(d), sharedschemas.RemoveScopeEntry)
+	if err != nil && !strings.Contains(err.Error(), "404") {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// lockProfile locks the scope of the target's profile, shared with the profile resource, and
+// returns the function which releases it.
+func lockProfile(target scopeTarget) func() {
+	if target.profileType == profileTypeMobileDevice {
+		return scope_locks.MobileDeviceConfigurationProfile(target.profileID)
+	}
+	return scope_locks.MacOSConfigurationProfile(target.profileID)
+}
+
+// modifyScope applies change to the scope of the target's profile under the profile lock, and
+// writes the profile back when the scope changed.
+func modifyScope(client *jamfpro.Client, target scopeTarget, change func(scope any, field []string, id int) (bool, error)) error {
+	field, err := sharedschemas.ScopeTargetField(target.targetType, target.exclusion)
+	if err != nil {
+		return err
+	}
+
+	defer lockProfile(target)()
+
+	p, err := getProfile(client, target, false)
+	if err != nil {
+		return fmt.Errorf("failed to read Jamf Pro %s Configuration Profile '%s': %v", target.profileType, target.profileID, err)
+	}
+
+	changed, err := change(p.scope, field, target.targetID)
+	if err != nil || !changed {
+		return err
+	}
+
+	err = p.write()
+	read_cache.Invalidate(client, target.profileID)
+	if err != nil {
+		return fmt.Errorf("failed to update scope of Jamf Pro %s Configuration Profile '%s' (ID: %s): %v", target.profileType, p.name, target.profileID, err)
+	}
+
+	return nil
+}
+
+// importState imports a scope target by its ID, in the form
+// <profile_type>:<profile_id>:<target|exclusion>:<target_type>:<target_id>.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 5 || (parts[0] != profileTypeMacOS && parts[0] != profileTypeMobileDevice) ||
+		!numericID.MatchString(parts[1]) || (parts[2] != "target" && parts[2] != "exclusion") {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <profile_type>:<profile_id>:<target|exclusion>:<target_type>:<target_id>", d.Id())
+	}
+	if _, err := sharedschemas.ScopeTargetField(parts[3], false); err != nil {
+		return nil, fmt.Errorf("unexpected target type %q in import ID %q", parts[3], d.Id())
+	}
+	if err := checkTargetType(parts[0], parts[3]); err != nil {
+		return nil, err
+	}
+	targetID, err := strconv.Atoi(parts[4])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected target ID %q in import ID %q", parts[4], d.Id())
+	}
+
+	for attribute, value := range map[string]any{
+		"profile_type": parts[0],
+		"profile_id":   parts[1],
+		"exclusion":    parts[2] == "exclusion",
+		"target_type":  parts[3],
+		"target_id":    targetID,
+	} {
+		if err := d.Set(attribute, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,111 @@
+package profile_scope_target
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Profile types accepted by the resource.
+const (
+	profileTypeMacOS        = "macos"
+	profileTypeMobileDevice = "mobile_device"
+)
+
+// numericID matches a Jamf Pro object ID.
+var numericID = regexp.MustCompile(`^[0-9]+$`)
+
+// ResourceJamfProProfileScopeTarget defines the schema and CRUD operations for managing a single
+// scope target or exclusion of a Jamf Pro configuration profile in Terraform.
+func ResourceJamfProProfileScopeTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   read,
+		DeleteContext: delete,
+		CustomizeDiff: validateTargetType,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Description: "Adds a single scope target or exclusion to an existing Jamf Pro macOS or mobile device " +
+			"configuration profile, leaving the rest of its scope untouched. The profile is only deployed to newly " +
+			"scoped devices. Set `ignore_external_scope` on the profile resource so that it keeps entries added this way.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The ID of the scope target, in the form " +
+					"`<profile_type>:<profile_id>:<target|exclusion>:<target_type>:<target_id>`.",
+			},
+			"profile_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{profileTypeMacOS, profileTypeMobileDevice}, false),
+				Description:  "The type of the profile: `macos` or `mobile_device`.",
+			},
+			"profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(numericID, "must be a numeric Jamf Pro ID"),
+				Description:  "The ID of the configuration profile to scope.",
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					sharedschemas.ScopeTargetComputerGroup,
+					sharedschemas.ScopeTargetMobileDeviceGroup,
+					sharedschemas.ScopeTargetBuilding,
+					sharedschemas.ScopeTargetDepartment,
+					sharedschemas.ScopeTargetNetworkSegment,
+					sharedschemas.ScopeTargetJSSUserGroup,
+				}, false),
+				Description: "The type of the target: `computer_group` (macOS profiles only), `mobile_device_group` " +
+					"(mobile device profiles only), `building`, `department`, `network_segment` or `jss_user_group`. " +
+					"A `network_segment` target is added as a scope limitation.",
+			},
+			"target_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The Jamf Pro ID of the target.",
+			},
+			"exclusion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the target is added to the scope exclusions rather than the scope.",
+			},
+		},
+	}
+}
+
+// validateTargetType rejects device group targets that do not match the profile type.
+func validateTargetType(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	return checkTargetType(d.Get("profile_type").(string), d.Get("target_type").(string))
+}
+
+// checkTargetType reports an error when the target type cannot scope the profile type.
+func checkTargetType(profileType, targetType string) error {
+	switch {
+	case profileType == profileTypeMacOS && targetType == sharedschemas.ScopeTargetMobileDeviceGroup:
+		return fmt.Errorf("target_type %q cannot scope a macOS configuration profile", targetType)
+	case profileType == profileTypeMobileDevice && targetType == sharedschemas.ScopeTargetComputerGroup:
+		return fmt.Errorf("target_type %q cannot scope a mobile device configuration profile", targetType)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package script
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewScriptListResource creates the jamfpro_script list resource used by `terraform query`.
+func NewScriptListResource() list.ListResource {
+	return sdkv2_list.New("_script", ResourceJamfProScripts, listScriptItems)
+}
+
+// listScriptItems returns every script as list resource items.
+func listScriptItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	scripts, err := listScripts(client)()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(scripts))
+	for _, script := range scripts {
+		items = append(items, sdkv2_list.Item{ID: script.ID, Name: script.Name})
+	}
+	return items, nil
+}
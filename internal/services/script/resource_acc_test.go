@@ -0,0 +1,109 @@
+package script_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScript_basic(t *testing.T) {
+	name := acctest.RandomName("script")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CheckDestroy("jamfpro_script", func(client *jamfpro.Client, id string) error {
+			_, err := client.GetScriptByID(id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScriptConfig(name, "echo hello", "BEFORE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_script.test", "name", name),
+					resource.TestCheckResourceAttr("jamfpro_script.test", "script_contents", "#!/bin/zsh\necho hello\n"),
+					resource.TestCheckResourceAttr("jamfpro_script.test", "priority", "BEFORE"),
+					resource.TestCheckResourceAttr("jamfpro_script.test", "parameter4", "target"),
+					resource.TestCheckResourceAttrPair("jamfpro_script.test", "category_id", "jamfpro_category.test", "id"),
+				),
+			},
+			{
+				Config: testAccScriptConfig(name, "echo updated", "AFTER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_script.test", "script_contents", "#!/bin/zsh\necho updated\n"),
+					resource.TestCheckResourceAttr("jamfpro_script.test", "priority", "AFTER"),
+				),
+			},
+			{
+				ResourceName:      "jamfpro_script.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Defaults are not set on import.
+				ImportStateVerifyIgnore: []string{"validation_mode"},
+			},
+		},
+	})
+}
+
+func testAccScriptConfig(name, command, priority string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_category" "test" {
+  name = "%[1]s-category"
+}
+
+resource "jamfpro_script" "test" {
+  name            = %[1]q
+  category_id     = jamfpro_category.test.id
+  priority        = %[3]q
+  info            = "Acceptance test script"
+  parameter4      = "target"
+  script_contents = <<-EOT
+    #!/bin/zsh
+    %[2]s
+  EOT
+}
+`, name, command, priority)
+}
+
+func TestAccScript_validationMode(t *testing.T) {
+	name := acctest.RandomName("script")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptValidationConfig(name, "error", "target", "Unused"),
+				ExpectError: regexp.MustCompile(`parameter5 is labelled "Unused", but the script never reads parameter 5`),
+			},
+			{
+				Config: testAccScriptValidationConfig(name, "error", "target", ""),
+				Check:  resource.TestCheckResourceAttr("jamfpro_script.test", "validation_mode", "error"),
+			},
+			{
+				Config:      testAccScriptValidationConfig(name, "error", "", ""),
+				ExpectError: regexp.MustCompile(`the script reads parameter 4, but parameter4 has no label`),
+			},
+		},
+	})
+}
+
+func testAccScriptValidationConfig(name, mode, parameter4, parameter5 string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_script" "test" {
+  name            = %[1]q
+  priority        = "BEFORE"
+  validation_mode = %[2]q
+  parameter4      = %[3]q
+  parameter5      = %[4]q
+  script_contents = <<-EOT
+    #!/bin/zsh
+    echo "$4"
+  EOT
+}
+`, name, mode, parameter4, parameter5)
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/read_cache"
 	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,8 +13,7 @@ import (
 
 // create is responsible for creating a new Jamf Pro Script in the remote system.
 func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-
-	return crud.Create(
+	diags := crud.Create(
 		ctx,
 		d,
 		meta,
@@ -21,6 +21,11 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		meta.(*jamfpro.Client).CreateScript,
 		readNoCleanup,
 	)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, validationWarnings(d)...)
 }
 
 // read is responsible for reading the current state of a Jamf Pro Script Resource from the remote system.
@@ -30,11 +35,28 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 		d,
 		meta,
 		cleanup,
-		meta.(*jamfpro.Client).GetScriptByID,
+		read_cache.Getter(
+			meta,
+			"script",
+			listScripts(meta.(*jamfpro.Client)),
+			func(r *jamfpro.ResourceScript) string { return r.ID },
+			meta.(*jamfpro.Client).GetScriptByID,
+		),
 		updateState,
 	)
 }
 
+// listScripts returns every script for the read cache prefetch.
+func listScripts(client *jamfpro.Client) func() ([]jamfpro.ResourceScript, error) {
+	return func() ([]jamfpro.ResourceScript, error) {
+		response, err := client.GetScripts(nil)
+		if err != nil {
+			return nil, err
+		}
+		return response.Results, nil
+	}
+}
+
 // readWithCleanup reads the resource with cleanup enabled
 func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	return read(ctx, d, meta, true)
@@ -47,7 +69,7 @@ func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 
 // update is responsible for updating an existing Jamf Pro Script on the remote system.
 func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return crud.Update(
+	diags := crud.Update(
 		ctx,
 		d,
 		meta,
@@ -55,6 +77,11 @@ func update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		meta.(*jamfpro.Client).UpdateScriptByID,
 		readNoCleanup,
 	)
+	if diags.HasError() || !d.HasChanges(append([]string{"script_contents", "validation_mode"}, parameterKeys()...)...) {
+		return diags
+	}
+
+	return append(diags, validationWarnings(d)...)
 }
 
 var mu sync.Mutex
@@ -0,0 +1,79 @@
+package script
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/script_analysis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Values of validation_mode.
+const (
+	validationModeOff   = "off"
+	validationModeWarn  = "warn"
+	validationModeError = "error"
+)
+
+// valueGetter is the part of schema.ResourceData and schema.ResourceDiff used to analyse a script.
+type valueGetter interface {
+	Get(key string) any
+}
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if diff.Get("validation_mode").(string) != validationModeError || !diff.NewValueKnown("script_contents") {
+		return nil
+	}
+
+	for number := script_analysis.FirstParameter; number <= script_analysis.LastParameter; number++ {
+		if !diff.NewValueKnown(fmt.Sprintf("parameter%d", number)) {
+			return nil
+		}
+	}
+
+	if findings := analyzeScript(diff); len(findings) > 0 {
+		return fmt.Errorf("script_contents failed validation:\n  %s", strings.Join(findings, "\n  "))
+	}
+
+	return nil
+}
+
+// analyzeScript returns the problems found in the configured script contents and parameter labels.
+func analyzeScript(d valueGetter) []string {
+	labels := make(map[int]string)
+	for number := script_analysis.FirstParameter; number <= script_analysis.LastParameter; number++ {
+		labels[number] = d.Get(fmt.Sprintf("parameter%d", number)).(string)
+	}
+
+	return script_analysis.Analyze(d.Get("script_contents").(string), labels)
+}
+
+// validationWarnings returns a warning for each problem found in the script when validation_mode
+// is warn. Plans of SDKv2 resources cannot carry warnings, so they are reported on apply.
+func validationWarnings(d *schema.ResourceData) diag.Diagnostics {
+	if d.Get("validation_mode").(string) != validationModeWarn {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, finding := range analyzeScript(d) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Script validation",
+			Detail:   fmt.Sprintf("Script %q: %s.", d.Get("name").(string), finding),
+		})
+	}
+	return diags
+}
+
+// parameterKeys returns the keys of the parameter labels.
+func parameterKeys() []string {
+	keys := make([]string, 0, script_analysis.LastParameter-script_analysis.FirstParameter+1)
+	for number := script_analysis.FirstParameter; number <= script_analysis.LastParameter; number++ {
+		keys = append(keys, fmt.Sprintf("parameter%d", number))
+	}
+	return keys
+}
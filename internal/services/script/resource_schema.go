@@ -4,6 +4,7 @@ package script
 import (
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,6 +17,7 @@ func ResourceJamfProScripts() *schema.Resource {
 		ReadContext:   readWithCleanup,
 		UpdateContext: update,
 		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(70 * time.Second),
 			Read:   schema.DefaultTimeout(70 * time.Second),
@@ -23,8 +25,9 @@ func ResourceJamfProScripts() *schema.Resource {
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -116,6 +119,16 @@ func ResourceJamfProScripts() *schema.Resource {
 				Optional:    true,
 				Description: "Script parameter label 11",
 			},
+			"validation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      validationModeOff,
+				ValidateFunc: validation.StringInSlice([]string{validationModeOff, validationModeWarn, validationModeError}, false),
+				Description: "Static analysis of `script_contents`: `off`, `warn` or `error`. The analysis checks for a bash, zsh, sh, " +
+					"python or osascript shebang, Windows (CRLF) line endings and content that is not UTF-8, and that the " +
+					"script reads each labelled parameter (`$4` to `$11`) and labels each parameter it reads. With `error` " +
+					"problems fail the plan, with `warn` they are reported as warnings when the script is created or updated.",
+			},
 		},
 	}
 }
@@ -0,0 +1,31 @@
+package smart_computer_group
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewSmartComputerGroupListResource creates the jamfpro_smart_computer_group list resource used by `terraform query`.
+func NewSmartComputerGroupListResource() list.ListResource {
+	return sdkv2_list.New("_smart_computer_group", ResourceJamfProSmartComputerGroups, listGroupItems)
+}
+
+// listGroupItems returns every smart computer group as list resource items.
+func listGroupItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	response, err := client.GetComputerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(response.Results))
+	for _, group := range response.Results {
+		if !group.IsSmart {
+			continue
+		}
+		items = append(items, sdkv2_list.Item{ID: strconv.Itoa(group.ID), Name: group.Name})
+	}
+	return items, nil
+}
@@ -0,0 +1,66 @@
+package smart_computer_group_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSmartComputerGroup_basic(t *testing.T) {
+	name := acctest.RandomName("smart-computer-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CheckDestroy("jamfpro_smart_computer_group", func(client *jamfpro.Client, id string) error {
+			_, err := client.GetComputerGroupByID(id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSmartComputerGroupConfig(name, "Google Chrome.app"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_smart_computer_group.test", "name", name),
+					resource.TestCheckResourceAttr("jamfpro_smart_computer_group.test", "is_smart", "true"),
+					resource.TestCheckResourceAttr("jamfpro_smart_computer_group.test", "criteria.#", "2"),
+					resource.TestCheckResourceAttr("jamfpro_smart_computer_group.test", "criteria.1.value", "Google Chrome.app"),
+				),
+			},
+			{
+				Config: testAccSmartComputerGroupConfig(name, "Firefox.app"),
+				Check:  resource.TestCheckResourceAttr("jamfpro_smart_computer_group.test", "criteria.1.value", "Firefox.app"),
+			},
+			{
+				ResourceName:      "jamfpro_smart_computer_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSmartComputerGroupConfig(name, application string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_smart_computer_group" "test" {
+  name = %[1]q
+
+  criteria {
+    name        = "Operating System Version"
+    priority    = 0
+    search_type = "greater than or equal"
+    value       = "14.0"
+  }
+
+  criteria {
+    name        = "Application Title"
+    priority    = 1
+    and_or      = "and"
+    search_type = "has"
+    value       = %[2]q
+  }
+}
+`, name, application)
+}
@@ -5,11 +5,15 @@ import (
 
 	schemahelpers "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/schema/helpers"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/schema/validation"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/smart_criteria"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-// Ensure the resource implements the ResourceWithConfigValidators interface
-var _ resource.ResourceWithConfigValidators = &smartComputerGroupV2FrameworkResource{}
+// Ensure the resource implements the ResourceWithConfigValidators and ResourceWithModifyPlan interfaces
+var (
+	_ resource.ResourceWithConfigValidators = &smartComputerGroupV2FrameworkResource{}
+	_ resource.ResourceWithModifyPlan       = &smartComputerGroupV2FrameworkResource{}
+)
 
 // ConfigValidators returns a list of config validators for the resource
 func (r *smartComputerGroupV2FrameworkResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -18,6 +22,22 @@ func (r *smartComputerGroupV2FrameworkResource) ConfigValidators(ctx context.Con
 	}
 }
 
+// ModifyPlan checks the planned criteria against those available in Jamf Pro when
+// validate_criteria is set.
+func (r *smartComputerGroupV2FrameworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan smartComputerGroupV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	smart_criteria.ValidatePlan(r.client, smart_criteria.SubjectComputer, plan.ValidateCriteria, plan.Criteria, &resp.Diagnostics)
+}
+
 // GetInt64Sequence exposes the priority sequence for validation.
 func (m smartComputerGroupV2ResourceModel) GetInt64Sequence() []int64 {
 	if m.Criteria.IsNull() || m.Criteria.IsUnknown() {
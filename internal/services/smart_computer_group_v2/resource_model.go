@@ -7,12 +7,13 @@ import (
 
 // smartComputerGroupV2ResourceModel describes the resource data model.
 type smartComputerGroupV2ResourceModel struct {
-	ID          types.String   `tfsdk:"id"`
-	Name        types.String   `tfsdk:"name"`
-	Description types.String   `tfsdk:"description"`
-	SiteID      types.String   `tfsdk:"site_id"`
-	Criteria    types.List     `tfsdk:"criteria"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	SiteID           types.String   `tfsdk:"site_id"`
+	Criteria         types.List     `tfsdk:"criteria"`
+	ValidateCriteria types.Bool     `tfsdk:"validate_criteria"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // smartComputerGroupV2CriteriaDataModel describes the criteria data model.
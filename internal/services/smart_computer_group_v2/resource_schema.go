@@ -82,8 +82,9 @@ func (r *smartComputerGroupV2FrameworkResource) Schema(ctx context.Context, _ re
 				MarkdownDescription: "The description of the smart computer group.",
 				Optional:            true,
 			},
-			"site_id":  commonschema.SiteID(ctx),
-			"timeouts": commonschema.Timeouts(ctx),
+			"site_id":           commonschema.SiteID(ctx),
+			"validate_criteria": commonschema.ValidateCriteria(ctx),
+			"timeouts":          commonschema.Timeouts(ctx),
 		},
 		Blocks: map[string]schema.Block{
 			"criteria": commonschema.CriteriaResource(ctx),
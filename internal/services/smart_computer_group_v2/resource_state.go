@@ -29,6 +29,11 @@ func state(ctx context.Context, data *smartComputerGroupV2ResourceModel, resourc
 		data.SiteID = types.StringNull()
 	}
 
+	// validate_criteria is not stored in Jamf Pro, so it is null after import.
+	if data.ValidateCriteria.IsNull() {
+		data.ValidateCriteria = types.BoolValue(false)
+	}
+
 	criteriaModels := make([]smartComputerGroupV2CriteriaDataModel, 0, len(resp.Criteria))
 	for _, criterion := range resp.Criteria {
 		criteriaModel := smartComputerGroupV2CriteriaDataModel{
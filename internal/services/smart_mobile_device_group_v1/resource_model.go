@@ -7,12 +7,13 @@ import (
 
 // smartMobileDeviceGroupV1ResourceModel describes the resource data model.
 type smartMobileDeviceGroupV1ResourceModel struct {
-	ID          types.String   `tfsdk:"id"`
-	Name        types.String   `tfsdk:"name"`
-	Description types.String   `tfsdk:"description"`
-	SiteID      types.String   `tfsdk:"site_id"`
-	Criteria    types.List     `tfsdk:"criteria"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	SiteID           types.String   `tfsdk:"site_id"`
+	Criteria         types.List     `tfsdk:"criteria"`
+	ValidateCriteria types.Bool     `tfsdk:"validate_criteria"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // smartMobileDeviceGroupV1CriteriaDataModel describes the criteria data model.
@@ -82,8 +82,9 @@ func (r *smartMobileDeviceGroupV1FrameworkResource) Schema(ctx context.Context,
 				MarkdownDescription: "The description of the smart mobile device group.",
 				Optional:            true,
 			},
-			"site_id":  commonschema.SiteID(ctx),
-			"timeouts": commonschema.Timeouts(ctx),
+			"site_id":           commonschema.SiteID(ctx),
+			"validate_criteria": commonschema.ValidateCriteria(ctx),
+			"timeouts":          commonschema.Timeouts(ctx),
 		},
 		Blocks: map[string]schema.Block{
 			"criteria": commonschema.CriteriaResource(ctx),
@@ -29,6 +29,11 @@ func state(ctx context.Context, data *smartMobileDeviceGroupV1ResourceModel, res
 		data.SiteID = types.StringNull()
 	}
 
+	// validate_criteria is not stored in Jamf Pro, so it is null after import.
+	if data.ValidateCriteria.IsNull() {
+		data.ValidateCriteria = types.BoolValue(false)
+	}
+
 	criteriaModels := make([]smartMobileDeviceGroupV1CriteriaDataModel, 0, len(resp.Criteria))
 	for _, criterion := range resp.Criteria {
 		criteriaModel := smartMobileDeviceGroupV1CriteriaDataModel{
@@ -11,6 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	ResourceIDSingleton = "jamfpro_sso_certificate_singleton"
+)
+
 // create is responsible for creating a new Jamf Pro SSO Certificate in the remote system.
 func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
@@ -29,7 +33,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnost
 		return diag.FromErr(fmt.Errorf("failed to create SSO certificate: %v", err))
 	}
 
-	d.SetId("jamfpro_sso_certificate_singleton")
+	d.SetId(ResourceIDSingleton)
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
@@ -39,7 +43,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta any, cleanup bool) d
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	d.SetId("jamfpro_sso_certificate_singleton")
+	d.SetId(ResourceIDSingleton)
 
 	var response *jamfpro.ResourceSSOKeystoreResponse
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
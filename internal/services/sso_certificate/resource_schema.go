@@ -3,6 +3,7 @@ package sso_certificate
 import (
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,6 +17,9 @@ func ResourceJamfProSSOCertificate() *schema.Resource {
 			Read:   schema.DefaultTimeout(1 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: crud.ImportStateSingleton(ResourceIDSingleton),
+		},
 		Schema: map[string]*schema.Schema{
 			"keystore": {
 				Type:     schema.TypeList,
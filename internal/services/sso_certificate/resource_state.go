@@ -36,7 +36,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceSSOKeystoreRespon
 		return diags
 	}
 
-	d.SetId("jamfpro_sso_certificate_singleton")
+	d.SetId(ResourceIDSingleton)
 	return diags
 }
 
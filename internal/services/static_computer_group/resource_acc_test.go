@@ -0,0 +1,50 @@
+package static_computer_group_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStaticComputerGroup_basic(t *testing.T) {
+	name := acctest.RandomName("static-computer-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.CheckDestroy("jamfpro_static_computer_group", func(client *jamfpro.Client, id string) error {
+			_, err := client.GetComputerGroupByID(id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticComputerGroupConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group.test", "name", name),
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group.test", "is_smart", "false"),
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group.test", "site_id", "-1"),
+				),
+			},
+			{
+				Config: testAccStaticComputerGroupConfig(name + "-updated"),
+				Check:  resource.TestCheckResourceAttr("jamfpro_static_computer_group.test", "name", name+"-updated"),
+			},
+			{
+				ResourceName:      "jamfpro_static_computer_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStaticComputerGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_static_computer_group" "test" {
+  name = %[1]q
+}
+`, name)
+}
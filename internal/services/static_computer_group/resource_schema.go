@@ -3,6 +3,7 @@ package static_computer_group
 import (
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,8 +46,9 @@ func ResourceJamfProStaticComputerGroups() *schema.Resource {
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -67,7 +69,7 @@ func ResourceJamfProStaticComputerGroups() *schema.Resource {
 			"assigned_computer_ids": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "assigned computer by ids",
+				Description: "assigned computer by ids. Ignore changes to this attribute when members are managed with `jamfpro_static_computer_group_membership`.",
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
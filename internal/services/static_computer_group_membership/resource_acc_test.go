@@ -0,0 +1,126 @@
+package static_computer_group_membership_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStaticComputerGroupMembership_basic(t *testing.T) {
+	name := acctest.RandomName("static-computer-group-membership")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticComputerGroupMembershipConfig(name, "[1, 2]", "[3]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("jamfpro_static_computer_group_membership.first", "id", "jamfpro_static_computer_group.test", "id"),
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group_membership.first", "computer_ids.#", "2"),
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group_membership.first", "member_ids.1", "1"),
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group_membership.second", "member_ids.3", "3"),
+					testAccCheckStaticComputerGroupMembers("jamfpro_static_computer_group.test", []int{1, 2, 3}),
+				),
+			},
+			{
+				Config: testAccStaticComputerGroupMembershipConfig(name, "[2]", "[3]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group_membership.first", "computer_ids.#", "1"),
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group_membership.first", "member_ids.%", "1"),
+					resource.TestCheckResourceAttr("jamfpro_static_computer_group_membership.second", "computer_ids.#", "1"),
+					testAccCheckStaticComputerGroupMembers("jamfpro_static_computer_group.test", []int{2, 3}),
+				),
+			},
+			{
+				ResourceName:      "jamfpro_static_computer_group_membership.second",
+				ImportState:       true,
+				ImportStateIdFunc: testAccStaticComputerGroupMembershipImportID("jamfpro_static_computer_group.test", "3"),
+				// Both memberships share the group ID, so verify the imported state directly rather
+				// than matching it against the state of one of them.
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					if got := states[0].Attributes["member_ids.3"]; got != "3" {
+						return fmt.Errorf("expected member_ids.3 to be 3, got %q", got)
+					}
+					if got := states[0].Attributes["computer_ids.#"]; got != "1" {
+						return fmt.Errorf("expected 1 computer ID, got %q", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// testAccCheckStaticComputerGroupMembers checks the group holds exactly the given computers, in any
+// order.
+func testAccCheckStaticComputerGroupMembers(groupResource string, want []int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		group, ok := s.RootModule().Resources[groupResource]
+		if !ok {
+			return fmt.Errorf("resource %s not found", groupResource)
+		}
+
+		client, err := acctest.Client()
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetComputerGroupByID(group.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var got []int
+		if resp.Computers != nil {
+			for _, computer := range *resp.Computers {
+				got = append(got, computer.ID)
+			}
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("expected group %s to hold computers %v, got %v", group.Primary.ID, want, got)
+		}
+		return nil
+	}
+}
+
+// testAccStaticComputerGroupMembershipImportID returns the import ID of a membership of the group
+// holding the given computers.
+func testAccStaticComputerGroupMembershipImportID(groupResource, computers string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		group, ok := s.RootModule().Resources[groupResource]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", groupResource)
+		}
+		return group.Primary.ID + ":" + computers, nil
+	}
+}
+
+func testAccStaticComputerGroupMembershipConfig(name, first, second string) string {
+	return fmt.Sprintf(`
+resource "jamfpro_static_computer_group" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [assigned_computer_ids]
+  }
+}
+
+resource "jamfpro_static_computer_group_membership" "first" {
+  group_id     = jamfpro_static_computer_group.test.id
+  computer_ids = %[2]s
+}
+
+resource "jamfpro_static_computer_group_membership" "second" {
+  group_id     = jamfpro_static_computer_group.test.id
+  computer_ids = %[3]s
+}
+`, name, first, second)
+}
@@ -0,0 +1,58 @@
+package static_computer_group_membership
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/static_group_membership"
+)
+
+// kind manages the membership of Jamf Pro static computer groups.
+var kind = &static_group_membership.Kind{
+	Device: "computer",
+	IDsKey: "computer_ids",
+	Get:    getGroup,
+	Write:  writeMembers,
+	Find:   findComputers,
+}
+
+// getGroup returns the computer group with the ID and the IDs of its computers.
+func getGroup(client *jamfpro.Client, id string) (*static_group_membership.Group, error) {
+	group, err := client.GetComputerGroupByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &static_group_membership.Group{ID: group.ID, Name: group.Name, IsSmart: group.IsSmart, Site: group.Site}
+	if group.Computers != nil {
+		for _, computer := range *group.Computers {
+			out.Members = append(out.Members, computer.ID)
+		}
+	}
+	return out, nil
+}
+
+// writeMembers replaces the members of the group with the given computer IDs.
+func writeMembers(client *jamfpro.Client, group *static_group_membership.Group, ids []int) error {
+	if _, err := client.UpdateComputerGroupByID(strconv.Itoa(group.ID), membersResource(group, ids)); err != nil {
+		return fmt.Errorf("failed to update members of Jamf Pro static computer group '%s' (ID: %d): %v", group.Name, group.ID, err)
+	}
+
+	return nil
+}
+
+// membersResource returns the static computer group with the given computer IDs as its members.
+func membersResource(group *static_group_membership.Group, ids []int) *jamfpro.ResourceComputerGroup {
+	computers := make([]jamfpro.ComputerGroupSubsetComputer, 0, len(ids))
+	for _, id := range ids {
+		computers = append(computers, jamfpro.ComputerGroupSubsetComputer{ID: id})
+	}
+
+	return &jamfpro.ResourceComputerGroup{
+		Name:      group.Name,
+		IsSmart:   false,
+		Site:      group.Site,
+		Computers: &computers,
+	}
+}
@@ -0,0 +1,48 @@
+package static_computer_group_membership
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// findComputers returns the Jamf Pro IDs of the computers with the serial numbers and UDIDs, keyed
+// by serial number or UDID, looking each up in the computer inventory.
+func findComputers(client *jamfpro.Client, serialNumbers, udids []string) (map[string]int, error) {
+	found := map[string]int{}
+
+	for _, serial := range serialNumbers {
+		computer, err := client.GetComputerInventoryBySerialNumber(serial)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find computer with serial number '%s': %v", serial, err)
+		}
+		id, err := strconv.Atoi(computer.ID)
+		if err != nil {
+			return nil, fmt.Errorf("computer with serial number '%s' has unexpected ID '%s'", serial, computer.ID)
+		}
+		found[serial] = id
+	}
+
+	for _, udid := range udids {
+		params := url.Values{}
+		params.Set("filter", fmt.Sprintf("udid==\"%s\"", udid))
+		params.Add("section", "GENERAL")
+
+		inventories, err := client.GetComputersInventory(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find computer with UDID '%s': %v", udid, err)
+		}
+		if len(inventories.Results) == 0 {
+			return nil, fmt.Errorf("failed to find computer with UDID '%s'", udid)
+		}
+		id, err := strconv.Atoi(inventories.Results[0].ID)
+		if err != nil {
+			return nil, fmt.Errorf("computer with UDID '%s' has unexpected ID '%s'", udid, inventories.Results[0].ID)
+		}
+		found[udid] = id
+	}
+
+	return found, nil
+}
@@ -0,0 +1,92 @@
+package static_computer_group_membership
+
+import (
+	"context"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/static_group_membership"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// memberAttributes are the attributes through which members may be given.
+var memberAttributes = []string{"computer_ids", "serial_numbers", "udids"}
+
+// ResourceJamfProStaticComputerGroupMembership defines the schema and CRUD operations for managing a
+// subset of the members of a Jamf Pro static computer group in Terraform.
+func ResourceJamfProStaticComputerGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kind.Create,
+		ReadContext:   kind.Read,
+		UpdateContext: kind.Update,
+		DeleteContext: kind.Delete,
+		CustomizeDiff: customdiff.ComputedIf("member_ids", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+			return d.HasChanges(memberAttributes...)
+		}),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(70 * time.Second),
+			Update: schema.DefaultTimeout(70 * time.Second),
+			Delete: schema.DefaultTimeout(70 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: kind.ImportState,
+		},
+		Description: "Manages a subset of the members of a Jamf Pro static computer group. Unlike the " +
+			"`assigned_computer_ids` of `jamfpro_static_computer_group`, only the computers listed here are " +
+			"added and removed, so several configurations can share one group.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the static computer group.",
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(static_group_membership.NumericID, "must be a numeric Jamf Pro ID"),
+				Description:  "The ID of the static computer group to add the computers to.",
+			},
+			"computer_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: memberAttributes,
+				Description:  "The Jamf Pro IDs of computers to add to the group.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"serial_numbers": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: memberAttributes,
+				Description:  "The serial numbers of computers to add to the group, resolved through the computer inventory.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"udids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: memberAttributes,
+				Description:  "The UDIDs of computers to add to the group, resolved through the computer inventory.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"member_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of each configured Jamf Pro ID, serial number and UDID to the Jamf Pro ID of the computer it resolved to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package static_mobile_device_group
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_list"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// NewStaticMobileDeviceGroupListResource creates the jamfpro_static_mobile_device_group list resource used by `terraform query`.
+func NewStaticMobileDeviceGroupListResource() list.ListResource {
+	return sdkv2_list.New("_static_mobile_device_group", ResourceJamfProStaticMobileDeviceGroups, listGroupItems)
+}
+
+// listGroupItems returns every static mobile device group as list resource items.
+func listGroupItems(client *jamfpro.Client) ([]sdkv2_list.Item, error) {
+	response, err := client.GetMobileDeviceGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]sdkv2_list.Item, 0, len(response.MobileDeviceGroup))
+	for _, group := range response.MobileDeviceGroup {
+		if group.IsSmart {
+			continue
+		}
+		items = append(items, sdkv2_list.Item{ID: strconv.Itoa(group.ID), Name: group.Name})
+	}
+	return items, nil
+}
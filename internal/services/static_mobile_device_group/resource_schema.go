@@ -3,6 +3,7 @@ package static_mobile_device_group
 import (
 	"time"
 
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
 	sharedschemas "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/shared_schemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,8 +46,9 @@ func ResourceJamfProStaticMobileDeviceGroups() *schema.Resource {
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: crud.ImportStatePassthroughWithIdentity(),
 		},
+		Identity: crud.IDIdentity(),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -67,7 +69,7 @@ func ResourceJamfProStaticMobileDeviceGroups() *schema.Resource {
 			"assigned_mobile_device_ids": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "assigned mobile device by ids",
+				Description: "assigned mobile device by ids. Ignore changes to this attribute when members are managed with `jamfpro_static_mobile_device_group_membership`.",
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
@@ -0,0 +1,64 @@
+package static_mobile_device_group_membership
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/static_group_membership"
+)
+
+// kind manages the membership of Jamf Pro static mobile device groups.
+var kind = &static_group_membership.Kind{
+	Device: "mobile device",
+	IDsKey: "mobile_device_ids",
+	Get:    getGroup,
+	Write:  writeMembers,
+	Find:   findMobileDevices,
+}
+
+// getGroup returns the mobile device group with the ID and the IDs of its mobile devices.
+func getGroup(client *jamfpro.Client, id string) (*static_group_membership.Group, error) {
+	group, err := client.GetMobileDeviceGroupByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupOf(group), nil
+}
+
+// groupOf returns the mobile device group and the IDs of its mobile devices.
+func groupOf(group *jamfpro.ResourceMobileDeviceGroup) *static_group_membership.Group {
+	out := &static_group_membership.Group{ID: group.ID, Name: group.Name, IsSmart: group.IsSmart, Site: group.Site}
+	if group.MobileDevices != nil {
+		for _, device := range *group.MobileDevices {
+			out.Members = append(out.Members, device.ID)
+		}
+	}
+	return out
+}
+
+// writeMembers replaces the members of the group with the given mobile device IDs.
+func writeMembers(client *jamfpro.Client, group *static_group_membership.Group, ids []int) error {
+	if _, err := client.UpdateMobileDeviceGroupByID(strconv.Itoa(group.ID), membersResource(group, ids)); err != nil {
+		return fmt.Errorf("failed to update members of Jamf Pro static mobile device group '%s' (ID: %d): %v", group.Name, group.ID, err)
+	}
+
+	return nil
+}
+
+// membersResource returns the static mobile device group with the given mobile device IDs as its
+// members.
+func membersResource(group *static_group_membership.Group, ids []int) *jamfpro.ResourceMobileDeviceGroup {
+	devices := make([]jamfpro.MobileDeviceGroupSubsetDeviceItem, 0, len(ids))
+	for _, id := range ids {
+		devices = append(devices, jamfpro.MobileDeviceGroupSubsetDeviceItem{ID: id})
+	}
+
+	return &jamfpro.ResourceMobileDeviceGroup{
+		Name:          group.Name,
+		IsSmart:       false,
+		Site:          group.Site,
+		MobileDevices: &devices,
+	}
+}
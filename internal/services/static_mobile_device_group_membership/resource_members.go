@@ -0,0 +1,48 @@
+package static_mobile_device_group_membership
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// findMobileDevices returns the Jamf Pro IDs of the mobile devices with the serial numbers and
+// UDIDs, keyed by serial number or UDID, from a single listing of the mobile device inventory.
+func findMobileDevices(client *jamfpro.Client, serialNumbers, udids []string) (map[string]int, error) {
+	devices, err := client.GetMobileDevices()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Jamf Pro mobile devices: %v", err)
+	}
+
+	return matchMobileDevices(devices.MobileDevices, serialNumbers, udids)
+}
+
+// matchMobileDevices returns the IDs of the listed mobile devices with the serial numbers and
+// UDIDs, which are matched regardless of case.
+func matchMobileDevices(devices []jamfpro.MobileDeviceListItem, serialNumbers, udids []string) (map[string]int, error) {
+	found := map[string]int{}
+
+	for _, serial := range serialNumbers {
+		index := slices.IndexFunc(devices, func(device jamfpro.MobileDeviceListItem) bool {
+			return strings.EqualFold(device.SerialNumber, serial)
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("failed to find mobile device with serial number '%s'", serial)
+		}
+		found[serial] = devices[index].ID
+	}
+
+	for _, udid := range udids {
+		index := slices.IndexFunc(devices, func(device jamfpro.MobileDeviceListItem) bool {
+			return strings.EqualFold(device.UDID, udid)
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("failed to find mobile device with UDID '%s'", udid)
+		}
+		found[udid] = devices[index].ID
+	}
+
+	return found, nil
+}
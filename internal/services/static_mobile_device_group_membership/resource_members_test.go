@@ -0,0 +1,63 @@
+package static_mobile_device_group_membership
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchMobileDevices(t *testing.T) {
+	devices := []jamfpro.MobileDeviceListItem{
+		{ID: 3, SerialNumber: "DMPXK1", UDID: "00008110-000A"},
+		{ID: 9, SerialNumber: "F9FXQ2", UDID: "00008110-000B"},
+	}
+
+	found, err := matchMobileDevices(devices, []string{"dmpxk1"}, []string{"00008110-000b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"dmpxk1": 3, "00008110-000b": 9}, found, "matched regardless of case")
+
+	_, err = matchMobileDevices(devices, []string{"UNKNOWN"}, nil)
+	assert.EqualError(t, err, "failed to find mobile device with serial number 'UNKNOWN'")
+
+	_, err = matchMobileDevices(devices, nil, []string{"UNKNOWN"})
+	assert.EqualError(t, err, "failed to find mobile device with UDID 'UNKNOWN'")
+}
+
+func TestGroupOf(t *testing.T) {
+	site := &jamfpro.SharedResourceSite{ID: 2, Name: "London"}
+	group := groupOf(&jamfpro.ResourceMobileDeviceGroup{
+		ID:            5,
+		Name:          "Loaners",
+		Site:          site,
+		MobileDevices: &[]jamfpro.MobileDeviceGroupSubsetDeviceItem{{ID: 3}, {ID: 9}},
+	})
+
+	assert.Equal(t, 5, group.ID)
+	assert.Equal(t, "Loaners", group.Name)
+	assert.False(t, group.IsSmart)
+	assert.Equal(t, site, group.Site)
+	assert.Equal(t, []int{3, 9}, group.Members)
+
+	assert.Empty(t, groupOf(&jamfpro.ResourceMobileDeviceGroup{ID: 6}).Members, "a group without members")
+}
+
+func TestMembersResource(t *testing.T) {
+	site := &jamfpro.SharedResourceSite{ID: 2, Name: "London"}
+	group := groupOf(&jamfpro.ResourceMobileDeviceGroup{ID: 5, Name: "Loaners", Site: site})
+
+	resource := membersResource(group, []int{3, 9})
+
+	assert.Equal(t, "Loaners", resource.Name)
+	assert.False(t, resource.IsSmart)
+	assert.Equal(t, site, resource.Site, "the site is kept")
+	assert.Equal(t, []jamfpro.MobileDeviceGroupSubsetDeviceItem{{ID: 3}, {ID: 9}}, *resource.MobileDevices)
+}
+
+func TestKindMatchesSchema(t *testing.T) {
+	s := ResourceJamfProStaticMobileDeviceGroupMembership().Schema
+
+	assert.Contains(t, s, kind.IDsKey)
+	assert.Equal(t, "mobile device", kind.Device)
+}
@@ -0,0 +1,42 @@
+package user_initiated_enrollment_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	crud "github.com/deploymenttheory/terraform-provider-jamfpro/internal/common/sdkv2_crud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importState imports the singleton enrollment settings. Read only keeps the built in
+// directory service group (ID 1) in state unless groups are configured, so every group
+// present in Jamf Pro is seeded here to make the import complete.
+func importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*jamfpro.Client)
+
+	resources, err := crud.ImportStateSingleton(ResourceIDSingleton)(ctx, d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	accessGroups, err := client.GetAccountDrivenUserEnrollmentAccessGroups(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch directory service groups for import: %v", err)
+	}
+
+	groupSettingsList := make([]any, 0, len(accessGroups.Results))
+	for i := range accessGroups.Results {
+		groupConfigMap, err := handleDirectoryServiceGroupEnrollmentSettings(&accessGroups.Results[i])
+		if err != nil {
+			return nil, err
+		}
+		groupSettingsList = append(groupSettingsList, groupConfigMap)
+	}
+
+	if err := d.Set("directory_service_group_enrollment_settings", groupSettingsList); err != nil {
+		return nil, fmt.Errorf("failed to set directory_service_group_enrollment_settings: %v", err)
+	}
+
+	return resources, nil
+}
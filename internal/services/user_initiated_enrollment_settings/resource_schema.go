@@ -21,6 +21,9 @@ func ResourceJamfProUserInitatedEnrollmentSettings() *schema.Resource {
 			Update: schema.DefaultTimeout(70 * time.Second),
 			Delete: schema.DefaultTimeout(70 * time.Second),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
 		Schema: map[string]*schema.Schema{
 			// General (page 1) Enrollment restrictions and settings
 			// /api/v4/enrollment
@@ -0,0 +1,82 @@
+// Command exporter writes the objects of a Jamf Pro instance as Terraform configuration for the
+// jamfpro provider, with references between them and import blocks, to promote them to another
+// instance.
+//
+// The instance is configured by the provider's environment variables, e.g. JAMFPRO_INSTANCE_FQDN,
+// JAMFPRO_AUTH_METHOD, JAMFPRO_CLIENT_ID and JAMFPRO_CLIENT_SECRET.
+//
+//	go run ./tools/exporter -out ./exported -kinds category,script,policy
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/exporter"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func main() {
+	out := flag.String("out", "exported", "The directory to write the configuration to.")
+	kinds := flag.String("kinds", "", "The comma-separated resource types to export, e.g. category,policy. Defaults to every type the exporter writes.")
+	flag.Parse()
+
+	if err := run(*out, *kinds); err != nil {
+		fmt.Fprintln(os.Stderr, "exporter:", err)
+		os.Exit(1)
+	}
+}
+
+func run(out, kindNames string) error {
+	var names []string
+	if kindNames != "" {
+		names = strings.Split(kindNames, ",")
+	}
+	kinds, err := exporter.KindsNamed(names)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	p := provider.Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return fmt.Errorf("failed to configure provider: %v", diags)
+	}
+
+	result, err := exporter.Export(ctx, p, kinds)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(result.Files))
+	for path := range result.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		target := filepath.Join(out, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, result.Files[path], 0o644); err != nil {
+			return err
+		}
+		fmt.Println("wrote", target)
+	}
+
+	warnings := result.Warnings
+	if kindNames == "" {
+		warnings = append(exporter.FrameworkResourceWarnings(ctx, provider.FrameworkProvider("exporter")()), warnings...)
+	}
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
+	return nil
+}